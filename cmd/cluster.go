@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/j3ssie/goverview/core"
+	"github.com/j3ssie/goverview/utils"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var clusterCmd = &cobra.Command{
+		Use:   "cluster",
+		Short: "Group probe output (--json or --simhash) by similarity of the content",
+		RunE:  runCluster,
+	}
+	clusterCmd.Flags().IntVar(&options.Cluster.Distance, "distance", 3, "Maximum number of different bits between simhash to be in the same cluster")
+	RootCmd.AddCommand(clusterCmd)
+}
+
+func runCluster(_ *cobra.Command, _ []string) error {
+	lines := inputs
+	if len(lines) == 0 {
+		if !utils.FileExists(options.ContentFile) {
+			utils.ErrorF("content summary not found: %v", options.ContentFile)
+			return nil
+		}
+		utils.InforF("reading content summary from: %v", options.ContentFile)
+		lines = utils.ReadingLines(options.ContentFile)
+	}
+
+	var overviews []core.Overview
+	for _, line := range lines {
+		overview, err := core.ParseOverview(line)
+		if err != nil {
+			utils.DebugF("%v", err)
+			continue
+		}
+		overviews = append(overviews, overview)
+	}
+
+	clusters := core.ClusterOverviews(overviews, options.Cluster.Distance)
+	for _, cluster := range clusters {
+		if options.JsonOutput {
+			if data, err := jsoniter.MarshalToString(cluster); err == nil {
+				fmt.Println(data)
+			}
+			continue
+		}
+		fmt.Printf("%v ;; %v ;; %v ;; %v\n", cluster.ID, cluster.Size, cluster.Title, strings.Join(cluster.URLs, ","))
+	}
+	utils.GoodF("Grouped %v pages into %v clusters", len(overviews), len(clusters))
+	return nil
+}
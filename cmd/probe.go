@@ -24,12 +24,27 @@ func init() {
 	probeCmd.Flags().BoolVarP(&options.SaveReponse, "save-response", "M", false, "Save HTTP response")
 	probeCmd.Flags().BoolVarP(&options.Probe.OnlySummary, "no-output", "N", false, "Only store summary file")
 	probeCmd.Flags().BoolVar(&options.Probe.WordsSummary, "words", false, "Get words from response too")
+	probeCmd.Flags().BoolVar(&options.Probe.SimHash, "simhash", false, "Add simhash column to text output, used by cluster command (always in JSON output)")
+	probeCmd.Flags().BoolVar(&options.Fin.Enable, "detect-tech", false, "Detect technologies of the response with Wappalyzer fingerprint (enabled by --tech or --rules too)")
+	probeCmd.Flags().StringVar(&options.Fin.RulesDir, "rules", "", "Directory of custom fingerprint rules (*.json), checked with the --tech file")
 	RootCmd.AddCommand(probeCmd)
 }
 
 func runProbe(_ *cobra.Command, _ []string) error {
 	// prepare output
 	var wg sync.WaitGroup
+	if options.Fin.TechFile != "" || options.Fin.RulesDir != "" {
+		options.Fin.Enable = true
+	}
+	if options.Fin.Enable {
+		if err := core.LoadTechs(options); err != nil {
+			return err
+		}
+		if err := core.LoadRules(options); err != nil {
+			return err
+		}
+		options.Fin.Loaded = true
+	}
 	client := core.BuildClient(options)
 	p, _ := ants.NewPoolWithFunc(options.Concurrency, func(i interface{}) {
 		defer wg.Done()
@@ -38,12 +53,14 @@ func runProbe(_ *cobra.Command, _ []string) error {
 		if strings.TrimSpace(job) == "" {
 			return
 		}
-		if options.InputAsBurp {
-			job = core.ParseBurpRequest(job)
+		req, err := parseJob(job)
+		if err != nil {
+			utils.ErrorF("Error parsing input: %v", err)
+			return
 		}
 
-		utils.InforF("[probing] %v", job)
-		out := core.Sending(options, job, client)
+		utils.InforF("[probing] %v %v", req.Method, req.URL)
+		out := core.Sending(options, req, client)
 		if out != "" {
 			fmt.Println(out)
 			if !options.Probe.OnlySummary {
@@ -54,7 +71,7 @@ func runProbe(_ *cobra.Command, _ []string) error {
 	}, ants.WithPreAlloc(true))
 	defer p.Release()
 
-	for _, raw := range inputs {
+	for _, raw := range expandInputs() {
 		wg.Add(1)
 		err := p.Invoke(raw)
 		if err != nil {
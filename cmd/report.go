@@ -13,6 +13,7 @@ func init() {
 		RunE:  runReport,
 	}
 	reportCmd.Flags().StringVar(&options.ReportFile, "report", "report.html", "Report name")
+	reportCmd.Flags().IntVar(&options.PHashDistance, "phash-distance", 4, "Max perceptual hash distance to group visually identical pages (-1 to disable)")
 	RootCmd.AddCommand(reportCmd)
 }
 
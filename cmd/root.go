@@ -33,26 +33,40 @@ func init() {
 	RootCmd.PersistentFlags().IntVarP(&options.Threads, "threads", "t", 5, "Set the threads level for do screenshot")
 	RootCmd.PersistentFlags().IntVarP(&options.Level, "level", "l", 0, "Set level to calculate CheckSum (default: 0)")
 	// inputs
-	RootCmd.PersistentFlags().StringSliceVarP(&options.Inputs, "inputs", "i", []string{}, "Custom headers (e.g: -H 'Referer: {{.BaseURL}}') (Multiple -H flags are accepted)")
-	RootCmd.PersistentFlags().StringVarP(&options.InputFile, "inputFile", "I", "", "Custom headers (e.g: -H 'Referer: {{.BaseURL}}') (Multiple -H flags are accepted)")
+	RootCmd.PersistentFlags().StringSliceVarP(&options.Inputs, "inputs", "i", []string{}, "Input URLs, hosts or CIDR ranges (Multiple -i flags are accepted)")
+	RootCmd.PersistentFlags().StringVarP(&options.InputFile, "inputFile", "I", "", "File contain input URLs, hosts or CIDR ranges")
+	RootCmd.PersistentFlags().StringVar(&options.Ports, "ports", "80,443", "Ports to expand bare hosts, IPs and CIDR ranges (e.g: 80,443,8000-8010)")
+	RootCmd.PersistentFlags().BoolVar(&options.DetectScheme, "detect-scheme", false, "Only keep the scheme that answers when expanding bare hosts")
 	// output
 	RootCmd.PersistentFlags().BoolVarP(&options.JsonOutput, "json", "j", false, "Output as JSON")
 	RootCmd.PersistentFlags().BoolVarP(&options.NoOutput, "no-output", "N", false, "No output")
 	RootCmd.PersistentFlags().StringVarP(&options.Output, "output", "o", "out", "Output Directory")
-	RootCmd.PersistentFlags().StringVarP(&options.Fin.TechFile, "tech", "a", "technologies.json", "Technology File")
+	RootCmd.PersistentFlags().StringVarP(&options.Fin.TechFile, "tech", "a", "", "Technology File or directory of split files (default: ~/.goverview/technologies.json from update-tech, then the embedded one)")
 	RootCmd.PersistentFlags().StringVarP(&options.ScreenShotFile, "screenshot", "S", "", "Summary File for Screenshot (default 'out/screenshot-summary.txt')")
 	RootCmd.PersistentFlags().StringVarP(&options.ContentFile, "content", "C", "", "Summary File for Content (default 'out/content-summary.txt')")
 	RootCmd.PersistentFlags().StringVarP(&options.WordList, "wordlist", "W", "", "Wordlists File build from HTTP Content (default 'out/wordlists.txt')")
 	// mics options
 	RootCmd.PersistentFlags().BoolVarP(&options.InputAsBurp, "burp", "B", false, "Receive input as base64 burp request")
 	RootCmd.PersistentFlags().BoolVar(&options.SortTag, "sortTag", false, "Sort HTML tag before do checksum")
+	RootCmd.PersistentFlags().BoolVar(&options.StripVolatile, "strip-volatile", false, "Strip volatile values (dates, CSRF tokens, nonces, request IDs) before do checksum level 5")
+	RootCmd.PersistentFlags().StringSliceVar(&options.StripPatterns, "strip-pattern", []string{}, "Custom regex of volatile values to strip before do checksum level 5 (Multiple flags are accepted)")
 	// HTTP options
 	RootCmd.PersistentFlags().BoolVarP(&options.Redirect, "redirect", "L", false, "Allow redirect")
 	RootCmd.PersistentFlags().BoolVarP(&options.SaveRedirectURL, "save-redirect", "R", false, "Save redirect URL to overview file too")
 	RootCmd.PersistentFlags().IntVar(&options.Timeout, "timeout", 15, "HTTP timeout")
 	RootCmd.PersistentFlags().IntVar(&options.Retry, "retry", 0, "Number of retry")
 	RootCmd.PersistentFlags().StringVarP(&options.Proxy, "proxy", "P", "", "Proxy to send http request")
-	RootCmd.PersistentFlags().StringSliceVarP(&options.Headers, "headers", "H", []string{}, "Custom headers (e.g: -H 'Referer: {{.BaseURL}}') (Multiple -H flags are accepted)")
+	RootCmd.PersistentFlags().StringVarP(&options.Method, "method", "X", "GET", "HTTP method to send (e.g: HEAD, POST, PUT, OPTIONS or any custom verb)")
+	RootCmd.PersistentFlags().StringVar(&options.Body, "body", "", "HTTP body to send with the request")
+	// rate limit options
+	RootCmd.PersistentFlags().IntVar(&options.Rate.RateLimit, "rate-limit", 0, "Maximum requests per second for all hosts (default: no limit)")
+	RootCmd.PersistentFlags().IntVar(&options.Rate.HostConcurrency, "host-concurrency", 0, "Maximum concurrent requests per host (default: no limit)")
+	RootCmd.PersistentFlags().IntVar(&options.Rate.HostDelay, "host-delay", 0, "Delay in millisecond between requests to the same host")
+	RootCmd.PersistentFlags().IntVar(&options.Rate.BackoffRetry, "backoff-retry", 1, "Number of retry when host responds with 429 or 503")
+	RootCmd.PersistentFlags().IntVar(&options.Rate.MaxBackoff, "max-backoff", 60, "Maximum backoff in second when host responds with 429 or 503")
+	RootCmd.PersistentFlags().BoolVar(&options.ResolveDNS, "dns", false, "Get A, AAAA records and CNAME chain of target")
+	RootCmd.PersistentFlags().StringSliceVar(&options.Resolvers, "resolvers", []string{}, "Custom resolvers or file contain resolvers (e.g: --resolvers 1.1.1.1,8.8.8.8:53)")
+	RootCmd.PersistentFlags().StringSliceVarP(&options.Headers, "headers", "H", []string{}, "Custom headers, rendered per target with {{.BaseURL}} {{.Scheme}} {{.Host}} {{.Hostname}} {{.Port}} {{.Path}} {{.URL}} {{.RandomString}} {{.RandomNumber}} (e.g: -H 'Referer: {{.BaseURL}}') (Multiple -H flags are accepted)")
 
 	RootCmd.PersistentFlags().BoolVarP(&options.Verbose, "verbose", "v", false, "Verbose output")
 	RootCmd.PersistentFlags().BoolVar(&options.Debug, "debug", false, "Debug output")
@@ -63,8 +77,12 @@ func init() {
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	fmt.Fprintf(os.Stderr, "goverview %v by %v\n", libs.VERSION, libs.AUTHOR)
-	core.InitConfig(&options)
+	err := core.InitConfig(&options)
 	utils.InitLog(&options)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
 	var urls []string
 	if len(options.Inputs) > 0 {
 		urls = append(urls, options.Inputs...)
@@ -93,6 +111,30 @@ func initConfig() {
 	prepareOutput()
 }
 
+// expandInputs turn bare hosts, host:port, IPs and CIDR ranges into URLs
+func expandInputs() []string {
+	if options.InputAsBurp {
+		return inputs
+	}
+	targets := core.ExpandInputs(options, inputs)
+	utils.DebugF("Expanded %v inputs to %v targets", len(inputs), len(targets))
+	return targets
+}
+
+// parseJob build the request from a raw input line
+func parseJob(job string) (libs.Request, error) {
+	if options.InputAsBurp {
+		req, err := core.ParseBurpRequest(job)
+		if err != nil {
+			return req, err
+		}
+		// -H headers apply to burp requests too
+		req.Headers = core.MergeHeaders(req.Headers, core.RenderHeaders(options.Headers, req.URL))
+		return req, nil
+	}
+	return core.BuildRequest(options, job), nil
+}
+
 // HelpMessage print help message
 func HelpMessage(cmd *cobra.Command, _ []string) {
 	h := fmt.Sprintf("goverview - Get an overview of the list of URLs - %v by %v\n\n", libs.VERSION, libs.AUTHOR)
@@ -102,7 +144,7 @@ func HelpMessage(cmd *cobra.Command, _ []string) {
 	h += "  0 - Only check for src in <script> tag\n"
 	h += "  1 - Check for all structure of HTML tag + src in <script> tag\n"
 	h += "  2 - Check for all structure of HTML tag + src in <script> <img> <a> tag\n"
-	h += "  5 - Entire HTTP response"
+	h += "  5 - Entire HTTP response (status + selected headers + body, use --strip-volatile to ignore dates, tokens and nonces)"
 
 	h += "\n\nExamples:\n"
 	h += "  # Only get summary \n"
@@ -111,15 +153,54 @@ func HelpMessage(cmd *cobra.Command, _ []string) {
 	h += "  # Get summary content and store raw response without screenshot \n"
 	h += "  cat http_lists.txt | goverview probe -c 20 -M --json\n\n"
 
+	h += "  # Probe with custom method, body and headers \n"
+	h += "  cat http_lists.txt | goverview probe -N -X POST --body '{\"id\": 1}' -H 'Content-Type: application/json' --json\n\n"
+
+	h += "  # Group similar pages of probe output \n"
+	h += "  cat http_lists.txt | goverview probe -c 20 --json -o overview \n"
+	h += "  goverview cluster -o overview --distance 5\n\n"
+
+	h += "  # Probe bare hosts, host:port and CIDR ranges on custom ports \n"
+	h += "  cat subdomains.txt | goverview probe -N --ports 80,443,8080,8443 --detect-scheme\n\n"
+
 	h += "  # Pass all urls to proxy with real browser\n"
 	h += "  cat list_of_urls.txt | goverview screen --proxy http://127.0.0.1:8080 \n\n"
 
+	h += "  # Probe and detect technologies without screenshot\n"
+	h += "  cat http_lists.txt | goverview probe -N --detect-tech --json\n\n"
+	h += "  # Detect internal products with custom rules (JSON files in the directory)\n"
+	h += "  cat http_lists.txt | goverview probe -N --rules ~/rules/ --json\n\n"
+
+	h += "  # Update technology database from a local mirror\n"
+	h += "  goverview update-tech --source http://127.0.0.1:8000/technologies.json\n\n"
+
 	h += "  # Do screenshot and store JSON Output\n"
 	h += "  cat http_lists.txt | goverview screen -c 5 --json\n\n"
 
+	h += "  # Do screenshot with 2 browser processes and 10 tabs in parallel\n"
+	h += "  cat http_lists.txt | goverview screen -c 10 -t 10 --browsers 2 --json\n\n"
+
 	h += "  # Do screenshot based on success HTTP site \n"
 	h += "  cat overview/target.com-http-overview.txt | jq -r '. | select(.status==\"200\") | .url' | goverview screen -c 5 -o overview -S overview/target.com-screen.txt\n\n"
 
+	h += "  # Do screenshot as desktop and phone in one run\n"
+	h += "  cat http_lists.txt | goverview screen --device desktop --device phone --json\n\n"
+
+	h += "  # Do viewport only screenshot as jpeg with small thumbnails\n"
+	h += "  cat http_lists.txt | goverview screen --format jpeg --quality 70 --full-page=false --thumb-width 240 --json\n\n"
+
+	h += "  # Wait for single-page apps to render before capture\n"
+	h += "  cat http_lists.txt | goverview screen --wait-idle 500 --wait-selector '#root > *' --wait-timeout 10 --json\n\n"
+
+	h += "  # Do screenshot with a session cookie and dismiss cookie banner after load\n"
+	h += "  cat http_lists.txt | goverview screen --cookies cookies.txt --js-after dismiss-banner.js --json\n\n"
+
+	h += "  # Do screenshot and save a HAR file of every request made by the page\n"
+	h += "  cat http_lists.txt | goverview screen --har --json\n\n"
+
+	h += "  # Do screenshot with a shared headless Chrome container\n"
+	h += "  cat http_lists.txt | goverview screen --chrome-url ws://127.0.0.1:9222 --json\n\n"
+
 	h += "  # Do screenshot and generated report \n"
 	h += "  cat http-shopee.io.txt| goverview screen --json -o /tmp/screenshot/ \n"
 	h += "  goverview report -o /tmp/screenshot/\n\n"
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"github.com/j3ssie/goverview/core"
+	"github.com/j3ssie/goverview/libs"
 	"github.com/j3ssie/goverview/utils"
 	"github.com/panjf2000/ants"
 	"strings"
@@ -20,12 +21,33 @@ func init() {
 
 	// screen options
 	screenCmd.Flags().BoolVar(&options.AbsPath, "A", false, "Use Absolute path in summary")
+	screenCmd.Flags().StringVar(&options.Fin.RulesDir, "rules", "", "Directory of custom fingerprint rules (*.json), checked with the --tech file")
 	screenCmd.Flags().BoolVar(&options.Screen.UseChromedp, "cdp", true, "Use old chromedp instead of rod")
 	screenCmd.Flags().BoolVar(&options.Screen.UseRod, "rod", false, "Use rod library")
 	screenCmd.Flags().IntVar(&options.Screen.ScreenTimeout, "screen-timeout", 40, "screenshot timeout")
-	screenCmd.Flags().IntVar(&options.Screen.ImgHeight, "height", 0, "Height screenshot")
-	screenCmd.Flags().IntVar(&options.Screen.ImgWidth, "width", 0, "Width screenshot")
-	screenCmd.Flags().IntVar(&options.Screen.Retry, "retry", 3, "retry screenshot")
+	screenCmd.Flags().IntVar(&options.Screen.ImgHeight, "height", 0, "Height of viewport (override the device profile)")
+	screenCmd.Flags().IntVar(&options.Screen.ImgWidth, "width", 0, "Width of viewport (override the device profile)")
+	screenCmd.Flags().StringSliceVar(&options.Screen.Devices, "device", []string{"desktop"}, "Device profiles to capture: desktop, tablet, phone (Multiple --device flags are accepted)")
+	screenCmd.Flags().StringVar(&options.Screen.Format, "format", "png", "Screenshot format: png, jpeg, webp")
+	screenCmd.Flags().IntVar(&options.Screen.Quality, "quality", 90, "Screenshot quality for jpeg and webp (1-100)")
+	screenCmd.Flags().BoolVar(&options.Screen.FullPage, "full-page", true, "Capture the full page instead of the viewport only (--full-page=false for viewport)")
+	screenCmd.Flags().IntVar(&options.Screen.ThumbWidth, "thumb-width", 320, "Width of thumbnail next to each screenshot (0 to disable)")
+	screenCmd.Flags().IntVar(&options.Screen.WaitIdle, "wait-idle", 0, "Wait until no network request for N milliseconds before capture")
+	screenCmd.Flags().IntVar(&options.Screen.WaitDelay, "wait-delay", 0, "Wait a fixed N milliseconds before capture")
+	screenCmd.Flags().StringVar(&options.Screen.WaitSelector, "wait-selector", "", "Wait until CSS selector appear before capture (e.g: '#app .loaded')")
+	screenCmd.Flags().StringVar(&options.Screen.WaitJS, "wait-js", "", "Wait until JS expression is true before capture (e.g: 'window.appReady')")
+	screenCmd.Flags().IntVar(&options.Screen.WaitTimeout, "wait-timeout", 10, "Timeout in seconds of each wait condition, capture anyway when reached")
+	screenCmd.Flags().StringVar(&options.Screen.CookieFile, "cookies", "", "Cookie file in Netscape cookies.txt or JSON format to set before loading the page")
+	screenCmd.Flags().StringVar(&options.Screen.JSBeforeFile, "js-before", "", "JS file to run before page scripts on every document")
+	screenCmd.Flags().StringVar(&options.Screen.JSAfterFile, "js-after", "", "JS file to run after page load, the returned value is saved in the output")
+	screenCmd.Flags().BoolVar(&options.Screen.HAR, "har", false, "Save a HAR file of all requests made by the page next to the screenshot")
+	screenCmd.Flags().StringVar(&options.Screen.UserAgent, "user-agent", "", "Custom user agent (override the device profile)")
+	screenCmd.Flags().IntVar(&options.Screen.Retry, "retry", 3, "Number of retry for failed capture")
+	screenCmd.Flags().StringSliceVar(&options.Screen.RetryOn, "retry-on", []string{core.ClassBrowserError, core.ClassTimeout}, "Classes of capture to retry: blank, browser-error, timeout (blank is not retried by default, many pages are really empty)")
+	screenCmd.Flags().IntVar(&options.Screen.Browsers, "browsers", 2, "Number of browser processes, tabs are bounded by --threads")
+	screenCmd.Flags().IntVar(&options.Screen.Recycle, "recycle", 100, "Relaunch a browser after this number of pages (0 to disable)")
+	screenCmd.Flags().StringVar(&options.Screen.ChromeURL, "chrome-url", "", "Connect to a running Chrome via DevTools URL instead of launching one (e.g: ws://127.0.0.1:9222)")
+	screenCmd.Flags().StringVar(&options.Screen.ChromePath, "chrome-path", "", "Path to Chrome/Chromium binary to launch")
 	RootCmd.AddCommand(screenCmd)
 }
 
@@ -36,6 +58,17 @@ func runScreen(_ *cobra.Command, _ []string) error {
 	if err == nil {
 		options.Fin.Loaded = true
 	}
+	if err := core.LoadRules(options); err != nil {
+		return err
+	}
+
+	if err := prepareScreen(); err != nil {
+		return err
+	}
+
+	devices := core.GetDevices(options)
+	pool := core.NewBrowserPool(options)
+	defer pool.Close()
 
 	var wg sync.WaitGroup
 	p, _ := ants.NewPoolWithFunc(options.Concurrency, func(i interface{}) {
@@ -45,22 +78,25 @@ func runScreen(_ *cobra.Command, _ []string) error {
 		if strings.TrimSpace(job) == "" {
 			return
 		}
-		if options.InputAsBurp {
-			job = core.ParseBurpRequest(job)
+		req, err := parseJob(job)
+		if err != nil {
+			utils.ErrorF("Error parsing input: %v", err)
+			return
 		}
 
-		utils.InforF("[screenshot] %v", job)
+		for _, device := range devices {
+			utils.InforF("[screenshot] %v %v - %v", req.Method, req.URL, device.Name)
+			out := doScreen(pool, req, device)
 
-		out := doScreen(job)
-
-		if out != "" {
-			fmt.Println(out)
-			core.AppendTo(options.ScreenShotFile, out)
+			if out != "" {
+				fmt.Println(out)
+				core.AppendTo(options.ScreenShotFile, out)
+			}
 		}
 	}, ants.WithPreAlloc(true))
 	defer p.Release()
 
-	for _, raw := range inputs {
+	for _, raw := range expandInputs() {
 		wg.Add(1)
 		err := p.Invoke(raw)
 		if err != nil {
@@ -72,27 +108,45 @@ func runScreen(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
-func doScreen(job string) string {
-	var out string
-
-	if options.Screen.UseChromedp {
-		out = core.DoScreenshot(options, job)
-	} else {
-		out = core.NewDoScreenshot(options, job)
+// prepareScreen load cookies and scripts for screenshot
+func prepareScreen() error {
+	if options.Screen.CookieFile != "" {
+		cookies, err := core.LoadCookies(options.Screen.CookieFile)
+		if err != nil {
+			utils.ErrorF("Error loading cookies: %v", err)
+			return err
+		}
+		utils.InforF("Loaded %v cookies from: %v", len(cookies), options.Screen.CookieFile)
+		options.Screen.Cookies = cookies
 	}
 
-	if out == "" {
-		for i := 0; i < options.Retry; i++ {
-			if options.Screen.UseChromedp {
-				out = core.DoScreenshot(options, job)
-			} else {
-				out = core.NewDoScreenshot(options, job)
-			}
-			if out != "" {
-				return out
-			}
+	if options.Screen.JSBeforeFile != "" {
+		if !utils.FileExists(options.Screen.JSBeforeFile) {
+			return fmt.Errorf("JS file not found: %v", options.Screen.JSBeforeFile)
 		}
+		options.Screen.JSBefore = utils.GetFileContent(options.Screen.JSBeforeFile)
 	}
+	if options.Screen.JSAfterFile != "" {
+		if !utils.FileExists(options.Screen.JSAfterFile) {
+			return fmt.Errorf("JS file not found: %v", options.Screen.JSAfterFile)
+		}
+		options.Screen.JSAfter = utils.GetFileContent(options.Screen.JSAfterFile)
+	}
+	return nil
+}
 
-	return out
+func doScreen(pool *core.BrowserPool, req libs.Request, device core.Device) string {
+	screen := takeScreen(pool, req, device)
+	for i := 0; i < options.Screen.Retry && core.ShouldRetry(options, screen.Class); i++ {
+		utils.DebugF("retry %v screenshot: %v - %v", screen.Class, req.URL, device.Name)
+		screen = takeScreen(pool, req, device)
+	}
+	return core.PrintScreen(options, screen)
+}
+
+func takeScreen(pool *core.BrowserPool, req libs.Request, device core.Device) core.Screen {
+	if core.UseRod(options) {
+		return core.NewDoScreenshot(options, pool, req, device)
+	}
+	return core.DoScreenshot(options, pool, req, device)
 }
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/j3ssie/goverview/core"
+	"github.com/j3ssie/goverview/utils"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var updateTechCmd = &cobra.Command{
+		Use:   "update-tech",
+		Short: "Update technology database used by fingerprint",
+		RunE:  runUpdateTech,
+	}
+	updateTechCmd.Flags().StringVarP(&options.Fin.Source, "source", "s", "", "Required: URL, local HTTP mirror or local path of technologies file (URL ending with / or directory for split a.json ... z.json files), upstream Wappalyzer doesn't publish it anymore")
+	updateTechCmd.MarkFlagRequired("source")
+	updateTechCmd.Flags().BoolVar(&options.Fin.DryRun, "dry-run", false, "Only report the changes without saving")
+	RootCmd.AddCommand(updateTechCmd)
+}
+
+func runUpdateTech(_ *cobra.Command, _ []string) error {
+	utils.InforF("Fetching technologies from: %v", options.Fin.Source)
+	data, err := core.FetchTechs(options.Fin.Source)
+	if err != nil {
+		utils.ErrorF("Error fetching technologies: %v", err)
+		return err
+	}
+
+	latest, err := core.ParseTechs(data)
+	if err != nil {
+		utils.ErrorF("Invalid technologies file: %v", err)
+		return err
+	}
+
+	// compare with the file LoadTechs would use
+	current := new(core.WebAnalyzer)
+	currentFile, err := core.ResolveTechFile(options)
+	if err != nil {
+		utils.ErrorF("%v", err)
+		return err
+	}
+	if err := current.LoadApps(currentFile); err != nil {
+		utils.WarningF("Error loading current technologies: %v", err)
+		current = nil
+	}
+	if currentFile == "" {
+		currentFile = "embedded"
+	}
+
+	diff := core.DiffApps(current, latest)
+	for _, name := range diff.Added {
+		fmt.Printf("[+] %v\n", name)
+	}
+	for _, name := range diff.Removed {
+		fmt.Printf("[-] %v\n", name)
+	}
+	for _, name := range diff.Changed {
+		fmt.Printf("[~] %v\n", name)
+	}
+	utils.InforF("Compared with %v: %v added, %v removed, %v changed", currentFile, len(diff.Added), len(diff.Removed), len(diff.Changed))
+
+	if options.Fin.DryRun {
+		return nil
+	}
+	techFile, err := core.SaveTechs(data)
+	if err != nil {
+		utils.ErrorF("Error saving technologies: %v", err)
+		return err
+	}
+	utils.GoodF("Saved %v technologies to: %v", len(latest.AppDefs.Apps), techFile)
+	return nil
+}
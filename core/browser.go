@@ -0,0 +1,291 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/chromedp/cdproto/browser"
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/chromedp"
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// BrowserPool a fixed number of browser processes shared by all screenshots
+type BrowserPool struct {
+	options  libs.Options
+	useRod   bool
+	recycle  int
+	tabs     chan struct{}
+	mu       sync.Mutex
+	next     int
+	browsers []*Browser
+	// closed when the browser of the slot is launched
+	starting []chan struct{}
+}
+
+// Browser a browser process managed by the pool
+type Browser struct {
+	// chromedp engine
+	allocCancel context.CancelFunc
+	ctx         context.Context
+	cancel      context.CancelFunc
+	// rod engine
+	rod      *rod.Browser
+	launcher *launcher.Launcher
+
+	pages   int
+	active  int
+	broken  bool
+	retired bool
+}
+
+// UseRod check if rod engine is selected instead of chromedp
+func UseRod(options libs.Options) bool {
+	return options.Screen.UseRod || !options.Screen.UseChromedp
+}
+
+// NewBrowserPool create browser pool, browsers are launched on demand
+func NewBrowserPool(options libs.Options) *BrowserPool {
+	threads := options.Threads
+	if threads <= 0 {
+		threads = 1
+	}
+	size := options.Screen.Browsers
+	if size <= 0 {
+		size = 1
+	}
+	if size > threads {
+		size = threads
+	}
+	return &BrowserPool{
+		options:  options,
+		useRod:   UseRod(options),
+		recycle:  options.Screen.Recycle,
+		tabs:     make(chan struct{}, threads),
+		browsers: make([]*Browser, size),
+		starting: make([]chan struct{}, size),
+	}
+}
+
+// Acquire get a browser to open a new tab, block when all tabs are in used
+func (p *BrowserPool) Acquire() (*Browser, error) {
+	p.tabs <- struct{}{}
+	p.mu.Lock()
+
+	i := p.next % len(p.browsers)
+	p.next++
+	// wait for the browser of this slot being launched by another tab
+	for p.starting[i] != nil {
+		starting := p.starting[i]
+		p.mu.Unlock()
+		<-starting
+		p.mu.Lock()
+	}
+
+	b := p.browsers[i]
+	if b != nil && (b.broken || (p.recycle > 0 && b.pages >= p.recycle)) {
+		// the process is closed once its last tab is done
+		utils.DebugF("Recycle browser after %v pages (broken: %v)", b.pages, b.broken)
+		b.retired = true
+		if b.active == 0 {
+			b.close()
+		}
+		p.browsers[i] = nil
+		b = nil
+	}
+
+	if b == nil {
+		// reserve the slot, launching a browser takes seconds so it is done without the lock
+		starting := make(chan struct{})
+		p.starting[i] = starting
+		p.mu.Unlock()
+
+		var err error
+		if p.useRod {
+			b, err = p.launchRod()
+		} else {
+			b, err = p.launchChromedp()
+		}
+
+		p.mu.Lock()
+		p.starting[i] = nil
+		close(starting)
+		if err != nil {
+			p.mu.Unlock()
+			<-p.tabs
+			return nil, err
+		}
+		p.browsers[i] = b
+	}
+	b.active++
+	b.pages++
+	p.mu.Unlock()
+	return b, nil
+}
+
+// Release give the tab back to the pool, browser is checked if the tab failed
+func (p *BrowserPool) Release(b *Browser, err error) {
+	if err != nil && !b.alive() {
+		utils.DebugF("Browser crashed: %v", err)
+		p.mu.Lock()
+		b.broken = true
+		p.mu.Unlock()
+	}
+
+	p.mu.Lock()
+	b.active--
+	if b.retired && b.active == 0 {
+		b.close()
+	}
+	p.mu.Unlock()
+	<-p.tabs
+}
+
+// Close close all browsers of the pool
+func (p *BrowserPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, b := range p.browsers {
+		if b != nil {
+			b.close()
+			p.browsers[i] = nil
+		}
+	}
+}
+
+func (p *BrowserPool) launchChromedp() (*Browser, error) {
+	opts := append(chromedp.DefaultExecAllocatorOptions[:],
+		chromedp.Flag("headless", true),
+		chromedp.Flag("ignore-certificate-errors", true),
+		chromedp.Flag("disable-gpu", true),
+		chromedp.Flag("enable-automation", true),
+		chromedp.Flag("disable-extensions", true),
+		chromedp.Flag("disable-setuid-sandbox", true),
+		chromedp.Flag("disable-web-security", true),
+		chromedp.Flag("no-first-run", true),
+		chromedp.Flag("no-default-browser-check", true),
+	)
+	if p.options.Proxy != "" {
+		opts = append(opts, chromedp.ProxyServer(p.options.Proxy))
+	}
+	if p.options.Screen.ChromePath != "" {
+		opts = append(opts, chromedp.ExecPath(p.options.Screen.ChromePath))
+	}
+
+	var allocCtx context.Context
+	var allocCancel context.CancelFunc
+	if p.options.Screen.ChromeURL != "" {
+		// only our tabs are closed when the allocator is cancelled
+		allocCtx, allocCancel = chromedp.NewRemoteAllocator(context.Background(), p.options.Screen.ChromeURL)
+	} else {
+		allocCtx, allocCancel = chromedp.NewExecAllocator(context.Background(), opts...)
+	}
+	ctx, cancel := chromedp.NewContext(allocCtx, chromedp.WithLogf(log.Printf))
+	// start the browser process
+	if err := chromedp.Run(ctx); err != nil {
+		cancel()
+		allocCancel()
+		return nil, fmt.Errorf("launch browser: %v", err)
+	}
+	utils.DebugF("Launched new chromedp browser")
+	return &Browser{allocCancel: allocCancel, ctx: ctx, cancel: cancel}, nil
+}
+
+func (p *BrowserPool) launchRod() (*Browser, error) {
+	if p.options.Screen.ChromeURL != "" {
+		return p.connectRod()
+	}
+
+	l := launcher.New().Headless(true).Set("ignore-certificate-errors")
+	if p.options.Proxy != "" {
+		l = l.Proxy(p.options.Proxy)
+	}
+	if p.options.Screen.ChromePath != "" {
+		l = l.Bin(p.options.Screen.ChromePath)
+	}
+	controlURL, err := l.Launch()
+	if err != nil {
+		return nil, fmt.Errorf("launch browser: %v", err)
+	}
+
+	rb := rod.New().ControlURL(controlURL)
+	if err := rb.Connect(); err != nil {
+		l.Kill()
+		l.Cleanup()
+		return nil, fmt.Errorf("connect browser: %v", err)
+	}
+	rb.IgnoreCertErrors(true)
+	utils.DebugF("Launched new rod browser")
+	return &Browser{rod: rb, launcher: l}, nil
+}
+
+// connectRod connect to a running Chrome, pages are opened in an incognito context
+// so closing it never kill the shared browser
+func (p *BrowserPool) connectRod() (*Browser, error) {
+	controlURL, err := launcher.ResolveURL(p.options.Screen.ChromeURL)
+	if err != nil {
+		return nil, fmt.Errorf("resolve chrome url: %v", err)
+	}
+	rb := rod.New().ControlURL(controlURL)
+	if err := rb.Connect(); err != nil {
+		return nil, fmt.Errorf("connect browser: %v", err)
+	}
+	incognito, err := rb.Incognito()
+	if err != nil {
+		return nil, fmt.Errorf("create browser context: %v", err)
+	}
+	incognito.IgnoreCertErrors(true)
+	utils.DebugF("Connected to remote browser at %v", controlURL)
+	return &Browser{rod: incognito}, nil
+}
+
+// NewTab open a new chromedp tab, cancel it to close the tab
+func (b *Browser) NewTab() (context.Context, context.CancelFunc) {
+	return chromedp.NewContext(b.ctx)
+}
+
+// NewPage open a new rod page
+func (b *Browser) NewPage() (*rod.Page, error) {
+	return b.rod.Page(proto.TargetCreateTarget{URL: "about:blank"})
+}
+
+// alive check if browser process still responds
+func (b *Browser) alive() bool {
+	if b.rod != nil {
+		_, err := proto.BrowserGetVersion{}.Call(b.rod)
+		return err == nil
+	}
+
+	if b.ctx.Err() != nil {
+		return false
+	}
+	c := chromedp.FromContext(b.ctx)
+	if c == nil || c.Browser == nil {
+		return false
+	}
+	ctx, cancel := context.WithTimeout(b.ctx, 5*time.Second)
+	defer cancel()
+	_, _, _, _, _, err := browser.GetVersion().Do(cdp.WithExecutor(ctx, c.Browser))
+	return err == nil
+}
+
+func (b *Browser) close() {
+	if b.rod != nil {
+		b.rod.Close()
+		if b.launcher != nil {
+			b.launcher.Kill()
+			b.launcher.Cleanup()
+		}
+		return
+	}
+	// the allocator remove its user data dir when cancelled
+	b.cancel()
+	b.allocCancel()
+}
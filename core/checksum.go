@@ -5,10 +5,12 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/j3ssie/goverview/libs"
 	"github.com/j3ssie/goverview/utils"
+	"net/http"
 	"path"
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/PuerkitoBio/goquery"
 	jsoniter "github.com/json-iterator/go"
@@ -16,16 +18,25 @@ import (
 
 // Overview overview data
 type Overview struct {
-	URL           string `json:"url"`
-	Title         string `json:"title"`
-	CheckSum      string `json:"checksum"`
-	ContentFile   string `json:"content_file"`
-	Status        string `json:"status"`
-	ResponseTime  string `json:"time"`
-	ContentLength string `json:"length"`
-	Redirect      string `json:"redirect"`
-	Headers       string `json:"headers"`
-	Favicon       string `json:"favicon"`
+	URL           string          `json:"url"`
+	Method        string          `json:"method"`
+	Title         string          `json:"title"`
+	CheckSum      string          `json:"checksum"`
+	SimHash       string          `json:"simhash"`
+	ContentFile   string          `json:"content_file"`
+	Status        string          `json:"status"`
+	ResponseTime  string          `json:"time"`
+	ContentLength string          `json:"length"`
+	Redirect      string          `json:"redirect"`
+	RedirectChain []libs.Redirect `json:"redirect_chain,omitempty"`
+	FinalURL      string          `json:"final_url"`
+	CrossOrigin   bool            `json:"cross_origin"`
+	TLS           *libs.TLSInfo   `json:"tls,omitempty"`
+	RemoteAddr    string          `json:"remote_addr"`
+	DNS           *libs.DNSInfo   `json:"dns,omitempty"`
+	Headers       string          `json:"headers"`
+	Favicon       string          `json:"favicon"`
+	Technologies  Technologies    `json:"technologies,omitempty"`
 }
 
 // PrintOverview print probe string
@@ -35,26 +46,50 @@ func PrintOverview(options libs.Options, overview Overview) string {
 			return data
 		}
 	}
+	var fields []string
 	// more detail when no output file
 	if options.NoOutput || options.Probe.OnlySummary {
-		return fmt.Sprintf("%v ;; %v ;; %v ;; %v ;; %v ;; %v", overview.URL, overview.Title, overview.CheckSum, overview.Status, overview.ContentLength, overview.Redirect)
+		fields = []string{overview.URL, overview.Title, overview.CheckSum, overview.Status, overview.ContentLength, overview.Redirect}
+	} else if options.SaveRedirectURL {
+		fields = []string{overview.URL, overview.Title, overview.CheckSum, overview.ContentFile, overview.Redirect}
+	} else {
+		fields = []string{overview.URL, overview.Title, overview.CheckSum, overview.ContentFile}
 	}
 
-	if options.SaveRedirectURL {
-		return fmt.Sprintf("%v ;; %v ;; %v ;; %v ;; %v", overview.URL, overview.Title, overview.CheckSum, overview.ContentFile, overview.Redirect)
+	// optional columns are only added when enabled
+	if options.Probe.SimHash {
+		simHash := overview.SimHash
+		if simHash == "" {
+			simHash = "No-SimHash"
+		}
+		fields = append(fields, simHash)
+	}
+	if options.Fin.Enable {
+		techs := overview.Technologies.String()
+		if techs == "" {
+			techs = "No-Tech"
+		}
+		fields = append(fields, techs)
 	}
-	return fmt.Sprintf("%v ;; %v ;; %v ;; %v", overview.URL, overview.Title, overview.CheckSum, overview.ContentFile)
+	return strings.Join(fields, " ;; ")
 }
 
-func CalcCheckSum(options libs.Options, url string, res libs.Response) Overview {
+// CalcCheckSum calculate checksum of the response
+func CalcCheckSum(options libs.Options, req libs.Request, res libs.Response) Overview {
 	var result string
 	var err error
+	url := req.URL
+	method := req.Method
+	if method == "" {
+		method = "GET"
+	}
 
 	title := "No-Title"
 	hash := "No-CheckSum"
 	contentFile := "No-Content"
 	overview := Overview{
 		URL:         url,
+		Method:      method,
 		Title:       title,
 		CheckSum:    "",
 		ContentFile: "",
@@ -69,12 +104,29 @@ func CalcCheckSum(options libs.Options, url string, res libs.Response) Overview
 		overview.Redirect = res.Location
 	}
 
-	overview.Status = fmt.Sprintf("%d", res.StatusCode)
-	overview.ResponseTime = fmt.Sprintf("%v", res.ResponseTime)
-	overview.ContentLength = fmt.Sprintf("%v", res.Length)
+	overview.TLS = res.TLS
+	overview.RemoteAddr = res.RemoteAddr
 
-	if res.Location != "" {
-		overview.Redirect = res.Location
+	// redirect chain
+	overview.RedirectChain = res.Redirects
+	overview.FinalURL = url
+	if res.FinalURL != "" {
+		overview.FinalURL = res.FinalURL
+	}
+	var redirectContent string
+	for _, hop := range res.Redirects {
+		marker := ""
+		if IsCrossOrigin(hop.URL, hop.Location) {
+			overview.CrossOrigin = true
+			marker = " (cross-origin)"
+		}
+		redirectContent += fmt.Sprintf("> Redirect: %v %v -> %v%v\n", hop.StatusCode, hop.URL, hop.Location, marker)
+	}
+	if len(res.Redirects) > 0 {
+		redirectContent += fmt.Sprintf("> Cross-Origin: %v\n", overview.CrossOrigin)
+		if options.Redirect {
+			redirectContent += fmt.Sprintf("> Final URL: %v\n", overview.FinalURL)
+		}
 	}
 
 	// store response
@@ -87,7 +139,7 @@ func CalcCheckSum(options libs.Options, url string, res libs.Response) Overview
 		contentFile = fmt.Sprintf("%v.txt", strings.Replace(url, "://", "___", -1))
 		contentFile = strings.Replace(contentFile, "?", "_", -1)
 		contentFile = strings.Replace(contentFile, "/", "_", -1)
-		content = fmt.Sprintf("> GET %v\n%v", url, content)
+		content = fmt.Sprintf("> %v %v\n%v%v", method, url, redirectContent, content)
 		contentFile = path.Join(options.ContentOutput, contentFile)
 		utils.DebugF("contentFile: %v", contentFile)
 		_, err = WriteToFile(contentFile, content)
@@ -119,6 +171,7 @@ func CalcCheckSum(options libs.Options, url string, res libs.Response) Overview
 	}
 	title = GetTitle(doc)
 	hash = GenHash(fmt.Sprintf("%v-%v", title, result))
+	overview.SimHash = SimHash(doc)
 
 	// wordlist builder
 	if options.Probe.WordsSummary {
@@ -133,6 +186,8 @@ func CalcCheckSum(options libs.Options, url string, res libs.Response) Overview
 		result = ParseDocLevel1(options, doc)
 	case 2:
 		result = ParseDocLevel2(options, doc)
+	case 5:
+		result = ParseResponseLevel5(options, res)
 	}
 	if result != "" {
 		hash = GenHash(fmt.Sprintf("%v-%v", title, result))
@@ -147,18 +202,29 @@ func CalcCheckSum(options libs.Options, url string, res libs.Response) Overview
 }
 
 // Sending send request and calculate checksum
-func Sending(options libs.Options, url string, client *resty.Client) string {
+func Sending(options libs.Options, req libs.Request, client *resty.Client) string {
 
-	res, err := JustSend(options, url, client)
+	res, err := JustSend(options, req, client)
 	if err != nil {
 		utils.DebugF("Headers: \n%v", res.BeautifyHeader)
 		utils.DebugF("Body: \n%v", res.Beautify)
-		utils.ErrorF("Error sending: %v", url)
+		utils.ErrorF("Error sending: %v %v", req.Method, req.URL)
 		return ""
 	}
 
-	overview := CalcCheckSum(options, url, res)
-	favIconHashed := GetFavHash(url)
+	overview := CalcCheckSum(options, req, res)
+	if options.ResolveDNS {
+		if host, err := utils.GetDomain(req.URL); err == nil {
+			overview.DNS = ResolveHost(options, host)
+		}
+	}
+	if options.Fin.Enable && options.Fin.Loaded {
+		if res.FinalURL == "" {
+			res.FinalURL = req.URL
+		}
+		overview.Technologies = ToTechnologies(FingerPrint(res))
+	}
+	favIconHashed := GetFavHash(req.URL)
 	if favIconHashed != "" {
 		overview.Favicon = favIconHashed
 	}
@@ -245,3 +311,100 @@ func ParseDocLevel2(options libs.Options, doc *goquery.Document) string {
 	}
 	return strings.Join(result, "-")
 }
+
+// checksumHeaders headers that are used to do checksum level 5
+var checksumHeaders = []string{
+	"Content-Type",
+	"Server",
+	"X-Powered-By",
+	"Location",
+	"Www-Authenticate",
+	"Set-Cookie",
+}
+
+// volatileRegex value that changes on every response of the same page
+type volatileRegex struct {
+	R       *regexp.Regexp
+	Replace string
+}
+
+// volatilePatterns built-in volatile values to strip before do checksum
+var volatilePatterns = []volatileRegex{
+	// RFC 1123 date e.g: Mon, 02 Jan 2006 15:04:05 GMT
+	{regexp.MustCompile(`(?i)(mon|tue|wed|thu|fri|sat|sun), \d{1,2}[ -][a-z]{3}[ -]\d{2,4} \d{2}:\d{2}:\d{2}( [a-z]{3})?`), "volatile"},
+	// ISO 8601 date e.g: 2006-01-02T15:04:05.000Z
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}(:\d{2})?(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`), "volatile"},
+	// CSRF tokens in attributes, meta tags or JS objects
+	{regexp.MustCompile(`(?i)((csrf|xsrf|authenticity|verification)[\w-]*["']?(\s+(content|value)=|\s*[:=])\s*["']?)[^"'\s>]+`), "${1}volatile"},
+	{regexp.MustCompile(`(?i)((content|value)=["']?)[^"'\s>]+(["']?[^>]*name=["']?[\w-]*(csrf|xsrf|authenticity|verification))`), "${1}volatile${3}"},
+	// nonce of script/style tag and CSP header
+	{regexp.MustCompile(`(?i)(nonce[=-]["']?)[\w+/=-]+`), "${1}volatile"},
+	// request IDs as UUID
+	{regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`), "volatile"},
+	// unix timestamp in second or millisecond
+	{regexp.MustCompile(`\b1\d{9}(\d{3})?\b`), "volatile"},
+}
+
+// StripVolatile replace volatile values so identical pages still have same checksum
+func StripVolatile(options libs.Options, raw string) string {
+	if options.StripVolatile {
+		for _, v := range volatilePatterns {
+			raw = v.R.ReplaceAllString(raw, v.Replace)
+		}
+	}
+
+	for _, pattern := range options.StripPatterns {
+		r, ok := stripRegexes.Load(pattern)
+		if !ok {
+			if err := CompileStripPatterns([]string{pattern}); err != nil {
+				continue
+			}
+			r, _ = stripRegexes.Load(pattern)
+		}
+		raw = r.(*regexp.Regexp).ReplaceAllString(raw, "volatile")
+	}
+	return raw
+}
+
+// stripRegexes compiled custom strip patterns
+var stripRegexes sync.Map
+
+// CompileStripPatterns compile custom strip patterns once, invalid pattern is an error
+func CompileStripPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		r, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid strip pattern %v: %v", pattern, err)
+		}
+		stripRegexes.Store(pattern, r)
+	}
+	return nil
+}
+
+// ParseResponseLevel5 calculate Hash based on entire HTTP response
+func ParseResponseLevel5(options libs.Options, res libs.Response) string {
+	result := []string{fmt.Sprintf("%d", res.StatusCode)}
+
+	headers := make(map[string][]string)
+	for _, header := range res.Headers {
+		for key, value := range header {
+			key = http.CanonicalHeaderKey(key)
+			// only cookie names are stable
+			if key == "Set-Cookie" {
+				value = strings.SplitN(value, "=", 2)[0]
+			}
+			headers[key] = append(headers[key], value)
+		}
+	}
+	for _, key := range checksumHeaders {
+		values, ok := headers[key]
+		if !ok {
+			continue
+		}
+		sort.Strings(values)
+		result = append(result, fmt.Sprintf("%v: %v", key, StripVolatile(options, strings.Join(values, ","))))
+	}
+
+	result = append(result, StripVolatile(options, res.Body))
+	return strings.Join(result, "\n")
+}
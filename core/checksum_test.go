@@ -1,5 +1,16 @@
 package core
 
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
 //func TestCalcCheckSum(t *testing.T) {
 //	var options libs.Options
 //	options.Level = 5
@@ -59,3 +70,83 @@ package core
 //		t.Errorf("Error CalcCheckSum")
 //	}
 //}
+
+func TestParseResponseLevel5(t *testing.T) {
+	var options libs.Options
+	options.Level = 5
+	page := `<html><head><meta name="csrf-token" content="%s"><script nonce="%s">var ts = %s;</script></head><body>Generated at %s, request %s</body></html>`
+	first := libs.Response{
+		StatusCode: 200,
+		Headers: []map[string]string{
+			{"Content-Type": "text/html"},
+			{"Date": "Mon, 02 Jan 2006 15:04:05 GMT"},
+			{"Set-Cookie": "session=abc; Path=/"},
+		},
+		Body: fmt.Sprintf(page, "aGVsbG8gd29ybGQ", "r4nd0m", "1633024800", "2021-10-01T10:00:00Z", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	second := libs.Response{
+		StatusCode: 200,
+		Headers: []map[string]string{
+			{"Content-Type": "text/html"},
+			{"Date": "Tue, 03 Jan 2006 10:00:00 GMT"},
+			{"Set-Cookie": "session=xyz; Path=/"},
+		},
+		Body: fmt.Sprintf(page, "Z29vZGJ5ZQ", "n0nc3", "1633024999123", "2021-10-02T11:22:33.123+07:00", "a0b1c2d3-e89b-12d3-a456-426614174999"),
+	}
+
+	if ParseResponseLevel5(options, first) == ParseResponseLevel5(options, second) {
+		t.Errorf("Error ParseResponseLevel5 should differ without --strip-volatile")
+	}
+
+	options.StripVolatile = true
+	if a, b := ParseResponseLevel5(options, first), ParseResponseLevel5(options, second); a != b {
+		t.Errorf("Error ParseResponseLevel5 should collide:\n%v\n%v", a, b)
+	}
+
+	second.StatusCode = 404
+	if ParseResponseLevel5(options, first) == ParseResponseLevel5(options, second) {
+		t.Errorf("Error ParseResponseLevel5 should differ on status")
+	}
+}
+
+func TestStripVolatileCustom(t *testing.T) {
+	var options libs.Options
+	options.StripPatterns = []string{`build-\d+`}
+	if result := StripVolatile(options, "app build-1234"); result != "app volatile" {
+		t.Errorf("Error StripVolatile: %v", result)
+	}
+	if err := CompileStripPatterns([]string{`build-(\d+`}); err == nil {
+		t.Errorf("Error invalid strip pattern should fail")
+	}
+}
+
+func TestCrossOriginContent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goverview-content")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var options libs.Options
+	options.Redirect = true
+	options.ContentOutput = dir
+	req := libs.Request{URL: "http://example.com/login", Method: "GET"}
+	res := libs.Response{
+		StatusCode:     200,
+		FinalURL:       "https://sso.example.org/",
+		BeautifyHeader: "< HTTP/1.1 200 OK",
+		Redirects: []libs.Redirect{
+			{URL: "http://example.com/login", StatusCode: 301, Location: "https://example.com/login"},
+			{URL: "https://example.com/login", StatusCode: 302, Location: "https://sso.example.org/"},
+		},
+	}
+	overview := CalcCheckSum(options, req, res)
+	if !overview.CrossOrigin {
+		t.Errorf("Error cross origin redirect is not flagged")
+	}
+
+	content := utils.GetFileContent(overview.ContentFile)
+	if !strings.Contains(content, "> Redirect: 302 https://example.com/login -> https://sso.example.org/ (cross-origin)\n") || !strings.Contains(content, "> Cross-Origin: true\n") {
+		t.Errorf("Error cross origin in content file: %v", content)
+	}
+}
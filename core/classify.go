@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"image"
+	"strings"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// Class of a capture
+const (
+	ClassOK           = "ok"
+	ClassBlank        = "blank"
+	ClassBrowserError = "browser-error"
+	ClassTimeout      = "timeout"
+)
+
+// blankRatio capture with more than this ratio of pixels in the same color is blank
+const blankRatio = 0.98
+
+// browserErrorMarkers DOM markers of Chrome error pages and certificate interstitial
+var browserErrorMarkers = []string{
+	`id="main-frame-error"`,
+	`class="neterror"`,
+	`chrome-error://chromewebdata`,
+	`class="interstitial-wrapper"`,
+	`id="security-interstitial`,
+}
+
+// ClassifyError get class of a failed capture
+func ClassifyError(err error) string {
+	if err == nil {
+		return ClassOK
+	}
+	if errors.Is(err, context.DeadlineExceeded) || strings.Contains(err.Error(), "deadline exceeded") {
+		return ClassTimeout
+	}
+	return ClassBrowserError
+}
+
+// ClassifyCapture get class of a capture based on the final DOM and the uniform color ratio of image
+func ClassifyCapture(html string, uniform float64) string {
+	for _, marker := range browserErrorMarkers {
+		if strings.Contains(html, marker) {
+			return ClassBrowserError
+		}
+	}
+	if uniform >= blankRatio {
+		return ClassBlank
+	}
+	return ClassOK
+}
+
+// UniformRatio ratio of pixels having the dominant color
+func UniformRatio(src image.Image) float64 {
+	bounds := src.Bounds()
+	width, height := 64, 64
+	if bounds.Dx() < width {
+		width = bounds.Dx()
+	}
+	if bounds.Dy() < height {
+		height = bounds.Dy()
+	}
+	small := resizeImage(src, bounds, width, height)
+
+	// quantize colors so the noise of jpeg is ignored
+	counts := make(map[uint32]int)
+	dominant := 0
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := small.RGBAAt(x, y)
+			key := uint32(c.R>>4)<<8 | uint32(c.G>>4)<<4 | uint32(c.B>>4)
+			counts[key]++
+			if counts[key] > dominant {
+				dominant = counts[key]
+			}
+		}
+	}
+	return float64(dominant) / float64(width*height)
+}
+
+// ShouldRetry check if the class of capture is one of the classes to retry
+func ShouldRetry(options libs.Options, class string) bool {
+	if class == "" || class == ClassOK {
+		return false
+	}
+	return utils.StringInSlice(class, options.Screen.RetryOn)
+}
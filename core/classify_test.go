@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestClassifyCapture(t *testing.T) {
+	// white page with a small loading spinner
+	blank := image.NewRGBA(image.Rect(0, 0, 800, 600))
+	for y := 0; y < 600; y++ {
+		for x := 0; x < 800; x++ {
+			c := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			if x > 390 && x < 410 && y > 290 && y < 310 {
+				c = color.RGBA{R: 100, G: 100, B: 100, A: 255}
+			}
+			blank.Set(x, y, c)
+		}
+	}
+	uniform := UniformRatio(blank)
+	if uniform < blankRatio {
+		t.Errorf("Error uniform ratio of blank page: %v", uniform)
+	}
+	if class := ClassifyCapture("<html><body><div class=\"spinner\"></div></body></html>", uniform); class != ClassBlank {
+		t.Errorf("Error blank class: %v", class)
+	}
+
+	page := drawPage(800, 600, 10)
+	if class := ClassifyCapture("<html><body><h1>Welcome</h1></body></html>", UniformRatio(page)); class != ClassOK {
+		t.Errorf("Error ok class: %v", class)
+	}
+
+	chromeError := `<html><body class="neterror" id="t"><div id="main-frame-error" class="interstitial-wrapper">ERR_CONNECTION_REFUSED</div></body></html>`
+	if class := ClassifyCapture(chromeError, 0.5); class != ClassBrowserError {
+		t.Errorf("Error browser-error class: %v", class)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	if ClassifyError(nil) != ClassOK {
+		t.Errorf("Error nil error class")
+	}
+	if class := ClassifyError(fmt.Errorf("navigate: %w", context.DeadlineExceeded)); class != ClassTimeout {
+		t.Errorf("Error timeout class: %v", class)
+	}
+	if class := ClassifyError(fmt.Errorf("page load error net::ERR_NAME_NOT_RESOLVED")); class != ClassBrowserError {
+		t.Errorf("Error browser-error class: %v", class)
+	}
+
+	var opt libs.Options
+	opt.Screen.RetryOn = []string{ClassTimeout}
+	if !ShouldRetry(opt, ClassTimeout) || ShouldRetry(opt, ClassBlank) || ShouldRetry(opt, ClassOK) {
+		t.Errorf("Error ShouldRetry")
+	}
+}
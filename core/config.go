@@ -7,8 +7,10 @@ import (
 )
 
 // InitConfig init config
-func InitConfig(options *libs.Options) {
+func InitConfig(options *libs.Options) error {
 	if options.TmpDir == "" {
 		options.TmpDir = path.Join(os.TempDir(), "goverview-log")
 	}
+	RateLimiter = NewLimiter(*options)
+	return CompileStripPatterns(options.StripPatterns)
 }
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+const (
+	// maxEventSample number of messages kept as sample for each kind of event
+	maxEventSample = 5
+	// maxEventLength max length of each sample message
+	maxEventLength = 300
+)
+
+// EventSummary count of page events with a few samples
+type EventSummary struct {
+	Count  int      `json:"count"`
+	Sample []string `json:"sample,omitempty"`
+}
+
+// PageEvents collect console messages, JS exceptions and dialogs of a page
+type PageEvents struct {
+	mu         sync.Mutex
+	Console    EventSummary
+	Exceptions EventSummary
+	Dialogs    EventSummary
+}
+
+// NewPageEvents create new page events collector
+func NewPageEvents() *PageEvents {
+	return &PageEvents{}
+}
+
+// AddConsole record a console message like "error: something wrong"
+func (p *PageEvents) AddConsole(level string, args []string) {
+	p.add(&p.Console, fmt.Sprintf("%s: %s", level, strings.Join(args, " ")))
+}
+
+// AddException record an uncaught JS exception
+func (p *PageEvents) AddException(text string) {
+	p.add(&p.Exceptions, text)
+}
+
+// AddDialog record a dialog like alert, confirm or prompt
+func (p *PageEvents) AddDialog(dialogType, message string) {
+	p.add(&p.Dialogs, fmt.Sprintf("%s: %s", dialogType, message))
+}
+
+func (p *PageEvents) add(summary *EventSummary, message string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	summary.Count++
+	if len(summary.Sample) >= maxEventSample {
+		return
+	}
+	message = strings.TrimSpace(message)
+	if len(message) > maxEventLength {
+		message = message[:maxEventLength] + "..."
+	}
+	summary.Sample = append(summary.Sample, message)
+}
+
+// Apply store summary of events to screen
+func (p *PageEvents) Apply(screen *Screen) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.Console.Count > 0 {
+		console := p.Console
+		screen.Console = &console
+	}
+	if p.Exceptions.Count > 0 {
+		exceptions := p.Exceptions
+		screen.Exceptions = &exceptions
+	}
+	if p.Dialogs.Count > 0 {
+		dialogs := p.Dialogs
+		screen.Dialogs = &dialogs
+		screen.HasPopUp = true
+	}
+}
+
+// remoteObjectText get readable text of a console argument
+func remoteObjectText(value []byte, description string, unserializable string) string {
+	if description != "" {
+		return description
+	}
+	if unserializable != "" {
+		return unserializable
+	}
+	if len(value) == 0 {
+		return "undefined"
+	}
+	var str string
+	if err := jsoniter.Unmarshal(value, &str); err == nil {
+		return str
+	}
+	return string(value)
+}
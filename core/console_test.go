@@ -0,0 +1,58 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPageEvents(t *testing.T) {
+	events := NewPageEvents()
+	for i := 0; i < 8; i++ {
+		events.AddConsole("log", []string{"loading", "chunk"})
+	}
+	events.AddException(strings.Repeat("A", 1000))
+	events.AddDialog("alert", "1")
+
+	var screen Screen
+	events.Apply(&screen)
+	if screen.Console == nil || screen.Console.Count != 8 || len(screen.Console.Sample) != maxEventSample {
+		t.Fatalf("Error console summary: %+v", screen.Console)
+	}
+	if screen.Console.Sample[0] != "log: loading chunk" {
+		t.Errorf("Error console sample: %v", screen.Console.Sample[0])
+	}
+	if screen.Exceptions == nil || len(screen.Exceptions.Sample[0]) > maxEventLength+3 {
+		t.Errorf("Error exception should be truncated: %+v", screen.Exceptions)
+	}
+	if !screen.HasPopUp || screen.Dialogs.Sample[0] != "alert: 1" {
+		t.Errorf("Error dialog summary: %+v", screen.Dialogs)
+	}
+
+	// nothing happened
+	var blank Screen
+	NewPageEvents().Apply(&blank)
+	if blank.Console != nil || blank.Exceptions != nil || blank.Dialogs != nil || blank.HasPopUp {
+		t.Errorf("Error blank events: %+v", blank)
+	}
+}
+
+func TestRemoteObjectText(t *testing.T) {
+	cases := []struct {
+		value          string
+		description    string
+		unserializable string
+		want           string
+	}{
+		{`"hello"`, "", "", "hello"},
+		{`42`, "42", "", "42"},
+		{``, "Error: boom\n    at app.js:1", "", "Error: boom\n    at app.js:1"},
+		{``, "", "NaN", "NaN"},
+		{`true`, "", "", "true"},
+		{``, "", "", "undefined"},
+	}
+	for _, c := range cases {
+		if got := remoteObjectText([]byte(c.value), c.description, c.unserializable); got != c.want {
+			t.Errorf("Error remoteObjectText(%q): %q", c.value, got)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// jsonCookie cookie exported by browser extensions like EditThisCookie or Cookie-Editor
+type jsonCookie struct {
+	Name           string  `json:"name"`
+	Value          string  `json:"value"`
+	Domain         string  `json:"domain"`
+	Path           string  `json:"path"`
+	Expires        float64 `json:"expires"`
+	ExpirationDate float64 `json:"expirationDate"`
+	Secure         bool    `json:"secure"`
+	HTTPOnly       bool    `json:"httpOnly"`
+	SameSite       string  `json:"sameSite"`
+}
+
+// LoadCookies load cookies from Netscape cookies.txt or JSON cookie file
+func LoadCookies(filename string) ([]libs.Cookie, error) {
+	if !utils.FileExists(filename) {
+		return nil, fmt.Errorf("cookie file not found: %v", filename)
+	}
+	raw := strings.TrimSpace(utils.GetFileContent(filename))
+	if strings.HasPrefix(raw, "[") || strings.HasPrefix(raw, "{") {
+		return ParseJSONCookies(raw)
+	}
+	return ParseNetscapeCookies(raw)
+}
+
+// ParseJSONCookies parse list of cookie or a single cookie in JSON format
+func ParseJSONCookies(raw string) ([]libs.Cookie, error) {
+	var items []jsonCookie
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "{") {
+		raw = "[" + raw + "]"
+	}
+	if err := jsoniter.UnmarshalFromString(raw, &items); err != nil {
+		return nil, err
+	}
+
+	var cookies []libs.Cookie
+	for _, item := range items {
+		if item.Name == "" {
+			continue
+		}
+		cookie := libs.Cookie{
+			Name:     item.Name,
+			Value:    item.Value,
+			Domain:   item.Domain,
+			Path:     item.Path,
+			Expires:  item.Expires,
+			Secure:   item.Secure,
+			HTTPOnly: item.HTTPOnly,
+			SameSite: normalizeSameSite(item.SameSite),
+		}
+		if cookie.Expires <= 0 {
+			cookie.Expires = item.ExpirationDate
+		}
+		cookies = append(cookies, cookie)
+	}
+	return cookies, nil
+}
+
+// ParseNetscapeCookies parse cookies.txt format:
+// domain  include-subdomains  path  secure  expiry  name  value
+func ParseNetscapeCookies(raw string) ([]libs.Cookie, error) {
+	var cookies []libs.Cookie
+	for _, line := range strings.Split(raw, "\n") {
+		line = strings.TrimRight(line, "\r")
+		httpOnly := false
+		if strings.HasPrefix(line, "#HttpOnly_") {
+			httpOnly = true
+			line = strings.TrimPrefix(line, "#HttpOnly_")
+		}
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 7 {
+			return nil, fmt.Errorf("invalid cookie line: %v", line)
+		}
+		expires, _ := strconv.ParseFloat(fields[4], 64)
+		cookies = append(cookies, libs.Cookie{
+			Domain:   fields[0],
+			Path:     fields[2],
+			Secure:   strings.EqualFold(fields[3], "TRUE"),
+			Expires:  expires,
+			Name:     fields[5],
+			Value:    strings.Join(fields[6:], "\t"),
+			HTTPOnly: httpOnly,
+		})
+	}
+	return cookies, nil
+}
+
+// normalizeSameSite convert sameSite value to the one Chrome accept
+func normalizeSameSite(raw string) string {
+	switch strings.ToLower(raw) {
+	case "strict":
+		return "Strict"
+	case "lax":
+		return "Lax"
+	case "none", "no_restriction":
+		return "None"
+	}
+	return ""
+}
+
+// scriptFunction wrap user script as async function so it can use return and await
+func scriptFunction(js string) string {
+	return fmt.Sprintf("async () => {\n%s\n}", js)
+}
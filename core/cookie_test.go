@@ -0,0 +1,57 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestLoadCookies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goverview-cookie")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	netscape := path.Join(dir, "cookies.txt")
+	ioutil.WriteFile(netscape, []byte("# Netscape HTTP Cookie File\n\n"+
+		".example.com\tTRUE\t/\tTRUE\t1893456000\tsession\tabc=123\n"+
+		"#HttpOnly_example.com\tFALSE\t/admin\tFALSE\t0\ttoken\txyz\n"), 0644)
+	cookies, err := LoadCookies(netscape)
+	if err != nil {
+		t.Fatalf("Error LoadCookies: %v", err)
+	}
+	if len(cookies) != 2 {
+		t.Fatalf("Error netscape cookies: %v", cookies)
+	}
+	if cookies[0].Domain != ".example.com" || cookies[0].Value != "abc=123" || !cookies[0].Secure || cookies[0].Expires != 1893456000 {
+		t.Errorf("Error netscape cookie: %+v", cookies[0])
+	}
+	if !cookies[1].HTTPOnly || cookies[1].Path != "/admin" || cookies[1].Secure {
+		t.Errorf("Error netscape http only cookie: %+v", cookies[1])
+	}
+
+	jsonFile := path.Join(dir, "cookies.json")
+	ioutil.WriteFile(jsonFile, []byte(`[
+  {"domain": ".example.com", "expirationDate": 1893456000, "httpOnly": true, "name": "sid", "path": "/", "sameSite": "no_restriction", "secure": true, "value": "s3cr3t"},
+  {"name": "lang", "value": "en"}
+]`), 0644)
+	cookies, err = LoadCookies(jsonFile)
+	if err != nil {
+		t.Fatalf("Error LoadCookies: %v", err)
+	}
+	if len(cookies) != 2 {
+		t.Fatalf("Error json cookies: %v", cookies)
+	}
+	if cookies[0].SameSite != "None" || cookies[0].Expires != 1893456000 || !cookies[0].HTTPOnly {
+		t.Errorf("Error json cookie: %+v", cookies[0])
+	}
+	if params := chromedpCookies(cookies, "https://example.com/"); params[1].URL != "https://example.com/" || params[0].URL != "" {
+		t.Errorf("Error cookie without domain should belong to the url")
+	}
+
+	if _, err := LoadCookies(path.Join(dir, "missing.txt")); err == nil {
+		t.Errorf("Error LoadCookies should fail on missing file")
+	}
+}
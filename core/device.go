@@ -0,0 +1,80 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// DefaultUserAgent user agent of desktop profile
+const DefaultUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_3) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/80.0.3987.132 Safari/537.36"
+
+// Device emulation profile for screenshot
+type Device struct {
+	Name      string
+	Width     int
+	Height    int
+	Scale     float64
+	Mobile    bool
+	UserAgent string
+}
+
+// Devices named device profiles
+var Devices = map[string]Device{
+	"desktop": {
+		Name:      "desktop",
+		Width:     1440,
+		Height:    900,
+		Scale:     1,
+		UserAgent: DefaultUserAgent,
+	},
+	"tablet": {
+		Name:      "tablet",
+		Width:     768,
+		Height:    1024,
+		Scale:     2,
+		Mobile:    true,
+		UserAgent: "Mozilla/5.0 (iPad; CPU OS 15_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1",
+	},
+	"phone": {
+		Name:      "phone",
+		Width:     390,
+		Height:    844,
+		Scale:     3,
+		Mobile:    true,
+		UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 15_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.0 Mobile/15E148 Safari/604.1",
+	},
+}
+
+// GetDevices get device profiles to capture, --width --height --user-agent override the profiles
+func GetDevices(options libs.Options) []Device {
+	var devices []Device
+	for _, name := range options.Screen.Devices {
+		name = strings.ToLower(strings.TrimSpace(name))
+		device, ok := Devices[name]
+		if !ok {
+			utils.ErrorF("Unknown device profile: %v", name)
+			continue
+		}
+		devices = append(devices, overrideDevice(options, device))
+	}
+
+	if len(devices) == 0 {
+		devices = append(devices, overrideDevice(options, Devices["desktop"]))
+	}
+	return devices
+}
+
+func overrideDevice(options libs.Options, device Device) Device {
+	if options.Screen.ImgWidth > 0 {
+		device.Width = options.Screen.ImgWidth
+	}
+	if options.Screen.ImgHeight > 0 {
+		device.Height = options.Screen.ImgHeight
+	}
+	if options.Screen.UserAgent != "" {
+		device.UserAgent = options.Screen.UserAgent
+	}
+	return device
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestGetDevices(t *testing.T) {
+	var opt libs.Options
+	devices := GetDevices(opt)
+	if len(devices) != 1 || devices[0].Name != "desktop" {
+		t.Errorf("Error default device: %v", devices)
+	}
+
+	opt.Screen.Devices = []string{"desktop", "Phone", "unknown"}
+	opt.Screen.ImgWidth = 1024
+	devices = GetDevices(opt)
+	if len(devices) != 2 {
+		t.Fatalf("Error GetDevices: %v", devices)
+	}
+	phone := devices[1]
+	if phone.Name != "phone" || !phone.Mobile || phone.Width != 1024 || phone.Height != Devices["phone"].Height {
+		t.Errorf("Error phone device: %+v", phone)
+	}
+
+	opt.Screen.UserAgent = "custom-agent"
+	for _, device := range GetDevices(opt) {
+		if device.UserAgent != "custom-agent" {
+			t.Errorf("Error override user agent: %+v", device)
+		}
+	}
+
+	desktopImage, _ := screenFiles(opt, "https://example.com/a", devices[0])
+	phoneImage, _ := screenFiles(opt, "https://example.com/a", phone)
+	if desktopImage == phoneImage {
+		t.Errorf("Error device should get different file: %v", phoneImage)
+	}
+}
@@ -0,0 +1,213 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// dnsCache cache DNS result per host as many URLs share the same host
+var dnsCache sync.Map
+
+// privateRanges internal network ranges
+var privateRanges = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"100.64.0.0/10",
+	"0.0.0.0/8",
+	"::1/128",
+	"fc00::/7",
+	"fe80::/10",
+}
+
+// ParseResolvers get list of resolver address from flags or files
+func ParseResolvers(raws []string) []string {
+	var resolvers []string
+	for _, raw := range raws {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		if utils.FileExists(raw) {
+			resolvers = append(resolvers, ParseResolvers(utils.ReadingLines(raw))...)
+			continue
+		}
+		if _, _, err := net.SplitHostPort(raw); err != nil {
+			raw = net.JoinHostPort(strings.Trim(raw, "[]"), "53")
+		}
+		resolvers = append(resolvers, raw)
+	}
+	return resolvers
+}
+
+// systemResolvers get nameservers from /etc/resolv.conf
+func systemResolvers() []string {
+	var resolvers []string
+	for _, line := range utils.ReadingLines("/etc/resolv.conf") {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && fields[0] == "nameserver" {
+			resolvers = append(resolvers, fields[1])
+		}
+	}
+	return ParseResolvers(resolvers)
+}
+
+// NewResolver build resolver that use custom resolvers if they are provided
+func NewResolver(options libs.Options) *net.Resolver {
+	resolvers := ParseResolvers(options.Resolvers)
+	if len(resolvers) == 0 {
+		return net.DefaultResolver
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			d := net.Dialer{Timeout: time.Duration(options.Timeout) * time.Second}
+			return d.DialContext(ctx, network, resolvers[rand.Intn(len(resolvers))])
+		},
+	}
+}
+
+// ResolveHost get A, AAAA records and the CNAME chain of host
+func ResolveHost(options libs.Options, host string) *libs.DNSInfo {
+	if host == "" || net.ParseIP(host) != nil {
+		return nil
+	}
+	if cached, ok := dnsCache.Load(host); ok {
+		return cached.(*libs.DNSInfo)
+	}
+
+	resolvers := ParseResolvers(options.Resolvers)
+	if len(resolvers) == 0 {
+		resolvers = systemResolvers()
+	}
+
+	info := &libs.DNSInfo{}
+	resolved := false
+	// start at a random resolver and fail over to the others
+	offset := 0
+	if len(resolvers) > 0 {
+		offset = rand.Intn(len(resolvers))
+	}
+	for i := 0; i < len(resolvers) && !resolved; i++ {
+		resolver := resolvers[(offset+i)%len(resolvers)]
+		for _, qType := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+			if err := queryDNS(options, resolver, host, qType, info); err != nil {
+				utils.DebugF("DNS query %v at %v err: %v", host, resolver, err)
+				continue
+			}
+			resolved = true
+		}
+	}
+
+	// fall back to the standard resolver
+	if !resolved {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(options.Timeout)*time.Second)
+		defer cancel()
+		resolver := NewResolver(options)
+		if cname, err := resolver.LookupCNAME(ctx, host); err == nil && strings.TrimSuffix(cname, ".") != host {
+			info.CNAME = append(info.CNAME, strings.TrimSuffix(cname, "."))
+		}
+		ips, _ := resolver.LookupIPAddr(ctx, host)
+		for _, ip := range ips {
+			if ip.IP.To4() != nil {
+				info.A = append(info.A, ip.IP.String())
+			} else {
+				info.AAAA = append(info.AAAA, ip.IP.String())
+			}
+		}
+	}
+
+	for _, ip := range append(info.A, info.AAAA...) {
+		if IsPrivateIP(ip) {
+			info.Private = true
+		}
+	}
+	dnsCache.Store(host, info)
+	return info
+}
+
+// queryDNS send a single DNS question and parse answers to info
+func queryDNS(options libs.Options, resolver string, host string, qType dnsmessage.Type, info *libs.DNSInfo) error {
+	name, err := dnsmessage.NewName(strings.TrimSuffix(host, ".") + ".")
+	if err != nil {
+		return err
+	}
+	msg := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: uint16(rand.Intn(65535)), RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  name,
+			Type:  qType,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	packed, err := msg.Pack()
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("udp", resolver, time.Duration(options.Timeout)*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Duration(options.Timeout) * time.Second))
+	if _, err := conn.Write(packed); err != nil {
+		return err
+	}
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	if err != nil {
+		return err
+	}
+
+	var reply dnsmessage.Message
+	if err := reply.Unpack(buf[:n]); err != nil {
+		return err
+	}
+	if reply.ID != msg.ID {
+		return fmt.Errorf("mismatched reply id %v", reply.ID)
+	}
+	// NXDOMAIN is a real answer, it may still carry a dangling CNAME
+	if reply.RCode != dnsmessage.RCodeSuccess && reply.RCode != dnsmessage.RCodeNameError {
+		return fmt.Errorf("reply code %v", reply.RCode)
+	}
+	for _, answer := range reply.Answers {
+		switch body := answer.Body.(type) {
+		case *dnsmessage.CNAMEResource:
+			cname := strings.TrimSuffix(body.CNAME.String(), ".")
+			if !utils.StringInSlice(cname, info.CNAME) {
+				info.CNAME = append(info.CNAME, cname)
+			}
+		case *dnsmessage.AResource:
+			info.A = append(info.A, net.IP(body.A[:]).String())
+		case *dnsmessage.AAAAResource:
+			info.AAAA = append(info.AAAA, net.IP(body.AAAA[:]).String())
+		}
+	}
+	return nil
+}
+
+// IsPrivateIP check if IP belong to internal network ranges
+func IsPrivateIP(raw string) bool {
+	ip := net.ParseIP(raw)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range privateRanges {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// fakeDNS answer every A question with a CNAME chain to an internal IP,
+// or with the empty reply of rcode if it isn't success
+func fakeDNS(t *testing.T, rcode dnsmessage.RCode) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error listen: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var msg dnsmessage.Message
+			if err := msg.Unpack(buf[:n]); err != nil {
+				continue
+			}
+			q := msg.Questions[0]
+			reply := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: msg.ID, Response: true, RCode: rcode},
+				Questions: msg.Questions,
+			}
+			if q.Type == dnsmessage.TypeA && rcode == dnsmessage.RCodeSuccess {
+				first := dnsmessage.MustNewName("app.cdn.example.")
+				second := dnsmessage.MustNewName("edge.cdn.example.")
+				hdr := dnsmessage.ResourceHeader{Class: dnsmessage.ClassINET, TTL: 60}
+				hdr.Name, hdr.Type = q.Name, dnsmessage.TypeCNAME
+				reply.Answers = append(reply.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.CNAMEResource{CNAME: first}})
+				hdr.Name = first
+				reply.Answers = append(reply.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.CNAMEResource{CNAME: second}})
+				hdr.Name, hdr.Type = second, dnsmessage.TypeA
+				reply.Answers = append(reply.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.AResource{A: [4]byte{10, 1, 2, 3}}})
+			}
+			packed, _ := reply.Pack()
+			conn.WriteTo(packed, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestResolveHost(t *testing.T) {
+	var options libs.Options
+	options.Timeout = 3
+	options.Resolvers = []string{fakeDNS(t, dnsmessage.RCodeSuccess)}
+
+	info := ResolveHost(options, "www.goverview.test")
+	if info == nil {
+		t.Fatalf("Error ResolveHost")
+	}
+	if !reflect.DeepEqual(info.CNAME, []string{"app.cdn.example", "edge.cdn.example"}) {
+		t.Errorf("Error ResolveHost CNAME chain: %v", info.CNAME)
+	}
+	if !reflect.DeepEqual(info.A, []string{"10.1.2.3"}) || len(info.AAAA) != 0 || !info.Private {
+		t.Errorf("Error ResolveHost addresses: %v", info)
+	}
+}
+
+func TestResolveHostFailover(t *testing.T) {
+	// a closed port so queries to it fail right away
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error listen: %v", err)
+	}
+	dead := conn.LocalAddr().String()
+	conn.Close()
+
+	var options libs.Options
+	options.Timeout = 3
+	options.Resolvers = []string{dead, fakeDNS(t, dnsmessage.RCodeSuccess), dead}
+	info := ResolveHost(options, "failover.goverview.test")
+	if info == nil || !reflect.DeepEqual(info.A, []string{"10.1.2.3"}) {
+		t.Errorf("Error ResolveHost failover: %v", info)
+	}
+}
+
+func TestResolveHostServFail(t *testing.T) {
+	var options libs.Options
+	options.Timeout = 3
+	options.Resolvers = []string{fakeDNS(t, dnsmessage.RCodeServerFailure), fakeDNS(t, dnsmessage.RCodeSuccess)}
+	for i := 0; i < 5; i++ {
+		host := fmt.Sprintf("servfail-%d.goverview.test", i)
+		if info := ResolveHost(options, host); info == nil || !reflect.DeepEqual(info.A, []string{"10.1.2.3"}) {
+			t.Errorf("Error ResolveHost SERVFAIL failover: %v", info)
+		}
+	}
+
+	// NXDOMAIN is an answer, not a failure
+	options.Resolvers = []string{fakeDNS(t, dnsmessage.RCodeNameError)}
+	var info libs.DNSInfo
+	if err := queryDNS(options, options.Resolvers[0], "nx.goverview.test", dnsmessage.TypeA, &info); err != nil {
+		t.Errorf("Error NXDOMAIN should not fail: %v", err)
+	}
+	if err := queryDNS(options, fakeDNS(t, dnsmessage.RCodeRefused), "nx.goverview.test", dnsmessage.TypeA, &info); err == nil {
+		t.Errorf("Error REFUSED should fail")
+	}
+}
+
+func TestParseResolvers(t *testing.T) {
+	result := ParseResolvers([]string{"1.1.1.1", "8.8.8.8:5353", "2001:4860:4860::8888"})
+	expected := []string{"1.1.1.1:53", "8.8.8.8:5353", "[2001:4860:4860::8888]:53"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Error ParseResolvers: %v", result)
+	}
+}
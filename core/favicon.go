@@ -71,11 +71,14 @@ func BigResponseReq(baseUrl string) string {
 
 	req, _ := http.NewRequest("GET", baseUrl, nil)
 	req.Header.Add("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_3) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/80.0.3987.132 Safari/537.36")
+	release := RateLimiter.Wait(baseUrl)
 	resp, err := client.Do(req)
+	release()
 	if err != nil {
 		return ""
 	}
 	defer resp.Body.Close()
+	RateLimiter.Backoff(baseUrl, resp.StatusCode, resp.Header.Get("Retry-After"))
 
 	content, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
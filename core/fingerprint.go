@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
 	"path/filepath"
 	"regexp"
@@ -42,10 +43,12 @@ type SecretResult struct {
 
 // Match type encapsulates the App information from a match on a document
 type Match struct {
-	App     `json:"app"`
-	AppName string     `json:"app_name"`
-	Matches [][]string `json:"matches"`
-	Version string     `json:"version"`
+	App        `json:"app"`
+	AppName    string     `json:"app_name"`
+	Matches    [][]string `json:"matches"`
+	Version    string     `json:"version"`
+	Confidence int        `json:"confidence"`
+	Evidence   []Evidence `json:"evidence"`
 }
 
 func (m *Match) updateVersion(version string) {
@@ -54,32 +57,63 @@ func (m *Match) updateVersion(version string) {
 	}
 }
 
+// addEvidence record what the app was found by, each evidence adds its confidence
+func (m *Match) addEvidence(kind, name, value string, confidence int) {
+	if len(value) > 100 {
+		value = value[:100]
+	}
+	m.Evidence = append(m.Evidence, Evidence{Type: kind, Name: name, Value: value})
+	m.Confidence += confidence
+}
+
+// find run patterns on content, kind is where the content came from: html, header, script ...
+func (m *Match) find(kind string, content string, regexes []AppRegexp) {
+	for _, r := range regexes {
+		matches := r.Regexp.FindAllStringSubmatch(content, -1)
+		if matches == nil {
+			continue
+		}
+		m.Matches = append(m.Matches, matches...)
+		m.addEvidence(kind, r.Name, matches[0][0], r.Confidence)
+		if r.Version != "" {
+			m.updateVersion(FindVersion(matches, r.Version))
+		}
+	}
+}
+
 // LoadTechs load technology
 func LoadTechs(options libs.Options) error {
 	WA = new(WebAnalyzer)
-	if err := WA.LoadApps(options.Fin.TechFile); err != nil {
-		utils.ErrorF("Technology file not found: %s", options.Fin.TechFile)
+	techFile, err := ResolveTechFile(options)
+	if err != nil {
+		utils.ErrorF("%v", err)
+		return err
+	}
+	if techFile == "" {
+		utils.DebugF("Use the embedded technology file")
+	}
+	if err := WA.LoadApps(techFile); err != nil {
+		utils.ErrorF("Error loading technology file: %s - %v", techFile, err)
 		return err
 	}
 	utils.DebugF("Loaded %v of tech fingerprint", len(WA.AppDefs.Apps))
 	return nil
 }
 
-// LocalFingerPrint do fingerprint but from local file
-func LocalFingerPrint(options libs.Options, filename string) string {
+// LocalFingerPrint do fingerprint but from local file, custom rules are checked on the target
+func LocalFingerPrint(options libs.Options, filename string, target *RuleTarget) Technologies {
 	utils.DebugF("Fingerprint tech from: %s", filename)
 
 	if !utils.FileExists(filename) {
 		utils.ErrorF("content file not found: %s", options.Fin.TechFile)
-		return ""
+		return nil
 	}
 
 	if !options.Fin.Loaded {
 		utils.ErrorF("error loading technology from: %s", options.Fin.TechFile)
-		return ""
+		return nil
 	}
 
-	var err error
 	var results []Result
 	var mux sync.Mutex
 	c := colly.NewCollector(
@@ -126,18 +160,13 @@ func LocalFingerPrint(options libs.Options, filename string) string {
 
 	// Setup app technologies detector handle
 	c.OnResponse(func(response *colly.Response) {
-		var apps = make([]Match, 0)
 		var doc *goquery.Document
-		htmlResponse := false
 		jsFile := false
 
-		//fmt.Println(string(response.Body))
-
 		contentType := http.DetectContentType(response.Body)
 		if strings.Contains(contentType, "html") {
-			doc, err = goquery.NewDocumentFromReader(bytes.NewReader(response.Body))
-			if err == nil {
-				htmlResponse = true
+			if d, err := goquery.NewDocumentFromReader(bytes.NewReader(response.Body)); err == nil {
+				doc = d
 			}
 		} else {
 			if path.Ext(response.Request.URL.EscapedPath()) == ".js" {
@@ -145,180 +174,351 @@ func LocalFingerPrint(options libs.Options, filename string) string {
 			}
 		}
 
-		var scripts []string
-		if htmlResponse == true {
-			doc.Find("script").Each(func(i int, s *goquery.Selection) {
-				if script, exists := s.Attr("src"); exists {
-					scripts = append(scripts, script)
+		apps := analyzeApps(response.Request.URL.String(), *response.Headers, string(response.Body), doc, jsFile)
+		var result Result
+		if jsFile {
+			if v, ok := siteMap.Get(response.Request.URL.String()); ok {
+				result = Result{
+					Host:    v.(string),
+					Matches: apps,
 				}
-			})
+			}
+		}
+		if result.Host == "" {
+			result = Result{
+				Host:    response.Request.URL.String(),
+				Matches: apps,
+			}
 		}
 
-		// load Cookie info map
-		var cookiesMap = make(map[string]string)
-		for k, v := range *response.Headers {
-			hk := http.CanonicalHeaderKey(k)
-			if hk != "Set-Cookie" {
-				continue
+		mux.Lock()
+		results = append(results, result)
+		mux.Unlock()
+	})
+
+	c.Wait()
+
+	var matches []Match
+	for _, result := range results {
+		matches = append(matches, result.Matches...)
+	}
+	if target != nil {
+		matches = append(matches, MatchRules(target)...)
+	}
+
+	finalTech := ToTechnologies(ResolveMatches(matches))
+	if len(finalTech) == 0 {
+		utils.ErrorF("no tech found from: %s", filename)
+	}
+	return finalTech
+}
+
+// FingerPrint detect technologies from the response in memory
+func FingerPrint(res libs.Response) []Match {
+	if WA == nil || WA.AppDefs == nil {
+		return nil
+	}
+
+	var doc *goquery.Document
+	jsFile := false
+	if strings.Contains(res.ContentType, "html") || strings.Contains(http.DetectContentType([]byte(res.Body)), "html") {
+		if d, err := goquery.NewDocumentFromReader(strings.NewReader(res.Body)); err == nil {
+			doc = d
+		}
+	} else if strings.Contains(res.ContentType, "javascript") {
+		jsFile = true
+	} else if u, err := url.Parse(res.FinalURL); err == nil && path.Ext(u.Path) == ".js" {
+		jsFile = true
+	}
+
+	matches := analyzeApps(res.FinalURL, ToHTTPHeader(res.Headers), res.Body, doc, jsFile)
+	if len(Rules) > 0 {
+		target := NewRuleTarget(res)
+		matches = append(matches, MatchRules(&target)...)
+	}
+	return ResolveMatches(matches)
+}
+
+// uniqueMatches merge matches of the same app, keep the first found version
+func uniqueMatches(matches []Match) []Match {
+	var result []Match
+	index := make(map[string]int)
+	for _, match := range matches {
+		if match.Confidence > 100 {
+			match.Confidence = 100
+		}
+		if i, ok := index[match.AppName]; ok {
+			result[i].Matches = append(result[i].Matches, match.Matches...)
+			result[i].Evidence = append(result[i].Evidence, match.Evidence...)
+			if result[i].Version == "" {
+				result[i].Version = match.Version
 			}
-			for _, cookie := range v {
-				keyValues := strings.Split(cookie, ";")
-				keyValueSlice := strings.Split(keyValues[0], "=")
-				if len(keyValueSlice) > 1 {
-					key, value := keyValueSlice[0], keyValueSlice[1]
-					cookiesMap[key] = value
-				}
+			if result[i].Confidence += match.Confidence; result[i].Confidence > 100 {
+				result[i].Confidence = 100
 			}
+			continue
 		}
+		index[match.AppName] = len(result)
+		result = append(result, match)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].AppName < result[j].AppName
+	})
+	return result
+}
 
-		for appname, app := range WA.AppDefs.Apps {
-			findings := Match{
-				App:     app,
-				AppName: appname,
+// ToHTTPHeader convert list of header to http.Header
+func ToHTTPHeader(headers []map[string]string) http.Header {
+	result := make(http.Header)
+	for _, header := range headers {
+		for k, v := range header {
+			result.Add(k, v)
+		}
+	}
+	return result
+}
+
+// parseCookies get cookies from Set-Cookie headers
+func parseCookies(headers http.Header) map[string]string {
+	var cookiesMap = make(map[string]string)
+	for k, v := range headers {
+		hk := http.CanonicalHeaderKey(k)
+		if hk != "Set-Cookie" {
+			continue
+		}
+		for _, cookie := range v {
+			keyValues := strings.Split(cookie, ";")
+			keyValueSlice := strings.SplitN(keyValues[0], "=", 2)
+			if len(keyValueSlice) > 1 {
+				key, value := strings.TrimSpace(keyValueSlice[0]), keyValueSlice[1]
+				cookiesMap[key] = value
+			}
+		}
+	}
+	return cookiesMap
+}
+
+// ResolveMatches merge matches then apply requires, requiresCategory, excludes and implies of the apps
+func ResolveMatches(matches []Match) []Match {
+	matches = uniqueMatches(matches)
+
+	// drop apps whose required apps or categories are not detected, until nothing changes
+	for changed := true; changed; {
+		changed = false
+		detected := make(map[string]bool)
+		detectedCats := make(map[string]bool)
+		for _, match := range matches {
+			detected[match.AppName] = true
+			for _, cid := range match.App.Cats {
+				detectedCats[cid] = true
+			}
+		}
+
+		var kept []Match
+		for _, match := range matches {
+			if !requirementsMet(match.App, detected, detectedCats) {
+				changed = true
+				continue
+			}
+			kept = append(kept, match)
+		}
+		matches = kept
+	}
+
+	// add implied apps, implied apps can imply others too
+	seen := make(map[string]bool)
+	for _, match := range matches {
+		seen[match.AppName] = true
+	}
+	for i := 0; i < len(matches); i++ {
+		for _, implies := range matches[i].App.Implies {
+			name, confidence := patternValue(implies)
+			implyApp, ok := WA.AppDefs.Apps[name]
+			if !ok || seen[name] {
+				continue
+			}
+			if confidence > matches[i].Confidence {
+				confidence = matches[i].Confidence
+			}
+			seen[name] = true
+			implied := Match{
+				App:     implyApp,
+				AppName: name,
 				Matches: make([][]string, 0),
 			}
-			// check raw html
-			if m, v := FindMatches(string(response.Body), app.HTMLRegex); len(m) > 0 {
-				findings.Matches = append(findings.Matches, m...)
-				findings.updateVersion(v)
+			implied.addEvidence("implies", matches[i].AppName, "", confidence)
+			matches = append(matches, implied)
+		}
+	}
+	matches = uniqueMatches(matches)
+
+	// the most confident app wins over the apps it excludes
+	order := make([]Match, len(matches))
+	copy(order, matches)
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].Confidence > order[j].Confidence
+	})
+	excluded := make(map[string]bool)
+	for _, match := range order {
+		if excluded[match.AppName] {
+			continue
+		}
+		for _, exclude := range match.App.Excludes {
+			name, _ := patternValue(exclude)
+			if name != match.AppName {
+				excluded[name] = true
 			}
+		}
+	}
+
+	var result []Match
+	for _, match := range matches {
+		if !excluded[match.AppName] {
+			result = append(result, match)
+		}
+	}
+	return result
+}
 
-			// check response header
-			headerFindings, version := app.FindInHeaders(*response.Headers)
-			findings.Matches = append(findings.Matches, headerFindings...)
-			findings.updateVersion(version)
+// requirementsMet check requires and requiresCategory of an app
+func requirementsMet(app App, detected map[string]bool, detectedCats map[string]bool) bool {
+	for _, require := range app.Requires {
+		if name, _ := patternValue(require); !detected[name] {
+			return false
+		}
+	}
+	if len(app.RequiresCategory) == 0 {
+		return true
+	}
+	for _, cid := range app.RequiresCategory {
+		if detectedCats[cid] {
+			return true
+		}
+	}
+	return false
+}
 
-			// check url
-			if m, v := FindMatches(response.Request.URL.String(), app.URLRegex); len(m) > 0 {
-				findings.Matches = append(findings.Matches, m...)
-				findings.updateVersion(v)
+// analyzeApps match all apps against a response
+func analyzeApps(rawURL string, headers http.Header, body string, doc *goquery.Document, jsFile bool) []Match {
+	var apps = make([]Match, 0)
+
+	var scripts, inlineScripts []string
+	if doc != nil {
+		doc.Find("script").Each(func(i int, s *goquery.Selection) {
+			if script, exists := s.Attr("src"); exists {
+				scripts = append(scripts, script)
+			} else if content := s.Text(); strings.TrimSpace(content) != "" {
+				inlineScripts = append(inlineScripts, content)
 			}
+		})
+	}
 
-			if htmlResponse == true {
-				// check script tags
-				for _, script := range scripts {
-					if m, v := FindMatches(script, app.ScriptRegex); len(m) > 0 {
-						findings.Matches = append(findings.Matches, m...)
-						findings.updateVersion(v)
-					}
-				}
+	// load Cookie info map
+	cookiesMap := parseCookies(headers)
 
-				// check meta tags
-				for _, h := range app.MetaRegex {
-					selector := fmt.Sprintf("meta[name='%s']", h.Name)
-					doc.Find(selector).Each(func(i int, s *goquery.Selection) {
-						content, _ := s.Attr("content")
-						if m, v := FindMatches(content, []AppRegexp{h}); len(m) > 0 {
-							findings.Matches = append(findings.Matches, m...)
-							findings.updateVersion(v)
-						}
-						selector := fmt.Sprintf("meta[property='%s']", h.Name)
-						doc.Find(selector).Each(func(i int, s *goquery.Selection) {
-							content, _ := s.Attr("content")
-							if m, v := FindMatches(content, []AppRegexp{h}); len(m) > 0 {
-								findings.Matches = append(findings.Matches, m...)
-								findings.updateVersion(v)
-							}
-						})
-					})
+	for appname, app := range WA.AppDefs.Apps {
+		findings := Match{
+			App:     app,
+			AppName: appname,
+			Matches: make([][]string, 0),
+		}
+		// check raw html
+		findings.find("html", body, app.HTMLRegex)
+
+		// check response header
+		for _, hre := range app.HeaderRegex {
+			for _, headerValue := range headers.Values(hre.Name) {
+				if headerValue != "" {
+					findings.find("header", headerValue, []AppRegexp{hre})
 				}
 			}
+		}
 
-			if jsFile {
-				// check JS
-				for _, j := range app.JSRegex {
-					if j.Regexp != nil {
-						if strings.Contains(string(response.Body), j.Name) {
-							findings.Matches = append(findings.Matches, []string{j.Name})
-						}
-					}
-				}
+		// check url
+		findings.find("url", rawURL, app.URLRegex)
+
+		if doc != nil {
+			// check script tags
+			for _, script := range scripts {
+				findings.find("script", script, app.ScriptRegex)
+			}
+			for _, script := range inlineScripts {
+				findings.find("script", script, app.ScriptBodyRegex)
 			}
 
-			// check cookies
-			for _, c := range app.CookieRegex {
-				if _, ok := cookiesMap[c.Name]; ok {
-					// if there is a regexp set, ensure it matches.
-					// otherwise just add this as a match
-					if c.Regexp != nil {
-						// only match single AppRegexp on this specific cookie
-						if m, v := FindMatches(cookiesMap[c.Name], []AppRegexp{c}); len(m) > 0 {
-							findings.Matches = append(findings.Matches, m...)
-							findings.updateVersion(v)
-						}
-					} else {
-						findings.Matches = append(findings.Matches, []string{c.Name})
-					}
-				}
+			// check meta tags
+			for _, h := range app.MetaRegex {
+				selector := fmt.Sprintf("meta[name='%s'], meta[property='%s']", h.Name, h.Name)
+				doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+					content, _ := s.Attr("content")
+					findings.find("meta", content, []AppRegexp{h})
+				})
 			}
 
-			if len(findings.Matches) > 0 {
-				apps = append(apps, findings)
-
-				// handle implies
-				for _, implies := range app.Implies {
-					for implyAppname, implyApp := range WA.AppDefs.Apps {
-						if implies != implyAppname {
-							continue
-						}
-						f2 := Match{
-							App:     implyApp,
-							AppName: implyAppname,
-							Matches: make([][]string, 0),
-						}
-						apps = append(apps, f2)
-					}
-				}
+			// check DOM selectors
+			for _, d := range app.DOMRegex {
+				findings.findDOM(doc, d)
 			}
+		} else if jsFile {
+			// the whole body is a script
+			findings.find("script", body, app.ScriptBodyRegex)
 		}
-		var result Result
+
 		if jsFile {
-			if v, ok := siteMap.Get(response.Request.URL.String()); ok {
-				result = Result{
-					Host:    v.(string),
-					Matches: apps,
+			// check JS
+			for _, j := range app.JSRegex {
+				if j.Regexp != nil {
+					if strings.Contains(body, j.Name) {
+						findings.Matches = append(findings.Matches, []string{j.Name})
+						findings.addEvidence("js", j.Name, "", j.Confidence)
+					}
 				}
 			}
 		}
-		if result.Host == "" {
-			result = Result{
-				Host:    response.Request.URL.String(),
-				Matches: apps,
+
+		// check cookies
+		for _, c := range app.CookieRegex {
+			if _, ok := cookiesMap[c.Name]; ok {
+				// if there is a regexp set, ensure it matches.
+				// otherwise just add this as a match
+				if c.Regexp != nil {
+					// only match single AppRegexp on this specific cookie
+					findings.find("cookie", cookiesMap[c.Name], []AppRegexp{c})
+				} else {
+					findings.Matches = append(findings.Matches, []string{c.Name})
+					findings.addEvidence("cookie", c.Name, "", c.Confidence)
+				}
 			}
 		}
 
-		mux.Lock()
-		results = append(results, result)
-		mux.Unlock()
-	})
-
-	c.Wait()
-
-	var finalTech string
-	// set := treeset.NewWithStringComparator()
-	for _, result := range results {
-		for _, match := range result.Matches {
-			sort.Strings(match.CatNames)
-			app := fmt.Sprintf("%s", match.AppName)
-			if match.Version != "" {
-				app = fmt.Sprintf("%s/%s", match.AppName, match.Version)
-			}
-			finalTech += fmt.Sprintf("%s,", app)
-			// row := []string{
-			// 	app,
-			// 	strings.Join(match.CatNames, ","),
-			// }
-
-			// tech := strings.Join(row, "|")
-			// if !set.Contains(tech) {
-			// 	set.Add(tech)
-			// }
+		if len(findings.Matches) > 0 {
+			apps = append(apps, findings)
 		}
 	}
+	return apps
+}
 
-	if finalTech == "" {
-		utils.ErrorF("no tech found from: %s", filename)
+// findDOM check elements found by the selector with the DOM rule
+func (m *Match) findDOM(doc *goquery.Document, d DOMRegexp) {
+	selection := doc.Find(d.Selector)
+	if selection.Length() == 0 {
+		return
 	}
-	finalTech = strings.TrimRight(finalTech, ",")
-	return finalTech
+	if d.Exists {
+		m.Matches = append(m.Matches, []string{d.Selector})
+		m.addEvidence("dom", d.Selector, "", d.Confidence)
+	}
+	selection.Each(func(i int, s *goquery.Selection) {
+		if d.Text != nil {
+			text := *d.Text
+			text.Name = d.Selector
+			m.find("dom", s.Text(), []AppRegexp{text})
+		}
+		for _, attr := range d.Attributes {
+			if value, ok := s.Attr(attr.Name); ok {
+				attr.Name = fmt.Sprintf("%s[%s]", d.Selector, attr.Name)
+				m.find("dom", value, []AppRegexp{attr})
+			}
+		}
+	})
 }
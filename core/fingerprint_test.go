@@ -1,8 +1,15 @@
 package core
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/j3ssie/goverview/libs"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
 	"testing"
 )
 
@@ -11,10 +18,178 @@ func TestFingerprint(t *testing.T) {
 	opt.Fin.TechFile = "/tmp/technologies.json"
 	filename := "/tmp/uu"
 
-	result := LocalFingerPrint(opt, filename)
+	result := LocalFingerPrint(opt, filename, nil)
 	fmt.Println("finalTech --> ", result)
 
-	if result == "" {
+	if len(result) == 0 {
 		t.Errorf("Error TestFingerprint")
 	}
 }
+
+const testTechnologies = `{
+  "categories": {"1": {"name": "CMS"}, "22": {"name": "Web servers"}, "27": {"name": "Programming languages"}},
+  "technologies": {
+    "Nginx": {"cats": [22], "headers": {"Server": "nginx(?:/([\\d.]+))?\\;version:\\1"}, "website": "http://nginx.org"},
+    "PHP": {"cats": [27], "cookies": {"PHPSESSID": ""}, "headers": {"X-Powered-By": "^php/?([\\d.]+)?\\;version:\\1"}},
+    "WordPress": {"cats": [1], "html": "<link rel=[\"']stylesheet[\"'] [^>]+/wp-(?:content|includes)/", "meta": {"generator": "^WordPress ?([\\d.]+)?\\;version:\\1"}, "implies": "PHP"},
+    "jQuery": {"cats": [59], "scripts": "jquery", "script": "jquery(?:-(\\d+\\.\\d+\\.\\d+))[/.-]\\;version:\\1"}
+  }
+}`
+
+func loadTestTechs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goverview-tech")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	techFile := path.Join(dir, "technologies.json")
+	ioutil.WriteFile(techFile, []byte(testTechnologies), 0644)
+
+	var opt libs.Options
+	opt.Fin.TechFile = techFile
+	if err := LoadTechs(opt); err != nil {
+		t.Fatalf("Error LoadTechs: %v", err)
+	}
+}
+
+func TestFingerPrintResponse(t *testing.T) {
+	loadTestTechs(t)
+	res := libs.Response{
+		FinalURL:    "https://example.com/",
+		ContentType: "text/html; charset=UTF-8",
+		Headers: []map[string]string{
+			{"Server": "nginx/1.18.0"},
+			{"Set-Cookie": "PHPSESSID=abc; path=/"},
+		},
+		Body: `<html><head><meta name="generator" content="WordPress 5.8"><script src="/js/jquery-3.6.0.min.js"></script></head><body></body></html>`,
+	}
+	techs := ToTechnologies(FingerPrint(res)).String()
+	if techs != "Nginx/1.18.0,PHP,WordPress/5.8,jQuery/3.6.0" {
+		t.Errorf("Error FingerPrint: %v", techs)
+	}
+
+	evidences := make(map[string]string)
+	for _, tech := range ToTechnologies(FingerPrint(res)) {
+		if tech.Confidence != 100 || len(tech.Evidence) == 0 {
+			t.Errorf("Error technology: %+v", tech)
+			continue
+		}
+		evidences[tech.Name] = tech.Evidence[0].Type
+	}
+	if evidences["Nginx"] != "header" || evidences["PHP"] != "cookie" || evidences["WordPress"] != "meta" || evidences["jQuery"] != "script" {
+		t.Errorf("Error evidence of technologies: %v", evidences)
+	}
+
+	if techs := ToTechnologies(FingerPrint(libs.Response{FinalURL: "https://example.com/", Body: "plain text"})).String(); techs != "" {
+		t.Errorf("Error FingerPrint should not match: %v", techs)
+	}
+}
+
+func TestSendingTech(t *testing.T) {
+	loadTestTechs(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Server", "nginx/1.20.1")
+		w.Header().Set("X-Powered-By", "PHP/7.4.3")
+		fmt.Fprint(w, "<html><title>hello</title></html>")
+	}))
+	defer ts.Close()
+
+	var opt libs.Options
+	opt.NoOutput = true
+	opt.JsonOutput = true
+	opt.Timeout = 5
+	opt.Fin.Enable = true
+	opt.Fin.Loaded = true
+	out := Sending(opt, BuildRequest(opt, ts.URL), BuildClient(opt))
+	overview, err := ParseOverview(out)
+	if err != nil {
+		t.Fatalf("Error Sending: %v", err)
+	}
+	if overview.Technologies.String() != "Nginx/1.20.1,PHP/7.4.3" {
+		t.Errorf("Error technologies of overview: %v", overview.Technologies)
+	}
+	if tech := overview.Technologies[0]; tech.Website != "http://nginx.org" || tech.Categories[0] != "Web servers" || tech.Evidence[0].Type != "header" {
+		t.Errorf("Error structured technology: %+v", tech)
+	}
+
+	// text output keep the baseline columns with technologies after them
+	opt.JsonOutput = false
+	out = Sending(opt, BuildRequest(opt, ts.URL), BuildClient(opt))
+	if fields := strings.Split(out, " ;; "); len(fields) != 7 || fields[6] != "Nginx/1.20.1,PHP/7.4.3" {
+		t.Errorf("Error text output: %v", out)
+	}
+
+	// simhash stay parsable with technologies after it
+	opt.Probe.SimHash = true
+	out = Sending(opt, BuildRequest(opt, ts.URL), BuildClient(opt))
+	if !strings.HasSuffix(out, " ;; Nginx/1.20.1,PHP/7.4.3") {
+		t.Errorf("Error text output: %v", out)
+	}
+	if overview, _ := ParseOverview(out); overview.SimHash == "" {
+		t.Errorf("Error parse simhash from text output: %v", out)
+	}
+}
+
+func TestModernSchema(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goverview-tech")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// upstream layout: src/categories.json and src/technologies/{_,a-z}.json
+	techDir := path.Join(dir, "technologies")
+	os.MkdirAll(techDir, 0755)
+	ioutil.WriteFile(path.Join(dir, "categories.json"), []byte(`{"12": {"name": "JavaScript frameworks"}, "87": {"name": "WordPress plugins"}, "1": {"name": "CMS"}}`), 0644)
+	ioutil.WriteFile(path.Join(techDir, "a.json"), []byte(`{
+  "Angular": {"cats": [12], "dom": {"[ng-version]": {"attributes": {"ng-version": "^([\\d.]+)\\;version:\\1"}}}, "excludes": ["AngularJS"], "cpe": "cpe:2.3:a:angular:angular:*:*:*:*:*:*:*:*"},
+  "AngularJS": {"cats": [12], "scriptSrc": "angular(?:\\.min)?\\.js", "js": {"angular": ""}, "implies": "Zone.js\\;confidence:50"},
+  "Akismet": {"cats": [87], "scriptSrc": "akismet", "requires": "WordPress"},
+  "AMP Plugin": {"cats": [87], "meta": {"generator": ["^AMP Plugin v(\\d+)\\;version:\\1", "^AMP Framework"]}, "requiresCategory": 1}
+}`), 0644)
+	ioutil.WriteFile(path.Join(techDir, "z.json"), []byte(`{
+  "Zone.js": {"cats": [12], "scripts": "Zone\\.__symbol__\\;confidence:25"}
+}`), 0644)
+
+	var opt libs.Options
+	opt.Fin.TechFile = techDir
+	if err := LoadTechs(opt); err != nil {
+		t.Fatalf("Error LoadTechs: %v", err)
+	}
+	if len(WA.AppDefs.Apps) != 5 || WA.AppDefs.Apps["Angular"].CatNames[0] != "JavaScript frameworks" {
+		t.Fatalf("Error loading split technology files: %v", WA.AppDefs.Apps)
+	}
+
+	res := libs.Response{
+		FinalURL:    "https://example.com/",
+		ContentType: "text/html",
+		Body: `<html><head><meta name="generator" content="AMP Plugin v2"><script src="/angular.min.js"></script><script src="/akismet.js"></script>
+<script>if (window.Zone) { Zone.__symbol__('x') }</script></head><body><app-root ng-version="12.2.1"></app-root></body></html>`,
+	}
+	matches := FingerPrint(res)
+	// AngularJS is excluded by Angular, requires of Akismet and AMP Plugin are not met
+	if techs := ToTechnologies(matches).String(); techs != "Angular/12.2.1,Zone.js" {
+		t.Errorf("Error modern schema: %v", techs)
+	}
+	for _, match := range matches {
+		if match.AppName == "Zone.js" && match.Confidence != 25 {
+			t.Errorf("Error confidence of Zone.js: %v", match.Confidence)
+		}
+		if match.AppName == "Angular" && match.Confidence != 100 {
+			t.Errorf("Error confidence of Angular: %v", match.Confidence)
+		}
+	}
+	if tech := ToTechnologies(matches)[0]; tech.CPE == "" || tech.Evidence[0].Type != "dom" || tech.Evidence[0].Name != "[ng-version][ng-version]" {
+		t.Errorf("Error structured technology: %+v", tech)
+	}
+}
+
+func TestParseLegacyTechs(t *testing.T) {
+	var screen Screen
+	if err := json.Unmarshal([]byte(`{"url": "https://example.com", "tech": "Nginx/1.18.0,PHP"}`), &screen); err != nil {
+		t.Fatalf("Error parse legacy tech: %v", err)
+	}
+	if len(screen.Technologies) != 2 || screen.Technologies[0].Version != "1.18.0" || screen.Technologies.String() != "Nginx/1.18.0,PHP" {
+		t.Errorf("Error parse legacy tech: %+v", screen.Technologies)
+	}
+}
@@ -0,0 +1,328 @@
+package core
+
+import (
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+	jsoniter "github.com/json-iterator/go"
+	"golang.org/x/net/publicsuffix"
+)
+
+// HAR http archive of a page
+type HAR struct {
+	Log HARLog `json:"log"`
+}
+
+// HARLog log of HAR file
+type HARLog struct {
+	Version string     `json:"version"`
+	Creator HARCreator `json:"creator"`
+	Entries []HAREntry `json:"entries"`
+}
+
+// HARCreator tool created HAR file
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// HAREntry a request made by the page
+type HAREntry struct {
+	StartedDateTime string       `json:"startedDateTime"`
+	Time            float64      `json:"time"`
+	Request         HARRequest   `json:"request"`
+	Response        HARResponse  `json:"response"`
+	Cache           struct{}     `json:"cache"`
+	Timings         HARTimings   `json:"timings"`
+	Initiator       HARInitiator `json:"_initiator"`
+	ResourceType    string       `json:"_resourceType"`
+	Error           string       `json:"_error,omitempty"`
+
+	started time.Time
+}
+
+// HARRequest request of HAR entry
+type HARRequest struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Headers     []HARHeader `json:"headers"`
+	QueryString []HARHeader `json:"queryString"`
+	Cookies     []HARHeader `json:"cookies"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+// HARResponse response of HAR entry
+type HARResponse struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Headers     []HARHeader `json:"headers"`
+	Cookies     []HARHeader `json:"cookies"`
+	Content     HARContent  `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int64       `json:"bodySize"`
+}
+
+// HARHeader name value pair
+type HARHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HARContent content of response
+type HARContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+}
+
+// HARTimings timings of HAR entry, we only know the total time
+type HARTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// HARInitiator what triggered the request
+type HARInitiator struct {
+	Type string `json:"type"`
+	URL  string `json:"url,omitempty"`
+}
+
+// DomainSummary requests made to a third-party domain
+type DomainSummary struct {
+	Domain   string `json:"domain"`
+	Requests int    `json:"requests"`
+	Size     int64  `json:"size"`
+}
+
+// NetworkRecorder record requests of a page for HAR
+type NetworkRecorder struct {
+	mu      sync.Mutex
+	entries []*HAREntry
+	current map[string]*HAREntry
+}
+
+// NewNetworkRecorder create new network recorder
+func NewNetworkRecorder() *NetworkRecorder {
+	return &NetworkRecorder{
+		current: make(map[string]*HAREntry),
+	}
+}
+
+// Request record a request, the same id of redirect start a new entry
+func (r *NetworkRecorder) Request(id, method, raw, resourceType string, initiator HARInitiator, headers map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	entry := &HAREntry{
+		StartedDateTime: now.UTC().Format(time.RFC3339Nano),
+		Request: HARRequest{
+			Method:      method,
+			URL:         raw,
+			HTTPVersion: "HTTP/1.1",
+			Headers:     harHeaders(headers),
+			QueryString: harQuery(raw),
+			Cookies:     []HARHeader{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: HARResponse{
+			Headers:     []HARHeader{},
+			Cookies:     []HARHeader{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings:      HARTimings{Send: -1, Wait: -1, Receive: -1},
+		Initiator:    initiator,
+		ResourceType: resourceType,
+		started:      now,
+	}
+	r.entries = append(r.entries, entry)
+	r.current[id] = entry
+}
+
+// Response record the response of request, redirect response is recorded before the next request
+func (r *NetworkRecorder) Response(id string, status int, statusText, mimeType, protocol string, headers map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.current[id]
+	if !ok {
+		return
+	}
+	entry.Response.Status = status
+	entry.Response.StatusText = statusText
+	entry.Response.Content.MimeType = mimeType
+	entry.Response.Headers = harHeaders(headers)
+	entry.Response.RedirectURL = headers["Location"]
+	if entry.Response.RedirectURL == "" {
+		entry.Response.RedirectURL = headers["location"]
+	}
+	if protocol != "" {
+		entry.Response.HTTPVersion = harVersion(protocol)
+		entry.Request.HTTPVersion = entry.Response.HTTPVersion
+	}
+	entry.Time = float64(time.Since(entry.started).Milliseconds())
+}
+
+// Finish record size of finished request
+func (r *NetworkRecorder) Finish(id string, size int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.current[id]
+	if !ok {
+		return
+	}
+	entry.Response.BodySize = size
+	entry.Response.Content.Size = size
+	entry.Time = float64(time.Since(entry.started).Milliseconds())
+	delete(r.current, id)
+}
+
+// Fail record error of failed request
+func (r *NetworkRecorder) Fail(id string, errorText string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.current[id]
+	if !ok {
+		return
+	}
+	entry.Error = errorText
+	entry.Time = float64(time.Since(entry.started).Milliseconds())
+	delete(r.current, id)
+}
+
+// HAR get HAR of recorded requests
+func (r *NetworkRecorder) HAR() HAR {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	har := HAR{
+		Log: HARLog{
+			Version: "1.2",
+			Creator: HARCreator{Name: "goverview", Version: libs.VERSION},
+			Entries: []HAREntry{},
+		},
+	}
+	for _, entry := range r.entries {
+		har.Log.Entries = append(har.Log.Entries, *entry)
+	}
+	return har
+}
+
+// ThirdParty summary of requests to domain other than the page's domain
+func (r *NetworkRecorder) ThirdParty(pageURL string) []DomainSummary {
+	site := registrableDomain(pageURL)
+	summary := make(map[string]*DomainSummary)
+	for _, entry := range r.HAR().Log.Entries {
+		domain := registrableDomain(entry.Request.URL)
+		if domain == "" || domain == site {
+			continue
+		}
+		if _, ok := summary[domain]; !ok {
+			summary[domain] = &DomainSummary{Domain: domain}
+		}
+		summary[domain].Requests++
+		if entry.Response.Content.Size > 0 {
+			summary[domain].Size += entry.Response.Content.Size
+		}
+	}
+
+	var result []DomainSummary
+	for _, item := range summary {
+		result = append(result, *item)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Requests != result[j].Requests {
+			return result[i].Requests > result[j].Requests
+		}
+		return result[i].Domain < result[j].Domain
+	})
+	return result
+}
+
+// HARFile get HAR file next to the screenshot
+func HARFile(imageFile string) string {
+	return strings.TrimSuffix(imageFile, path.Ext(imageFile)) + ".har"
+}
+
+// SaveNetwork store third-party summary to screen and write HAR file when enabled
+func SaveNetwork(options libs.Options, recorder *NetworkRecorder, screen *Screen, imageFile string) {
+	screen.ThirdParty = recorder.ThirdParty(screen.URL)
+	if !options.Screen.HAR || options.Output == "" {
+		return
+	}
+
+	data, err := jsoniter.MarshalIndent(recorder.HAR(), "", "  ")
+	if err != nil {
+		utils.ErrorF("HAR err: %v - %v", screen.URL, err)
+		return
+	}
+	harFile := HARFile(imageFile)
+	if _, err := WriteToFile(harFile, string(data)); err != nil {
+		utils.ErrorF("write HAR err: %v - %v", harFile, err)
+		return
+	}
+	screen.HAR = harFile
+}
+
+// registrableDomain get eTLD+1 of the url, IP or single label host is returned as is
+func registrableDomain(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// harVersion normalise the protocol of CDP to HTTP version the way Chrome export HAR
+func harVersion(protocol string) string {
+	protocol = strings.ToLower(protocol)
+	switch {
+	case protocol == "h2":
+		return "http/2.0"
+	case strings.HasPrefix(protocol, "h3"):
+		return "http/3.0"
+	}
+	return protocol
+}
+
+func harHeaders(headers map[string]string) []HARHeader {
+	result := []HARHeader{}
+	for k, v := range headers {
+		result = append(result, HARHeader{Name: k, Value: v})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func harQuery(raw string) []HARHeader {
+	result := []HARHeader{}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return result
+	}
+	for k, values := range u.Query() {
+		for _, v := range values {
+			result = append(result, HARHeader{Name: k, Value: v})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
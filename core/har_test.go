@@ -0,0 +1,61 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestNetworkRecorder(t *testing.T) {
+	recorder := NewNetworkRecorder()
+	// document with a redirect
+	recorder.Request("1", "GET", "http://example.com/", "Document", HARInitiator{Type: "other"}, nil)
+	recorder.Response("1", 301, "Moved Permanently", "text/html", "http/1.1", map[string]string{"Location": "https://www.example.com/"})
+	recorder.Finish("1", 120)
+	recorder.Request("1", "GET", "https://www.example.com/", "Document", HARInitiator{Type: "other"}, nil)
+	recorder.Response("1", 200, "OK", "text/html", "h2", nil)
+	recorder.Finish("1", 5000)
+
+	// sub resources
+	recorder.Request("2", "GET", "https://cdn.jsdelivr.net/npm/app.js?v=1", "Script", HARInitiator{Type: "parser", URL: "https://www.example.com/"}, nil)
+	recorder.Response("2", 200, "OK", "application/javascript", "h2", nil)
+	recorder.Finish("2", 300)
+	recorder.Request("3", "GET", "https://www.google-analytics.com/collect", "XHR", HARInitiator{Type: "script"}, nil)
+	recorder.Fail("3", "net::ERR_BLOCKED_BY_CLIENT")
+	recorder.Request("4", "GET", "https://static.example.com/logo.png", "Image", HARInitiator{Type: "parser"}, nil)
+	recorder.Request("5", "GET", "https://cdn.jsdelivr.net/npm/app.css", "Stylesheet", HARInitiator{Type: "parser"}, nil)
+
+	har := recorder.HAR()
+	entries := har.Log.Entries
+	if len(entries) != 6 {
+		t.Fatalf("Error HAR entries: %v", len(entries))
+	}
+	if entries[0].Response.Status != 301 || entries[0].Response.RedirectURL != "https://www.example.com/" {
+		t.Errorf("Error redirect entry: %+v", entries[0].Response)
+	}
+	if entries[1].Response.Content.Size != 5000 || entries[1].Response.HTTPVersion != "http/2.0" {
+		t.Errorf("Error document entry: %+v", entries[1].Response)
+	}
+	if entries[0].Request.HTTPVersion != "http/1.1" {
+		t.Errorf("Error HTTP version: %v", entries[0].Request.HTTPVersion)
+	}
+	if entries[2].Initiator.URL != "https://www.example.com/" || entries[2].Response.Content.MimeType != "application/javascript" || len(entries[2].Request.QueryString) != 1 {
+		t.Errorf("Error script entry: %+v", entries[2])
+	}
+	if entries[3].Error == "" {
+		t.Errorf("Error failed entry should have error")
+	}
+
+	third := recorder.ThirdParty("https://www.example.com/")
+	if len(third) != 2 {
+		t.Fatalf("Error third party: %v", third)
+	}
+	if third[0].Domain != "jsdelivr.net" || third[0].Requests != 2 || third[0].Size != 300 {
+		t.Errorf("Error third party summary: %+v", third[0])
+	}
+	if third[1].Domain != "google-analytics.com" {
+		t.Errorf("Error third party summary: %+v", third[1])
+	}
+
+	if HARFile("/tmp/out/https___example.com.png") != "/tmp/out/https___example.com.har" {
+		t.Errorf("Error HARFile")
+	}
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	// register decoder for screenshot
+	_ "image/png"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// ImageFormat get screenshot format from options: png, jpeg or webp
+func ImageFormat(options libs.Options) string {
+	switch strings.ToLower(strings.TrimSpace(options.Screen.Format)) {
+	case "jpeg", "jpg":
+		return "jpeg"
+	case "webp":
+		return "webp"
+	default:
+		return "png"
+	}
+}
+
+// ImageExt get file extension of the screenshot format
+func ImageExt(options libs.Options) string {
+	if ImageFormat(options) == "jpeg" {
+		return "jpg"
+	}
+	return ImageFormat(options)
+}
+
+// ImageQuality get compression quality, png is lossless so it has no quality
+func ImageQuality(options libs.Options) int {
+	if ImageFormat(options) == "png" {
+		return 0
+	}
+	quality := options.Screen.Quality
+	if quality <= 0 || quality > 100 {
+		quality = 90
+	}
+	return quality
+}
+
+// CanDecode check if we can decode the screenshot format with standard library
+func CanDecode(options libs.Options) bool {
+	return ImageFormat(options) != "webp"
+}
+
+// ThumbnailFile get thumbnail file next to the screenshot
+func ThumbnailFile(imageFile string) string {
+	ext := path.Ext(imageFile)
+	return fmt.Sprintf("%s-thumb.jpg", strings.TrimSuffix(imageFile, ext))
+}
+
+// DecodeImage decode png or jpeg screenshot
+func DecodeImage(data []byte) (image.Image, error) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if src.Bounds().Dx() == 0 || src.Bounds().Dy() == 0 {
+		return nil, fmt.Errorf("blank image")
+	}
+	return src, nil
+}
+
+// MakeThumbnail resize screenshot to width and crop the top of long page
+func MakeThumbnail(data []byte, width int) ([]byte, error) {
+	src, err := DecodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+	return thumbnail(src, width)
+}
+
+func thumbnail(src image.Image, width int) ([]byte, error) {
+	crop := topCrop(src.Bounds())
+	if width <= 0 || width > crop.Dx() {
+		width = crop.Dx()
+	}
+	height := crop.Dy() * width / crop.Dx()
+	if height == 0 {
+		height = 1
+	}
+
+	thumb := resizeImage(src, crop, width, height)
+	buf := new(bytes.Buffer)
+	if err := jpeg.Encode(buf, thumb, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// topCrop keep the 4:3 top part of full page screenshot
+func topCrop(bounds image.Rectangle) image.Rectangle {
+	crop := bounds
+	if maxHeight := bounds.Dx() * 3 / 4; crop.Dy() > maxHeight {
+		crop.Max.Y = crop.Min.Y + maxHeight
+	}
+	return crop
+}
+
+// resizeImage downscale the crop area of image with box filter
+func resizeImage(src image.Image, crop image.Rectangle, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := crop.Min.Y + y*crop.Dy()/height
+		y1 := crop.Min.Y + (y+1)*crop.Dy()/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := crop.Min.X + x*crop.Dx()/width
+			x1 := crop.Min.X + (x+1)*crop.Dx()/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					count++
+				}
+			}
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+	return dst
+}
+
+// ProcessScreenshot write thumbnail and compute perceptual hashes of the screenshot
+func ProcessScreenshot(options libs.Options, screen *Screen, imageFile string, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	src, err := DecodeImage(data)
+	if err != nil {
+		utils.ErrorF("decode screenshot err: %v - %v", imageFile, err)
+		return
+	}
+
+	screen.Uniform = UniformRatio(src)
+	hashes := ImageHashes(src)
+	screen.AHash = hashes.AHash
+	screen.DHash = hashes.DHash
+	screen.PHash = hashes.PHash
+
+	if options.Screen.ThumbWidth <= 0 || options.Output == "" {
+		return
+	}
+	thumb, err := thumbnail(src, options.Screen.ThumbWidth)
+	if err != nil {
+		utils.ErrorF("thumbnail err: %v - %v", imageFile, err)
+		return
+	}
+	thumbFile := ThumbnailFile(imageFile)
+	if err := ioutil.WriteFile(thumbFile, thumb, 0644); err != nil {
+		utils.ErrorF("write thumbnail err: %v - %v", thumbFile, err)
+		return
+	}
+	screen.Thumbnail = thumbFile
+}
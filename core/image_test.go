@@ -0,0 +1,67 @@
+package core
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestMakeThumbnail(t *testing.T) {
+	// long page: white top and black bottom
+	src := image.NewRGBA(image.Rect(0, 0, 1000, 5000))
+	for y := 0; y < 5000; y++ {
+		for x := 0; x < 1000; x++ {
+			c := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			if y > 2500 {
+				c = color.RGBA{A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+	buf := new(bytes.Buffer)
+	png.Encode(buf, src)
+
+	data, err := MakeThumbnail(buf.Bytes(), 200)
+	if err != nil {
+		t.Fatalf("Error MakeThumbnail: %v", err)
+	}
+	thumb, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Error decode thumbnail: %v", err)
+	}
+	if thumb.Bounds().Dx() != 200 || thumb.Bounds().Dy() != 150 {
+		t.Errorf("Error thumbnail size: %v", thumb.Bounds())
+	}
+	// only the top of the page is kept
+	if r, _, _, _ := thumb.At(100, 140).RGBA(); r>>8 < 200 {
+		t.Errorf("Error thumbnail should be cropped to the top of the page")
+	}
+
+	if _, err := MakeThumbnail([]byte("not an image"), 200); err == nil {
+		t.Errorf("Error MakeThumbnail should fail on invalid image")
+	}
+}
+
+func TestImageFormat(t *testing.T) {
+	var opt libs.Options
+	if ImageFormat(opt) != "png" || ImageQuality(opt) != 0 {
+		t.Errorf("Error default format")
+	}
+	opt.Screen.Format = "JPG"
+	if ImageFormat(opt) != "jpeg" || ImageExt(opt) != "jpg" || ImageQuality(opt) != 90 {
+		t.Errorf("Error jpeg format")
+	}
+	opt.Screen.Format = "webp"
+	opt.Screen.Quality = 50
+	if ImageExt(opt) != "webp" || ImageQuality(opt) != 50 || CanDecode(opt) {
+		t.Errorf("Error webp format")
+	}
+	if ThumbnailFile("/tmp/out/a.webp") != "/tmp/out/a-thumb.jpg" {
+		t.Errorf("Error ThumbnailFile: %v", ThumbnailFile("/tmp/out/a.webp"))
+	}
+}
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// maxCIDRHosts limit number of hosts expanded from a single CIDR
+const maxCIDRHosts = 1 << 16
+
+// Target a host and port that need to be turned into URLs
+type Target struct {
+	Host string
+	Port string
+	Path string
+}
+
+// ExpandInputs expand bare hosts, host:port pairs, IPs and CIDR ranges to URLs
+func ExpandInputs(options libs.Options, raws []string) []string {
+	ports := ParsePorts(options.Ports)
+	var urls []string
+	var targets []Target
+	for _, raw := range raws {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		if strings.Contains(raw, "://") || strings.ContainsAny(raw, " \t") {
+			urls = append(urls, raw)
+			continue
+		}
+		targets = append(targets, ParseTarget(raw, ports)...)
+	}
+
+	if !options.DetectScheme {
+		for _, t := range targets {
+			urls = append(urls, t.URLs()...)
+		}
+		return urls
+	}
+
+	// only keep the scheme that answers
+	results := make([]string, len(targets))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, options.Concurrency+1)
+	for i, t := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, t Target) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			scheme := DetectScheme(t.Host, t.Port, options.Timeout)
+			if scheme == "" {
+				utils.DebugF("No scheme answer at %v", net.JoinHostPort(t.Host, t.Port))
+				return
+			}
+			results[i] = t.URL(scheme)
+		}(i, t)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		if result != "" {
+			urls = append(urls, result)
+		}
+	}
+	return urls
+}
+
+// ParsePorts parse port list e.g: 80,443,8000-8010
+func ParsePorts(raw string) []string {
+	var ports []string
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "-") {
+			if _, err := strconv.Atoi(item); err == nil {
+				ports = append(ports, item)
+			}
+			continue
+		}
+		bounds := strings.SplitN(item, "-", 2)
+		start, err1 := strconv.Atoi(bounds[0])
+		end, err2 := strconv.Atoi(bounds[1])
+		if err1 != nil || err2 != nil || start > end {
+			continue
+		}
+		for port := start; port <= end && port <= 65535; port++ {
+			ports = append(ports, strconv.Itoa(port))
+		}
+	}
+	if len(ports) == 0 {
+		ports = []string{"80", "443"}
+	}
+	return ports
+}
+
+// ParseTarget parse a bare host, host:port, IP or CIDR to the list of targets
+func ParseTarget(raw string, ports []string) []Target {
+	var path string
+	if i := strings.Index(raw, "/"); i >= 0 {
+		// CIDR range
+		if _, ipNet, err := net.ParseCIDR(raw); err == nil {
+			var targets []Target
+			for _, ip := range expandCIDR(ipNet) {
+				for _, port := range ports {
+					targets = append(targets, Target{Host: ip, Port: port})
+				}
+			}
+			return targets
+		}
+		raw, path = raw[:i], raw[i:]
+	}
+
+	// host with port
+	if host, port, err := net.SplitHostPort(raw); err == nil {
+		return []Target{{Host: host, Port: port, Path: path}}
+	}
+
+	host := strings.Trim(raw, "[]")
+	var targets []Target
+	for _, port := range ports {
+		targets = append(targets, Target{Host: host, Port: port, Path: path})
+	}
+	return targets
+}
+
+// expandCIDR get all host IPs of a network
+func expandCIDR(ipNet *net.IPNet) []string {
+	ones, size := ipNet.Mask.Size()
+	if size-ones > 16 {
+		utils.ErrorF("CIDR too large to expand: %v", ipNet.String())
+		return nil
+	}
+
+	var ips []string
+	ip := make(net.IP, len(ipNet.IP))
+	copy(ip, ipNet.IP)
+	for ; ipNet.Contains(ip) && len(ips) < maxCIDRHosts; incIP(ip) {
+		ips = append(ips, ip.String())
+	}
+	// remove network and broadcast address
+	if size == 32 && size-ones > 1 && len(ips) > 2 {
+		ips = ips[1 : len(ips)-1]
+	}
+	return ips
+}
+
+func incIP(ip net.IP) {
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] > 0 {
+			break
+		}
+	}
+}
+
+// URLs get candidate URLs of target
+func (t Target) URLs() []string {
+	switch t.Port {
+	case "80":
+		return []string{t.URL("http")}
+	case "443":
+		return []string{t.URL("https")}
+	}
+	return []string{t.URL("http"), t.URL("https")}
+}
+
+// URL build URL with scheme and omit the default port
+func (t Target) URL(scheme string) string {
+	host := t.Host
+	if strings.Contains(host, ":") {
+		host = fmt.Sprintf("[%s]", host)
+	}
+	if !((scheme == "http" && t.Port == "80") || (scheme == "https" && t.Port == "443")) {
+		host = net.JoinHostPort(t.Host, t.Port)
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, host, t.Path)
+}
+
+// DetectScheme check if host:port speak https or http
+func DetectScheme(host string, port string, timeout int) string {
+	address := net.JoinHostPort(host, port)
+	dialer := &net.Dialer{Timeout: time.Duration(timeout) * time.Second}
+
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{InsecureSkipVerify: true, ServerName: host})
+	if err == nil {
+		conn.Close()
+		return "https"
+	}
+
+	plain, err := dialer.Dial("tcp", address)
+	if err != nil {
+		return ""
+	}
+	defer plain.Close()
+
+	// other services (ssh, smtp, redis...) also accept the connection, so check the reply
+	plain.SetDeadline(time.Now().Add(time.Duration(timeout) * time.Second))
+	if _, err := fmt.Fprintf(plain, "HEAD / HTTP/1.0\r\nHost: %s\r\n\r\n", address); err != nil {
+		return ""
+	}
+	reply := make([]byte, 5)
+	if _, err := io.ReadFull(plain, reply); err != nil || string(reply) != "HTTP/" {
+		return ""
+	}
+	return "http"
+}
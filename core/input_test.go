@@ -0,0 +1,88 @@
+package core
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestExpandInputs(t *testing.T) {
+	var options libs.Options
+	options.Ports = "80,443,8080"
+	raws := []string{
+		"https://example.com/login",
+		"example.com",
+		"example.com:8443/admin",
+		"10.0.0.0/30",
+	}
+	result := ExpandInputs(options, raws)
+	expected := []string{
+		"https://example.com/login",
+		"http://example.com",
+		"https://example.com",
+		"http://example.com:8080",
+		"https://example.com:8080",
+		"http://example.com:8443/admin",
+		"https://example.com:8443/admin",
+		"http://10.0.0.1",
+		"https://10.0.0.1",
+		"http://10.0.0.1:8080",
+		"https://10.0.0.1:8080",
+		"http://10.0.0.2",
+		"https://10.0.0.2",
+		"http://10.0.0.2:8080",
+		"https://10.0.0.2:8080",
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Error ExpandInputs: %v", result)
+	}
+}
+
+func TestParsePorts(t *testing.T) {
+	result := ParsePorts("80, 8000-8002,abc")
+	if !reflect.DeepEqual(result, []string{"80", "8000", "8001", "8002"}) {
+		t.Errorf("Error ParsePorts: %v", result)
+	}
+	if result := ParsePorts(""); len(result) != 2 {
+		t.Errorf("Error ParsePorts default: %v", result)
+	}
+}
+
+func TestDetectScheme(t *testing.T) {
+	plain := httptest.NewServer(http.NotFoundHandler())
+	defer plain.Close()
+	secure := httptest.NewTLSServer(http.NotFoundHandler())
+	defer secure.Close()
+
+	for server, expected := range map[string]string{plain.URL: "http", secure.URL: "https"} {
+		u, _ := url.Parse(server)
+		if scheme := DetectScheme(u.Hostname(), u.Port(), 5); scheme != expected {
+			t.Errorf("Error DetectScheme %v: %v", server, scheme)
+		}
+	}
+	// open port of a service that isn't http
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Write([]byte("SSH-2.0-OpenSSH_8.2\r\n"))
+			conn.Close()
+		}
+	}()
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	if scheme := DetectScheme(host, port, 5); scheme != "" {
+		t.Errorf("Error DetectScheme non http service: %v", scheme)
+	}
+}
@@ -0,0 +1,141 @@
+package core
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"sort"
+)
+
+// Hashes perceptual hashes of an image in hex
+type Hashes struct {
+	AHash string
+	DHash string
+	PHash string
+}
+
+// ImageHashes compute average, difference and DCT hashes of the top of the screenshot
+func ImageHashes(src image.Image) Hashes {
+	crop := topCrop(src.Bounds())
+	return Hashes{
+		AHash: fmt.Sprintf("%016x", averageHash(grayscale(src, crop, 8, 8))),
+		DHash: fmt.Sprintf("%016x", differenceHash(grayscale(src, crop, 9, 8))),
+		PHash: fmt.Sprintf("%016x", dctHash(grayscale(src, crop, 32, 32))),
+	}
+}
+
+// HashDistance hamming distance between two hex hashes
+func HashDistance(a, b string) int {
+	return SimHashDistance(a, b)
+}
+
+// grayscale resize the crop area and convert to luminance
+func grayscale(src image.Image, crop image.Rectangle, width, height int) [][]float64 {
+	small := resizeImage(src, crop, width, height)
+	pixels := make([][]float64, height)
+	for y := 0; y < height; y++ {
+		pixels[y] = make([]float64, width)
+		for x := 0; x < width; x++ {
+			c := small.RGBAAt(x, y)
+			pixels[y][x] = 0.299*float64(c.R) + 0.587*float64(c.G) + 0.114*float64(c.B)
+		}
+	}
+	return pixels
+}
+
+// averageHash bit is set when pixel brighter than the mean
+func averageHash(pixels [][]float64) uint64 {
+	var total float64
+	for _, row := range pixels {
+		for _, v := range row {
+			total += v
+		}
+	}
+	mean := total / 64
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if pixels[y][x] > mean {
+				hash |= 1 << uint(y*8+x)
+			}
+		}
+	}
+	return hash
+}
+
+// differenceHash bit is set when pixel brighter than its right neighbour
+func differenceHash(pixels [][]float64) uint64 {
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if pixels[y][x] > pixels[y][x+1] {
+				hash |= 1 << uint(y*8+x)
+			}
+		}
+	}
+	return hash
+}
+
+// dctHash bit is set when the low frequency DCT coefficient greater than the median
+func dctHash(pixels [][]float64) uint64 {
+	coefficients := dct2D(pixels)
+
+	var low []float64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			low = append(low, coefficients[y][x])
+		}
+	}
+	// ignore the DC term, it's just the average brightness
+	sorted := append([]float64{}, low[1:]...)
+	sort.Float64s(sorted)
+	median := (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+
+	var hash uint64
+	for i, v := range low {
+		if v > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// dct2D type-II discrete cosine transform of a square matrix
+func dct2D(pixels [][]float64) [][]float64 {
+	size := len(pixels)
+	cos := make([][]float64, size)
+	for k := 0; k < size; k++ {
+		cos[k] = make([]float64, size)
+		for n := 0; n < size; n++ {
+			cos[k][n] = math.Cos(math.Pi / float64(size) * (float64(n) + 0.5) * float64(k))
+		}
+	}
+
+	// rows then columns
+	rows := make([][]float64, size)
+	for y := 0; y < size; y++ {
+		rows[y] = make([]float64, size)
+		for k := 0; k < size; k++ {
+			var sum float64
+			for n := 0; n < size; n++ {
+				sum += pixels[y][n] * cos[k][n]
+			}
+			rows[y][k] = sum
+		}
+	}
+	result := make([][]float64, size)
+	for k := 0; k < size; k++ {
+		result[k] = make([]float64, size)
+	}
+	for x := 0; x < size; x++ {
+		for k := 0; k < size; k++ {
+			var sum float64
+			for n := 0; n < size; n++ {
+				sum += rows[n][x] * cos[k][n]
+			}
+			result[k][x] = sum
+		}
+	}
+	return result
+}
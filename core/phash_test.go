@@ -0,0 +1,69 @@
+package core
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// drawPage draw a fake page with a header bar and a box at the position
+func drawPage(width, height int, boxX int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			// soft gradient background like most pages
+			shade := uint8(255 - 80*y/height - 40*x/width)
+			c := color.RGBA{R: shade, G: shade, B: shade, A: 255}
+			if y < height/8 {
+				c = color.RGBA{R: 30, G: 60, B: 120, A: 255}
+			}
+			if x >= boxX*width/100 && x < (boxX+30)*width/100 && y > height/3 && y < height*2/3 {
+				c = color.RGBA{R: 200, G: 20, B: 20, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestImageHashes(t *testing.T) {
+	a := ImageHashes(drawPage(800, 600, 10))
+	b := ImageHashes(drawPage(400, 300, 10))
+	c := ImageHashes(drawPage(800, 600, 60))
+
+	if len(a.PHash) != 16 || len(a.AHash) != 16 || len(a.DHash) != 16 {
+		t.Fatalf("Error hash format: %+v", a)
+	}
+	if d := HashDistance(a.PHash, b.PHash); d > 4 {
+		t.Errorf("Error same page in different size should be similar: %v", d)
+	}
+	if d := HashDistance(a.DHash, b.DHash); d > 4 {
+		t.Errorf("Error same page in different size should be similar (dhash): %v", d)
+	}
+	if d := HashDistance(a.PHash, c.PHash); d <= 4 {
+		t.Errorf("Error different page should not be similar: %v", d)
+	}
+}
+
+func TestGroupContents(t *testing.T) {
+	contents := []Content{
+		{URL: "http://a.com", PHash: "ffff0000ffff0000"},
+		{URL: "http://b.com", PHash: "0000ffff0000ffff"},
+		{URL: "http://c.com", PHash: "ffff0000ffff0001"},
+		{URL: "http://d.com"},
+		{URL: "http://e.com"},
+	}
+	groups := GroupContents(contents, 4)
+	if len(groups) != 4 {
+		t.Fatalf("Error GroupContents: %v", groups)
+	}
+	if groups[0].Count != 2 || len(groups[0].Similar) != 1 || groups[0].Similar[0] != "http://c.com" {
+		t.Errorf("Error group: %+v", groups[0])
+	}
+	if groups[2].Count != 1 || groups[3].Count != 1 {
+		t.Errorf("Error pages without hash should not be grouped: %+v", groups[2:])
+	}
+	if len(GroupContents(contents, -1)) != 5 {
+		t.Errorf("Error negative distance should disable grouping")
+	}
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+// RateLimiter shared limiter for probing, favicon fetching and screenshots
+var RateLimiter *Limiter
+
+// Limiter global requests per second and per host politeness
+type Limiter struct {
+	interval        time.Duration
+	hostConcurrency int
+	hostDelay       time.Duration
+	maxBackoff      time.Duration
+
+	mu    sync.Mutex
+	next  time.Time
+	hosts map[string]*hostLimit
+}
+
+// hostLimit state of a single host
+type hostLimit struct {
+	sem      chan struct{}
+	next     time.Time
+	failures int
+}
+
+// NewLimiter build limiter from options
+func NewLimiter(options libs.Options) *Limiter {
+	l := &Limiter{
+		hostConcurrency: options.Rate.HostConcurrency,
+		hostDelay:       time.Duration(options.Rate.HostDelay) * time.Millisecond,
+		maxBackoff:      time.Duration(options.Rate.MaxBackoff) * time.Second,
+		hosts:           make(map[string]*hostLimit),
+	}
+	if options.Rate.RateLimit > 0 {
+		l.interval = time.Second / time.Duration(options.Rate.RateLimit)
+	}
+	if l.maxBackoff <= 0 {
+		l.maxBackoff = 60 * time.Second
+	}
+	return l
+}
+
+// Wait block until a request to the URL is allowed, call release when the request is done
+func (l *Limiter) Wait(raw string) (release func()) {
+	if l == nil {
+		return func() {}
+	}
+	h := l.getHost(hostKey(raw))
+	if h.sem != nil {
+		h.sem <- struct{}{}
+	}
+
+	// per host delay and backoff
+	l.mu.Lock()
+	now := time.Now()
+	start := h.next
+	if start.Before(now) {
+		start = now
+	}
+	h.next = start.Add(l.hostDelay)
+	l.mu.Unlock()
+	time.Sleep(start.Sub(now))
+
+	// global rate
+	if l.interval > 0 {
+		l.mu.Lock()
+		now = time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		wait := l.next.Sub(now)
+		l.next = l.next.Add(l.interval)
+		l.mu.Unlock()
+		time.Sleep(wait)
+	}
+
+	return func() {
+		if h.sem != nil {
+			<-h.sem
+		}
+	}
+}
+
+// Backoff delay next requests to the host when it responds with 429 or 503
+func (l *Limiter) Backoff(raw string, statusCode int, retryAfter string) (time.Duration, bool) {
+	if l == nil {
+		return 0, false
+	}
+	h := l.getHost(hostKey(raw))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if statusCode != http.StatusTooManyRequests && statusCode != http.StatusServiceUnavailable {
+		h.failures = 0
+		return 0, false
+	}
+
+	h.failures++
+	delay := ParseRetryAfter(retryAfter)
+	if delay <= 0 {
+		delay = time.Duration(math.Pow(2, float64(h.failures-1))) * time.Second
+	}
+	if delay > l.maxBackoff {
+		delay = l.maxBackoff
+	}
+	if until := time.Now().Add(delay); until.After(h.next) {
+		h.next = until
+	}
+	return delay, true
+}
+
+func (l *Limiter) getHost(host string) *hostLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	h, ok := l.hosts[host]
+	if !ok {
+		h = &hostLimit{}
+		if l.hostConcurrency > 0 {
+			h.sem = make(chan struct{}, l.hostConcurrency)
+		}
+		l.hosts[host] = h
+	}
+	return h
+}
+
+// ParseRetryAfter parse Retry-After header in seconds or HTTP date
+func ParseRetryAfter(raw string) time.Duration {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(raw); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(raw); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
+
+func hostKey(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	return strings.ToLower(u.Host)
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestLimiterRate(t *testing.T) {
+	var options libs.Options
+	options.Rate.RateLimit = 20
+	options.Rate.HostDelay = 0
+	l := NewLimiter(options)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		l.Wait("http://example.com/")()
+	}
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("Error Limiter rate too fast: %v", elapsed)
+	}
+}
+
+func TestLimiterBackoff(t *testing.T) {
+	var options libs.Options
+	options.Rate.MaxBackoff = 5
+	l := NewLimiter(options)
+
+	if _, ok := l.Backoff("http://example.com/a", 200, ""); ok {
+		t.Errorf("Error Limiter should not backoff on 200")
+	}
+	delay, ok := l.Backoff("http://example.com/a", 429, "1")
+	if !ok || delay != time.Second {
+		t.Errorf("Error Limiter backoff Retry-After: %v", delay)
+	}
+	if delay, _ := l.Backoff("http://example.com/b", 503, "3600"); delay != 5*time.Second {
+		t.Errorf("Error Limiter max backoff: %v", delay)
+	}
+
+	// other hosts are not affected
+	start := time.Now()
+	l.Wait("http://another.com/")()
+	if time.Since(start) > 100*time.Millisecond {
+		t.Errorf("Error Limiter backoff leak to another host")
+	}
+}
+
+func TestJustSendBackoff(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	var options libs.Options
+	options.Timeout = 5
+	options.Rate.BackoffRetry = 1
+	RateLimiter = NewLimiter(options)
+	defer func() { RateLimiter = nil }()
+
+	start := time.Now()
+	res, err := JustSend(options, BuildRequest(options, ts.URL), BuildClient(options))
+	if err != nil {
+		t.Fatalf("Error sending: %v", err)
+	}
+	if res.StatusCode != 200 || hits != 2 {
+		t.Errorf("Error JustSend backoff: %v %v", res.StatusCode, hits)
+	}
+	if time.Since(start) < time.Second {
+		t.Errorf("Error JustSend should honor Retry-After")
+	}
+}
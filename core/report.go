@@ -14,6 +14,7 @@ import (
 
 type Content struct {
 	ImgPath    string
+	ThumbPath  string
 	URL        string
 	Title      string
 	Tech       string
@@ -21,7 +22,12 @@ type Content struct {
 	Header     string
 	Status     string
 	Length     string
-	Checksum   string
+	// ID of the detail modal, pages are grouped by PHash
+	ID    string
+	PHash string
+	// visually identical pages collapsed into this one
+	Count   int
+	Similar []string
 }
 
 type ReportData struct {
@@ -65,17 +71,21 @@ func RenderReport(options libs.Options) {
 
 			if !options.AbsPath {
 				screen.Image = strings.ReplaceAll(screen.Image, options.Output, "")
+				screen.Thumbnail = strings.ReplaceAll(screen.Thumbnail, options.Output, "")
 			}
 
 			content := Content{
 				Title:      screen.Title,
-				Tech:       screen.Technologies,
+				Tech:       screen.Technologies.String(),
 				ScreenPath: screen.ContentFile,
-				Checksum:   utils.GenHash(screen.Image),
+				ID:         utils.GenHash(screen.Image),
 				Status:     screen.Status,
 				Header:     header,
 				Length:     length,
 				ImgPath:    screen.Image,
+				ThumbPath:  screen.Thumbnail,
+				PHash:      screen.PHash,
+				Count:      1,
 				URL:        screen.URL,
 			}
 			contents = append(contents, content)
@@ -84,10 +94,34 @@ func RenderReport(options libs.Options) {
 
 	}
 
+	contents = GroupContents(contents, options.PHashDistance)
 	GenerateReport(options, contents)
 	// options.ScreenShotFile
 }
 
+// GroupContents collapse visually identical pages based on perceptual hash distance
+func GroupContents(contents []Content, distance int) []Content {
+	var groups []Content
+	for _, content := range contents {
+		found := false
+		if content.PHash != "" {
+			for i := range groups {
+				if groups[i].PHash != "" && HashDistance(groups[i].PHash, content.PHash) <= distance {
+					groups[i].Count++
+					groups[i].Similar = append(groups[i].Similar, content.URL)
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			content.Count = 1
+			groups = append(groups, content)
+		}
+	}
+	return groups
+}
+
 // GenerateReport generate report file
 func GenerateReport(options libs.Options, contents []Content) error {
 	if len(contents) == 0 {
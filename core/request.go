@@ -2,15 +2,19 @@ package core
 
 import (
 	"bufio"
+	"bytes"
 	"crypto/tls"
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -27,17 +31,6 @@ func BuildClient(options libs.Options) *resty.Client {
 		"AcceptLang": "en-US,en;q=0.8",
 	}
 	timeout := options.Timeout
-	if len(options.Headers) > 0 {
-		for _, head := range options.Headers {
-			if strings.Contains(head, ":") {
-				data := strings.Split(head, ":")
-				if len(data) < 2 {
-					continue
-				}
-				headers[data[0]] = strings.Join(data[1:], "")
-			}
-		}
-	}
 
 	// disable log when retry
 	logger := logrus.New()
@@ -47,21 +40,36 @@ func BuildClient(options libs.Options) *resty.Client {
 
 	client := resty.New()
 	client.SetLogger(logger)
+	dialer := &net.Dialer{
+		Timeout:  time.Duration(timeout) * time.Second,
+		Resolver: NewResolver(options),
+	}
+	maxConnsPerHost := 1000
+	if options.Rate.HostConcurrency > 0 {
+		maxConnsPerHost = options.Rate.HostConcurrency
+	}
 	client.SetTransport(&http.Transport{
+		DialContext:           dialer.DialContext,
 		MaxIdleConns:          100,
-		MaxConnsPerHost:       1000,
+		MaxConnsPerHost:       maxConnsPerHost,
 		IdleConnTimeout:       time.Duration(timeout) * time.Second,
 		ExpectContinueTimeout: time.Duration(timeout) * time.Second,
 		ResponseHeaderTimeout: time.Duration(timeout) * time.Second,
 		TLSHandshakeTimeout:   time.Duration(timeout) * time.Second,
 		DisableCompression:    true,
 		TLSClientConfig:       &tls.Config{InsecureSkipVerify: true},
+		// offer h2 with ALPN so the negotiated protocol is reported in TLS info
+		ForceAttemptHTTP2: true,
 	})
 
 	if options.Proxy != "" {
 		client.SetProxy(options.Proxy)
 	}
 
+	// redirect is followed manually to keep the whole chain
+	client.SetRedirectPolicy(resty.RedirectPolicyFunc(func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}))
 	client.SetHeaders(headers)
 	client.SetCloseConnection(true)
 	if options.Retry > 0 {
@@ -73,91 +81,209 @@ func BuildClient(options libs.Options) *resty.Client {
 	return client
 }
 
-// JustSend just sending request
-func JustSend(options libs.Options, url string, client *resty.Client) (res libs.Response, err error) {
-	method := "GET"
-	timeStart := time.Now()
-	// redirect policy
-	if options.Redirect == false {
-		client.SetRedirectPolicy(resty.RedirectPolicyFunc(func(req *http.Request, via []*http.Request) error {
-			// keep the header the same
-			res.StatusCode = req.Response.StatusCode
-			res.Status = req.Response.Status
-			resp := req.Response
-			bodyBytes, err := ioutil.ReadAll(resp.Body)
+// BuildRequest build request from options and the raw URL
+func BuildRequest(options libs.Options, raw string) libs.Request {
+	method := strings.ToUpper(strings.TrimSpace(options.Method))
+	if method == "" {
+		method = "GET"
+	}
+	return libs.Request{
+		URL:      raw,
+		Method:   method,
+		Headers:  RenderHeaders(options.Headers, raw),
+		Body:     options.Body,
+		Proxy:    options.Proxy,
+		Timeout:  options.Timeout,
+		Redirect: options.Redirect,
+	}
+}
+
+// RenderHeaders render header templates for a specific URL (e.g: 'Referer: {{.BaseURL}}')
+func RenderHeaders(rawHeaders []string, raw string) []map[string]string {
+	var headers []map[string]string
+	if len(rawHeaders) == 0 {
+		return headers
+	}
+
+	data := map[string]string{
+		"URL":          raw,
+		"RandomString": utils.RandomString(8),
+		"RandomNumber": fmt.Sprintf("%d", rand.Intn(100000)),
+	}
+	if u, err := url.Parse(raw); err == nil {
+		data["BaseURL"] = fmt.Sprintf("%v://%v", u.Scheme, u.Host)
+		data["Scheme"] = u.Scheme
+		data["Host"] = u.Host
+		data["Hostname"] = u.Hostname()
+		data["Port"] = u.Port()
+		data["Path"] = u.EscapedPath()
+	}
+
+	for _, head := range rawHeaders {
+		if !strings.Contains(head, ":") {
+			continue
+		}
+		item := strings.SplitN(head, ":", 2)
+		key, value := strings.TrimSpace(item[0]), strings.TrimSpace(item[1])
+		if key == "" {
+			continue
+		}
+
+		if strings.Contains(value, "{{") {
+			t, err := template.New("header").Option("missingkey=zero").Parse(value)
 			if err != nil {
-				utils.ErrorF("%v", err)
+				utils.ErrorF("Invalid header template: %v", head)
+				continue
 			}
-			bodyString := string(bodyBytes)
-			resLength := len(bodyString)
-			// format the headers
-			var resHeaders []map[string]string
-			for k, v := range resp.Header {
-				if k == "Content-Type" {
-					res.ContentType = strings.Join(v[:], "")
-				}
-				if k == "Location" {
-					res.Location = strings.Join(v[:], "")
-				}
-				element := make(map[string]string)
-				element[k] = strings.Join(v[:], "")
-				resLength += len(fmt.Sprintf("%s: %s\n", k, strings.Join(v[:], "")))
-				resHeaders = append(resHeaders, element)
+			buf := &bytes.Buffer{}
+			if err := t.Execute(buf, data); err != nil {
+				utils.ErrorF("Error rendering header: %v", head)
+				continue
 			}
+			value = buf.String()
+		}
+		headers = append(headers, map[string]string{key: value})
+	}
+	return headers
+}
+
+// maxRedirects maximum number of redirect to follow
+const maxRedirects = 10
+
+// JustSend just sending request
+func JustSend(options libs.Options, req libs.Request, client *resty.Client) (res libs.Response, err error) {
+	var redirects []libs.Redirect
+	var attempts int
+	current := req
+	for {
+		release := RateLimiter.Wait(current.URL)
+		resp, err := sendRequest(current, client)
+		release()
+		if err != nil || resp == nil {
+			utils.ErrorF("%v %v", current.URL, err)
+			return libs.Response{}, err
+		}
+		res = ParseResponse(*resp)
+
+		// host asks us to slow down
+		if delay, ok := RateLimiter.Backoff(current.URL, res.StatusCode, resp.Header().Get("Retry-After")); ok && attempts < options.Rate.BackoffRetry {
+			attempts++
+			utils.DebugF("Backoff %v before retry %v", delay, current.URL)
+			continue
+		}
+
+		if res.StatusCode < 300 || res.StatusCode >= 400 || res.Location == "" {
+			break
+		}
+		next, err := ResolveLocation(current.URL, res.Location)
+		if err != nil {
+			utils.DebugF("Invalid redirect location: %v", res.Location)
+			break
+		}
+		redirects = append(redirects, libs.Redirect{
+			URL:        current.URL,
+			StatusCode: res.StatusCode,
+			Location:   next,
+		})
+		// only keep the first hop when auto redirect is disabled
+		if !options.Redirect || len(redirects) >= maxRedirects {
+			break
+		}
+		current = nextRequest(current, res.StatusCode, next)
+	}
+
+	res.Redirects = redirects
+	res.FinalURL = current.URL
+	return res, nil
+}
+
+// sendRequest send a single request without following redirect
+func sendRequest(req libs.Request, client *resty.Client) (resp *resty.Response, err error) {
+	r := client.R().EnableTrace()
+	for _, header := range req.Headers {
+		for key, value := range header {
+			r.SetHeader(key, value)
+		}
+	}
+	if req.Body != "" {
+		r.SetBody(req.Body)
+	}
 
-			// response time in second
-			resTime := time.Since(timeStart).Seconds()
-			resHeaders = append(resHeaders,
-				map[string]string{"Total Length": strconv.Itoa(resLength)},
-				map[string]string{"Response Time": fmt.Sprintf("%f", resTime)},
-			)
-
-			// set some variable
-			res.Headers = resHeaders
-			res.StatusCode = resp.StatusCode
-			res.Status = fmt.Sprintf("%v %v", resp.Status, resp.Proto)
-			res.Body = bodyString
-			res.ResponseTime = resTime
-			res.Length = resLength
-			// beautify
-			res.Beautify = BeautifyResponse(res)
-			res.BeautifyHeader = BeautifyHeaders(res)
-			return errors.New("auto redirect is disabled")
-		}))
-
-		client.AddRetryCondition(
-			func(r *resty.Response, err error) bool {
-				return false
-			},
-		)
-	} else {
-		client.SetRedirectPolicy(resty.RedirectPolicyFunc(func(req *http.Request, via []*http.Request) error {
-			// keep the header the same
-			return nil
-		}))
-	}
-
-	var resp *resty.Response
 	// really sending things here
-	method = strings.ToLower(strings.TrimSpace(method))
+	method := strings.ToLower(strings.TrimSpace(req.Method))
 	switch method {
-	case "get":
-		resp, err = client.R().
-			Get(url)
-		break
+	case "", "get":
+		resp, err = r.Get(req.URL)
+	case "head":
+		resp, err = r.Head(req.URL)
+	case "post":
+		resp, err = r.Post(req.URL)
+	case "put":
+		resp, err = r.Put(req.URL)
+	case "patch":
+		resp, err = r.Patch(req.URL)
+	case "delete":
+		resp, err = r.Delete(req.URL)
+	case "options":
+		resp, err = r.Options(req.URL)
+	default:
+		// custom verb
+		resp, err = r.Execute(strings.ToUpper(method), req.URL)
 	}
+	return resp, err
+}
 
-	// in case we want to get redirect stuff
-	if res.StatusCode != 0 {
-		return res, nil
+// nextRequest build the request to follow a redirect the same way browsers do
+func nextRequest(req libs.Request, statusCode int, location string) libs.Request {
+	next := req
+	next.URL = location
+	if statusCode == 303 || ((statusCode == 301 || statusCode == 302) && strings.ToUpper(req.Method) == "POST") {
+		next.Method = "GET"
+		next.Body = ""
 	}
 
-	if err != nil || resp == nil {
-		utils.ErrorF("%v %v", url, err)
-		return libs.Response{}, err
+	// don't leak credentials to another host
+	if IsCrossOrigin(req.URL, location) {
+		next.Headers = nil
+		for _, header := range req.Headers {
+			element := make(map[string]string)
+			for key, value := range header {
+				switch http.CanonicalHeaderKey(key) {
+				case "Authorization", "Cookie":
+					continue
+				}
+				element[key] = value
+			}
+			next.Headers = append(next.Headers, element)
+		}
+	}
+	return next
+}
+
+// ResolveLocation resolve Location header against the current URL
+func ResolveLocation(current string, location string) (string, error) {
+	base, err := url.Parse(current)
+	if err != nil {
+		return "", err
 	}
+	u, err := base.Parse(strings.TrimSpace(location))
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
 
-	return ParseResponse(*resp), nil
+// IsCrossOrigin check if two URLs have different scheme or host
+func IsCrossOrigin(from string, to string) bool {
+	a, err := url.Parse(from)
+	if err != nil {
+		return false
+	}
+	b, err := url.Parse(to)
+	if err != nil {
+		return false
+	}
+	return a.Scheme != b.Scheme || !strings.EqualFold(a.Host, b.Host)
 }
 
 // ParseResponse field to Response
@@ -190,6 +316,12 @@ func ParseResponse(resp resty.Response) (res libs.Response) {
 	res.StatusCode = resp.StatusCode()
 	res.Status = fmt.Sprintf("%v %v", resp.Status(), resp.RawResponse.Proto)
 	res.Body = string(resp.Body())
+	res.TLS = ParseTLS(resp.RawResponse.TLS)
+	if resp.Request != nil {
+		if remoteAddr := resp.Request.TraceInfo().RemoteAddr; remoteAddr != nil {
+			res.RemoteAddr = remoteAddr.String()
+		}
+	}
 	res.ResponseTime = resTime
 	res.Length = resLength
 	// beautify
@@ -198,6 +330,18 @@ func ParseResponse(resp resty.Response) (res libs.Response) {
 	return res
 }
 
+// GetHeader get value of header by case-insensitive name
+func GetHeader(headers []map[string]string, name string) string {
+	for _, header := range headers {
+		for key, value := range header {
+			if strings.EqualFold(key, name) {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
 // BeautifyRequest beautify request
 func BeautifyRequest(req libs.Request) string {
 	var beautifyReq string
@@ -251,18 +395,38 @@ func BeautifyResponse(res libs.Response) string {
 	return beautifyRes
 }
 
+// MergeHeaders add extra headers to the request headers, extra headers replace the ones with the same name
+func MergeHeaders(headers []map[string]string, extra []map[string]string) []map[string]string {
+	replaced := make(map[string]bool)
+	for _, header := range extra {
+		for k := range header {
+			replaced[http.CanonicalHeaderKey(k)] = true
+		}
+	}
+
+	var result []map[string]string
+	for _, header := range headers {
+		for k, v := range header {
+			if !replaced[http.CanonicalHeaderKey(k)] {
+				result = append(result, map[string]string{k: v})
+			}
+		}
+	}
+	return append(result, extra...)
+}
+
 // ParseBurpRequest parse burp style request
-func ParseBurpRequest(raw string) string {
-	rawDecoded, err := base64.StdEncoding.DecodeString(raw)
+func ParseBurpRequest(raw string) (libs.Request, error) {
+	var realReq libs.Request
+	rawDecoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(raw))
 	if err != nil {
-		return ""
+		return realReq, err
 	}
 
-	var realReq libs.Request
 	reader := bufio.NewReader(strings.NewReader(string(rawDecoded)))
 	parsedReq, err := http.ReadRequest(reader)
 	if err != nil {
-		return ""
+		return realReq, err
 	}
 	realReq.Method = parsedReq.Method
 	// URL part
@@ -285,5 +449,31 @@ func ParseBurpRequest(raw string) string {
 	realReq.URL = parsedReq.URL.String()
 	realReq.Path = parsedReq.RequestURI
 
-	return realReq.URL
+	// headers part, Content-Length will be calculated again when sending
+	var keys []string
+	for key := range parsedReq.Header {
+		if key == "Content-Length" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sep := ", "
+		if key == "Cookie" {
+			sep = "; "
+		}
+		realReq.Headers = append(realReq.Headers, map[string]string{
+			key: strings.Join(parsedReq.Header[key], sep),
+		})
+	}
+
+	// body part
+	if parsedReq.Body != nil {
+		body, err := ioutil.ReadAll(parsedReq.Body)
+		if err == nil {
+			realReq.Body = string(body)
+		}
+	}
+	return realReq, nil
 }
@@ -1,5 +1,16 @@
 package core
 
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
 //func TestJustSend(t *testing.T) {
 //	var options libs.Options
 //	options.Level = 5
@@ -11,3 +22,215 @@ package core
 //		utils.ErrorF("Error sending: %v", url)
 //	}
 //}
+
+func TestJustSendMethod(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Custom", r.Header.Get("X-Custom"))
+		fmt.Fprintf(w, "%s", body)
+	}))
+	defer ts.Close()
+
+	var options libs.Options
+	options.Timeout = 5
+	options.Method = "put"
+	options.Body = "id=1"
+	client := BuildClient(options)
+
+	req := BuildRequest(options, ts.URL)
+	req.Headers = append(req.Headers, map[string]string{"X-Custom": "goverview"})
+	res, err := JustSend(options, req, client)
+	if err != nil {
+		t.Fatalf("Error sending: %v", err)
+	}
+	if !strings.Contains(res.BeautifyHeader, "X-Method: PUT") || !strings.Contains(res.BeautifyHeader, "X-Custom: goverview") {
+		t.Errorf("Error JustSend headers: %v", res.BeautifyHeader)
+	}
+	if res.Body != "id=1" {
+		t.Errorf("Error JustSend body: %v", res.Body)
+	}
+
+	req.Method = "PURGE"
+	req.Body = ""
+	res, err = JustSend(options, req, client)
+	if err != nil || !strings.Contains(res.BeautifyHeader, "X-Method: PURGE") {
+		t.Errorf("Error JustSend custom verb: %v", res.BeautifyHeader)
+	}
+}
+
+func TestParseBurpRequest(t *testing.T) {
+	raw := "POST /api/login?next=%2F HTTP/1.1\r\nHost: example.com\r\nCookie: session=abc\r\nReferer: http://example.com/\r\nContent-Type: application/x-www-form-urlencoded\r\nContent-Length: 17\r\n\r\nuser=foo&pass=bar"
+	req, err := ParseBurpRequest(Base64Encode(raw))
+	if err != nil {
+		t.Fatalf("Error ParseBurpRequest: %v", err)
+	}
+	if req.Method != "POST" || req.URL != "http://example.com/api/login?next=%2F" {
+		t.Errorf("Error ParseBurpRequest URL: %v %v", req.Method, req.URL)
+	}
+	if req.Body != "user=foo&pass=bar" {
+		t.Errorf("Error ParseBurpRequest body: %v", req.Body)
+	}
+
+	headers := make(map[string]string)
+	for _, header := range req.Headers {
+		for k, v := range header {
+			headers[k] = v
+		}
+	}
+	if headers["Cookie"] != "session=abc" || headers["Content-Type"] == "" {
+		t.Errorf("Error ParseBurpRequest headers: %v", headers)
+	}
+	if _, ok := headers["Content-Length"]; ok {
+		t.Errorf("Content-Length should be dropped: %v", headers)
+	}
+
+	// -H headers are added to the burp request and replace the same header
+	req.Headers = MergeHeaders(req.Headers, RenderHeaders([]string{"cookie: session=xyz", "X-Scan: {{.Hostname}}"}, req.URL))
+	headers = make(map[string]string)
+	for _, header := range req.Headers {
+		for k, v := range header {
+			headers[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+	if len(req.Headers) != 4 || headers["Cookie"] != "session=xyz" || headers["X-Scan"] != "example.com" || headers["Referer"] == "" {
+		t.Errorf("Error burp request with custom headers: %v", req.Headers)
+	}
+}
+
+func TestJustSendRedirectChain(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s", r.Method, r.Header.Get("Cookie"))
+	}))
+	defer other.Close()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			http.Redirect(w, r, "/next", http.StatusSeeOther)
+		case "/next":
+			http.Redirect(w, r, other.URL+"/final", http.StatusMovedPermanently)
+		}
+	}))
+	defer ts.Close()
+
+	var options libs.Options
+	options.Timeout = 5
+	options.Method = "POST"
+	options.Body = "user=foo"
+	client := BuildClient(options)
+	req := BuildRequest(options, ts.URL+"/login")
+	req.Headers = []map[string]string{{"Cookie": "session=abc"}}
+
+	// only the first hop without -L
+	res, err := JustSend(options, req, client)
+	if err != nil {
+		t.Fatalf("Error sending: %v", err)
+	}
+	if res.StatusCode != 303 || len(res.Redirects) != 1 || res.Location != "/next" {
+		t.Errorf("Error JustSend without redirect: %v %v", res.StatusCode, res.Redirects)
+	}
+
+	options.Redirect = true
+	res, err = JustSend(options, req, client)
+	if err != nil {
+		t.Fatalf("Error sending: %v", err)
+	}
+	if len(res.Redirects) != 2 || res.FinalURL != other.URL+"/final" {
+		t.Fatalf("Error JustSend redirect chain: %v %v", res.Redirects, res.FinalURL)
+	}
+	if res.Redirects[1].StatusCode != 301 || res.Redirects[1].Location != other.URL+"/final" {
+		t.Errorf("Error JustSend redirect hop: %v", res.Redirects[1])
+	}
+	// 303 switch to GET and cookie is not sent to another host
+	if res.Body != "GET " {
+		t.Errorf("Error JustSend final request: %v", res.Body)
+	}
+
+	options.NoOutput = true
+	overview := CalcCheckSum(options, req, res)
+	if !overview.CrossOrigin || overview.FinalURL != res.FinalURL || len(overview.RedirectChain) != 2 {
+		t.Errorf("Error CalcCheckSum redirect: %v", overview)
+	}
+}
+
+func TestJustSendTLS(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.NotFoundHandler())
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+
+	var options libs.Options
+	options.Timeout = 5
+	client := BuildClient(options)
+	res, err := JustSend(options, BuildRequest(options, ts.URL), client)
+	if err != nil {
+		t.Fatalf("Error sending: %v", err)
+	}
+	if res.TLS == nil {
+		t.Fatalf("Error JustSend TLS is missing")
+	}
+	if res.TLS.Version == "" || res.TLS.Cipher == "" || res.TLS.NotAfter == "" {
+		t.Errorf("Error JustSend TLS handshake: %v", res.TLS)
+	}
+	if res.TLS.ALPN != "h2" {
+		t.Errorf("Error JustSend TLS ALPN: %v", res.TLS.ALPN)
+	}
+	found := false
+	for _, san := range res.TLS.SANs {
+		if san == "example.com" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Error JustSend TLS SANs: %v", res.TLS.SANs)
+	}
+}
+
+func TestJustSendRemoteAddr(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	var options libs.Options
+	options.Timeout = 5
+	res, err := JustSend(options, BuildRequest(options, ts.URL), BuildClient(options))
+	if err != nil {
+		t.Fatalf("Error sending: %v", err)
+	}
+	if res.RemoteAddr != ts.Listener.Addr().String() {
+		t.Errorf("Error JustSend remote address: %v", res.RemoteAddr)
+	}
+}
+
+func TestRenderHeaders(t *testing.T) {
+	raws := []string{
+		"Referer: {{.BaseURL}}/",
+		"X-Forwarded-Host: {{.Hostname}}:{{.Port}}",
+		"Origin: http://evil.com:8080",
+		"X-Path: {{.Scheme}} {{.Path}}",
+		"X-Random: {{.RandomString}}",
+		"Invalid",
+	}
+	headers := RenderHeaders(raws, "https://example.com:8443/admin/login?next=1")
+	expected := map[string]string{
+		"Referer":          "https://example.com:8443/",
+		"X-Forwarded-Host": "example.com:8443",
+		"Origin":           "http://evil.com:8080",
+		"X-Path":           "https /admin/login",
+	}
+	if len(headers) != 5 {
+		t.Fatalf("Error RenderHeaders: %v", headers)
+	}
+	for _, header := range headers {
+		for k, v := range header {
+			if k == "X-Random" {
+				if len(v) != 8 {
+					t.Errorf("Error RenderHeaders random: %v", v)
+				}
+				continue
+			}
+			if expected[k] != v {
+				t.Errorf("Error RenderHeaders %v: %v", k, v)
+			}
+		}
+	}
+}
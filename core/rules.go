@@ -0,0 +1,440 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Rules custom fingerprint rules loaded with --rules
+var Rules []Rule
+
+// Matcher types of custom rules
+const (
+	MatcherStatus  = "status"
+	MatcherHeader  = "header"
+	MatcherBody    = "body"
+	MatcherTitle   = "title"
+	MatcherFavicon = "favicon"
+	MatcherHash    = "hash"
+	MatcherDOM     = "dom"
+	MatcherJS      = "js"
+)
+
+// Rule custom fingerprint rule, detected as a technology named Name
+type Rule struct {
+	Name       string      `json:"name"`
+	Categories []string    `json:"categories"`
+	Website    string      `json:"website"`
+	CPE        string      `json:"cpe"`
+	Implies    StringArray `json:"implies"`
+	Confidence int         `json:"confidence"`
+	// and, or (default: or)
+	Condition string    `json:"condition"`
+	Matchers  []Matcher `json:"matchers"`
+	File      string    `json:"-"`
+
+	// ids of Categories in the technology file, for requiresCategory of wappalyzer apps
+	cats StringArray
+}
+
+// Matcher a check of a rule, matchers without type is a group of matchers with its own condition
+type Matcher struct {
+	Type string `json:"type"`
+	// header name, JS variable or attribute of the DOM selector
+	Name     string `json:"name"`
+	Selector string `json:"selector"`
+	// regex support \;version:\1 like wappalyzer
+	Regex string `json:"regex"`
+	// status codes, favicon hashes or sha1 of response body
+	Values    StringArray `json:"values"`
+	Negative  bool        `json:"negative"`
+	Condition string      `json:"condition"`
+	Matchers  []Matcher   `json:"matchers"`
+
+	regex *AppRegexp
+	// assignment of the JS variable, used when it can't be read from the browser
+	assignment *regexp.Regexp
+}
+
+// RuleTarget response to evaluate rules on
+type RuleTarget struct {
+	URL     string
+	Status  int
+	Headers http.Header
+	Body    string
+	// JS variables evaluated in the browser, JS matchers check assignments in the body when nil
+	JSVars map[string]string
+
+	doc     *goquery.Document
+	favicon *string
+}
+
+// NewRuleTarget prepare a response to evaluate rules on
+func NewRuleTarget(res libs.Response) RuleTarget {
+	target := RuleTarget{
+		URL:     res.FinalURL,
+		Status:  res.StatusCode,
+		Headers: ToHTTPHeader(res.Headers),
+		Body:    res.Body,
+	}
+	if doc, err := goquery.NewDocumentFromReader(strings.NewReader(res.Body)); err == nil {
+		target.doc = doc
+	}
+	return target
+}
+
+// Favicon hash of favicon, only fetched when a rule need it
+func (t *RuleTarget) Favicon() string {
+	if t.favicon == nil {
+		favicon := GetFavHash(t.URL)
+		t.favicon = &favicon
+	}
+	return *t.favicon
+}
+
+// LoadRules load all rule files (*.json) in the rules directory
+func LoadRules(options libs.Options) error {
+	Rules = nil
+	if options.Fin.RulesDir == "" {
+		return nil
+	}
+	rulesDir := utils.NormalizePath(options.Fin.RulesDir)
+	err := filepath.Walk(rulesDir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(filename) != ".json" {
+			return nil
+		}
+		rules, err := ParseRules(filename)
+		if err != nil {
+			return err
+		}
+		for i := range rules {
+			rules[i].cats = categoryIDs(rules[i].Categories)
+		}
+		Rules = append(Rules, rules...)
+		return nil
+	})
+	if err != nil {
+		utils.ErrorF("Error loading rules: %s - %v", rulesDir, err)
+		return err
+	}
+	utils.DebugF("Loaded %v of custom rules", len(Rules))
+	return nil
+}
+
+// ParseRules parse a rule file contain a rule or a list of rules
+func ParseRules(filename string) ([]Rule, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = jsoniter.Unmarshal(data, &rules)
+	} else {
+		var rule Rule
+		err = jsoniter.Unmarshal(data, &rule)
+		rules = append(rules, rule)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+
+	for i := range rules {
+		rules[i].File = filename
+		if err := rules[i].compile(); err != nil {
+			return nil, fmt.Errorf("%v: %v", filename, err)
+		}
+	}
+	return rules, nil
+}
+
+// categoryIDs map category names (or ids) to ids of the loaded technology file
+func categoryIDs(categories []string) StringArray {
+	var ids StringArray
+	if WA == nil || WA.AppDefs == nil {
+		return ids
+	}
+	for _, category := range categories {
+		if _, ok := WA.AppDefs.Cats[category]; ok {
+			ids = append(ids, category)
+			continue
+		}
+		for id, cat := range WA.AppDefs.Cats {
+			if strings.EqualFold(cat.Name, category) {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+func (r *Rule) compile() error {
+	if r.Name == "" {
+		return fmt.Errorf("rule without name")
+	}
+	if r.Confidence == 0 {
+		r.Confidence = 100
+	}
+	if len(r.Matchers) == 0 {
+		return fmt.Errorf("rule %v: no matchers", r.Name)
+	}
+	if err := checkCondition(r.Condition); err != nil {
+		return fmt.Errorf("rule %v: %v", r.Name, err)
+	}
+	for i := range r.Matchers {
+		if err := r.Matchers[i].compile(); err != nil {
+			return fmt.Errorf("rule %v: %v", r.Name, err)
+		}
+	}
+	return nil
+}
+
+func (m *Matcher) compile() error {
+	if m.Regex != "" {
+		regex, err := compilePattern(m.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex %v: %v", m.Regex, err)
+		}
+		m.regex = &regex
+	}
+
+	switch m.Type {
+	case "":
+		if len(m.Matchers) == 0 {
+			return fmt.Errorf("matcher without type or matchers")
+		}
+		if err := checkCondition(m.Condition); err != nil {
+			return err
+		}
+		for i := range m.Matchers {
+			if err := m.Matchers[i].compile(); err != nil {
+				return err
+			}
+		}
+	case MatcherStatus, MatcherFavicon, MatcherHash:
+		if len(m.Values) == 0 {
+			return fmt.Errorf("%v matcher without values", m.Type)
+		}
+	case MatcherHeader, MatcherJS:
+		if m.Name == "" {
+			return fmt.Errorf("%v matcher without name", m.Type)
+		}
+		if m.Type == MatcherJS {
+			parts := strings.Split(m.Name, ".")
+			m.assignment = regexp.MustCompile(fmt.Sprintf(`\b%s\s*[=:]\s*["']?([^"',;\s}]*)`, regexp.QuoteMeta(parts[len(parts)-1])))
+		}
+	case MatcherDOM:
+		if m.Selector == "" {
+			return fmt.Errorf("dom matcher without selector")
+		}
+	case MatcherBody, MatcherTitle:
+		if m.regex == nil {
+			return fmt.Errorf("%v matcher without regex", m.Type)
+		}
+	default:
+		return fmt.Errorf("unknown matcher type: %v", m.Type)
+	}
+	return nil
+}
+
+func checkCondition(condition string) error {
+	switch strings.ToLower(condition) {
+	case "", "and", "or":
+		return nil
+	}
+	return fmt.Errorf("unknown condition: %v", condition)
+}
+
+// MatchRules evaluate custom rules on the target
+func MatchRules(target *RuleTarget) []Match {
+	var matches []Match
+	for _, rule := range Rules {
+		found := Match{
+			App: App{
+				Cats:     rule.cats,
+				CatNames: rule.Categories,
+				Website:  rule.Website,
+				CPE:      rule.CPE,
+				Implies:  rule.Implies,
+			},
+			AppName: rule.Name,
+			Matches: make([][]string, 0),
+		}
+		if !evalMatchers(rule.Condition, rule.Matchers, target, &found) {
+			continue
+		}
+		found.Matches = append(found.Matches, []string{rule.Name})
+		found.Confidence = rule.Confidence
+		matches = append(matches, found)
+	}
+	return matches
+}
+
+// evalMatchers evaluate matchers with and/or, evidences of the matched ones are added to found
+func evalMatchers(condition string, matchers []Matcher, target *RuleTarget, found *Match) bool {
+	and := strings.EqualFold(condition, "and")
+	for _, m := range matchers {
+		check := *found
+		check.Evidence = nil
+		ok := m.eval(target, &check) != m.Negative
+		if ok {
+			found.Evidence = append(found.Evidence, check.Evidence...)
+			found.updateVersion(check.Version)
+		}
+		if and && !ok {
+			return false
+		}
+		if !and && ok {
+			return true
+		}
+	}
+	return and
+}
+
+// eval check the matcher on target, found get evidence and version when it matched
+func (m *Matcher) eval(target *RuleTarget, found *Match) bool {
+	switch m.Type {
+	case "":
+		return evalMatchers(m.Condition, m.Matchers, target, found)
+	case MatcherStatus:
+		return m.evalValues(fmt.Sprintf("%d", target.Status), found)
+	case MatcherFavicon:
+		return m.evalValues(target.Favicon(), found)
+	case MatcherHash:
+		return m.evalValues(utils.GenHash(target.Body), found)
+	case MatcherHeader:
+		for _, value := range target.Headers.Values(m.Name) {
+			if m.evalRegex(value, found) {
+				return true
+			}
+		}
+	case MatcherBody:
+		return m.evalRegex(target.Body, found)
+	case MatcherTitle:
+		if target.doc != nil {
+			return m.evalRegex(GetTitle(target.doc), found)
+		}
+	case MatcherDOM:
+		if target.doc == nil {
+			return false
+		}
+		matched := false
+		target.doc.Find(m.Selector).EachWithBreak(func(i int, s *goquery.Selection) bool {
+			value := s.Text()
+			if m.Name != "" {
+				attr, exists := s.Attr(m.Name)
+				if !exists {
+					return true
+				}
+				value = attr
+			}
+			matched = m.evalRegex(value, found)
+			return !matched
+		})
+		return matched
+	case MatcherJS:
+		value, ok := target.jsVar(m)
+		return ok && m.evalRegex(value, found)
+	}
+	return false
+}
+
+func (m *Matcher) evalValues(value string, found *Match) bool {
+	if value == "" {
+		return false
+	}
+	for _, expected := range m.Values {
+		if strings.TrimSpace(expected) == value {
+			found.addEvidence(m.Type, m.Name, value, 0)
+			return true
+		}
+	}
+	return false
+}
+
+// evalRegex match value with the regex, a matcher without regex only need the value to exist
+func (m *Matcher) evalRegex(value string, found *Match) bool {
+	name := m.Name
+	if m.Type == MatcherDOM {
+		name = m.Selector
+	}
+	if m.regex == nil {
+		found.addEvidence(m.Type, name, value, 0)
+		return true
+	}
+
+	matches := m.regex.Regexp.FindAllStringSubmatch(value, -1)
+	if matches == nil {
+		return false
+	}
+	found.addEvidence(m.Type, name, matches[0][0], 0)
+	if m.regex.Version != "" {
+		found.updateVersion(FindVersion(matches, m.regex.Version))
+	}
+	return true
+}
+
+// jsVar get JS variable from the browser, or find its assignment in the body
+func (t *RuleTarget) jsVar(m *Matcher) (string, bool) {
+	if t.JSVars != nil {
+		value, ok := t.JSVars[strings.TrimPrefix(m.Name, "window.")]
+		return value, ok
+	}
+
+	if match := m.assignment.FindStringSubmatch(t.Body); match != nil {
+		return match[1], true
+	}
+	return "", false
+}
+
+// RuleJSVars names of JS variables used by rules, to evaluate in the browser
+func RuleJSVars() []string {
+	var names []string
+	var collect func(matchers []Matcher)
+	collect = func(matchers []Matcher) {
+		for _, m := range matchers {
+			if m.Type == MatcherJS && !utils.StringInSlice(strings.TrimPrefix(m.Name, "window."), names) {
+				names = append(names, strings.TrimPrefix(m.Name, "window."))
+			}
+			collect(m.Matchers)
+		}
+	}
+	for _, rule := range Rules {
+		collect(rule.Matchers)
+	}
+	return names
+}
+
+// JSVarsScript JS expression return the value of variables as {name: string}
+func JSVarsScript(names []string) string {
+	list, _ := json.Marshal(names)
+	return fmt.Sprintf(`(() => {
+	const result = {};
+	for (const name of %s) {
+		try {
+			const value = name.split('.').reduce((obj, key) => obj[key], window);
+			if (value !== undefined) {
+				result[name] = typeof value === 'object' ? JSON.stringify(value) : String(value);
+			}
+		} catch (e) {}
+	}
+	return result;
+})()`, list)
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+const testRules = `[
+  {
+    "name": "Acme Admin",
+    "categories": ["Admin panels", "cms"],
+    "cpe": "cpe:2.3:a:acme:admin:*:*:*:*:*:*:*:*",
+    "condition": "and",
+    "matchers": [
+      {"type": "status", "values": [200, 401]},
+      {"type": "header", "name": "X-Acme"},
+      {"type": "title", "regex": "^Acme Admin (\\d+)\\;version:\\1"},
+      {"condition": "or", "matchers": [
+        {"type": "js", "name": "window.ACME_BUILD"},
+        {"type": "dom", "selector": "form#acme-login", "name": "action", "regex": "/login"}
+      ]}
+    ]
+  },
+  {
+    "name": "Acme Not Found",
+    "matchers": [{"type": "status", "values": 404}]
+  },
+  {
+    "name": "Acme Legacy",
+    "condition": "and",
+    "matchers": [
+      {"type": "body", "regex": "Acme"},
+      {"type": "body", "regex": "jquery-1\\.", "negative": true}
+    ]
+  }
+]`
+
+func TestMatchRules(t *testing.T) {
+	loadTestTechs(t)
+	// only detected with an app of CMS category, which is given by a rule
+	plugin := App{Cats: StringArray{"1"}, RequiresCategory: StringArray{"1"}, HTML: StringArray{"acme-plugin"}}
+	plugin.HTMLRegex = compileRegexes(plugin.HTML)
+	WA.AppDefs.Apps["Acme Plugin"] = plugin
+	favicon := "\x00\x00\x01\x00acme-icon"
+	body := `<html><head><title>Acme Admin 3</title><script>var ACME_BUILD = "2021.09";</script></head>
+<body><form id="acme-login" action="/login"></form><div class="acme-plugin"></div></body></html>`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, favicon)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "goverview-rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.MkdirAll(path.Join(dir, "internal"), 0755)
+	ioutil.WriteFile(path.Join(dir, "acme.json"), []byte(testRules), 0644)
+	ioutil.WriteFile(path.Join(dir, "internal", "hashes.json"), []byte(fmt.Sprintf(`{
+  "name": "Acme Portal",
+  "matchers": [
+    {"type": "favicon", "values": ["%s"]},
+    {"type": "hash", "values": ["%s"]}
+  ]
+}`, Mmh3Hash32(StandBase64([]byte(favicon))), utils.GenHash("other body"))), 0644)
+
+	var opt libs.Options
+	opt.Fin.RulesDir = dir
+	if err := LoadRules(opt); err != nil {
+		t.Fatalf("Error LoadRules: %v", err)
+	}
+	defer func() { Rules = nil }()
+	if len(Rules) != 4 {
+		t.Fatalf("Error number of rules: %v", len(Rules))
+	}
+
+	res := libs.Response{
+		FinalURL:    ts.URL,
+		StatusCode:  200,
+		ContentType: "text/html",
+		Headers:     []map[string]string{{"X-Acme": "1"}, {"Server": "nginx"}},
+		Body:        body,
+	}
+	techs := ToTechnologies(FingerPrint(res))
+	if techs.String() != "Acme Admin/3,Acme Legacy,Acme Plugin,Acme Portal,Nginx" {
+		t.Fatalf("Error match rules: %v", techs.String())
+	}
+	admin := techs[0]
+	if admin.Categories[0] != "Admin panels" || admin.CPE == "" || admin.Confidence != 100 {
+		t.Errorf("Error rule technology: %+v", admin)
+	}
+	// the or group stop at the first matched matcher
+	if len(admin.Evidence) != 4 || admin.Evidence[3].Type != MatcherJS || admin.Evidence[3].Value != "2021.09" {
+		t.Errorf("Error rule evidence: %+v", admin.Evidence)
+	}
+
+	// JS variables from the browser replace the static check
+	target := NewRuleTarget(res)
+	target.JSVars = map[string]string{}
+	res.Body = `<html><head><title>Acme Admin 3</title></head><body><script src="/jquery-1.12.js"></script></body></html>`
+	legacy := NewRuleTarget(res)
+	if techs := ToTechnologies(MatchRules(&target)); techs[0].Evidence[3].Type != MatcherDOM {
+		t.Errorf("Error JS variables from browser: %+v", techs[0].Evidence)
+	}
+	if techs := ToTechnologies(MatchRules(&legacy)).String(); techs != "Acme Portal" {
+		t.Errorf("Error and/negative matchers: %v", techs)
+	}
+}
+
+func TestInvalidRules(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goverview-rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(path.Join(dir, "bad.json"), []byte(`{"name": "Bad", "matchers": [{"type": "cookie", "name": "sid"}]}`), 0644)
+
+	var opt libs.Options
+	opt.Fin.RulesDir = dir
+	if err := LoadRules(opt); err == nil {
+		t.Errorf("Error unknown matcher type should fail")
+	}
+	Rules = nil
+}
@@ -2,10 +2,17 @@ package core
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"github.com/chromedp/cdproto/cdp"
 	"github.com/chromedp/cdproto/dom"
+	"github.com/chromedp/cdproto/emulation"
 	"github.com/chromedp/cdproto/fetch"
 	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/cdproto/runtime"
+	"net/http"
 	"net/url"
 
 	"github.com/chromedp/chromedp"
@@ -16,24 +23,39 @@ import (
 	jsoniter "github.com/json-iterator/go"
 
 	"io/ioutil"
-	"log"
-	"os"
 	"path"
-	"path/filepath"
 	"strings"
 	"time"
 )
 
 // Screen overview struct
 type Screen struct {
-	URL          string `json:"url"`
-	Image        string `json:"image"`
-	ContentFile  string `json:"content_file"`
-	Technologies string `json:"tech"`
+	URL          string       `json:"url"`
+	Image        string       `json:"image"`
+	ContentFile  string       `json:"content_file"`
+	Thumbnail    string       `json:"thumbnail"`
+	Technologies Technologies `json:"tech"`
 	// with check sum
 	Title    string `json:"title"`
 	CheckSum string `json:"checksum"`
 	Status   string `json:"status"`
+	Device   string `json:"device"`
+	// ok, blank, browser-error or timeout
+	Class   string  `json:"class"`
+	Uniform float64 `json:"uniform,omitempty"`
+	// perceptual hashes of the screenshot
+	AHash string `json:"ahash,omitempty"`
+	DHash string `json:"dhash,omitempty"`
+	PHash string `json:"phash,omitempty"`
+	// value returned by --js-after script
+	ScriptResult json.RawMessage `json:"script_result,omitempty"`
+	HAR          string          `json:"har,omitempty"`
+	ThirdParty   []DomainSummary `json:"third_party,omitempty"`
+	// page events, dialogs are dismissed automatically
+	HasPopUp   bool          `json:"has_popup,omitempty"`
+	Console    *EventSummary `json:"console,omitempty"`
+	Exceptions *EventSummary `json:"exceptions,omitempty"`
+	Dialogs    *EventSummary `json:"dialogs,omitempty"`
 	//External []string `json:"external"`
 }
 
@@ -44,6 +66,12 @@ func PrintScreen(options libs.Options, screen Screen) string {
 	}
 	if options.AbsPath {
 		screen.Image = path.Base(screen.Image)
+		if screen.Thumbnail != "" {
+			screen.Thumbnail = path.Base(screen.Thumbnail)
+		}
+		if screen.HAR != "" {
+			screen.HAR = path.Base(screen.HAR)
+		}
 	}
 
 	if options.JsonOutput {
@@ -54,50 +82,39 @@ func PrintScreen(options libs.Options, screen Screen) string {
 	return fmt.Sprintf("%v ;; %v", screen.URL, screen.Image)
 }
 
-func DoScreenshot(options libs.Options, raw string) string {
-	imageName := strings.Replace(raw, "://", "___", -1)
-	imageScreen := path.Join(options.Screen.ScreenOutput, fmt.Sprintf("%v.png", strings.Replace(imageName, "/", "_", -1)))
-
-	contentFile := fmt.Sprintf("%s.txt", strings.Replace(raw, "://", "___", -1))
-	contentFile = strings.Replace(contentFile, "?", "_", -1)
-	contentFile = strings.Replace(contentFile, "/", "_", -1)
-	contentFile = path.Join(options.Screen.ScreenOutput, contentFile)
-	content := fmt.Sprintf("> GET %s\n", raw)
+// DoScreenshot do screenshot based on chromedp
+func DoScreenshot(options libs.Options, pool *BrowserPool, req libs.Request, device Device) Screen {
+	raw := req.URL
+	imageScreen, contentFile := screenFiles(options, raw, device)
+	content := fmt.Sprintf("> %s %s\n", req.Method, raw)
 
 	screen := Screen{
 		URL:         raw,
 		ContentFile: contentFile,
+		Device:      device.Name,
 	}
 
-	opts := append(chromedp.DefaultExecAllocatorOptions[:],
-		chromedp.Flag("headless", true),
-		chromedp.Flag("ignore-certificate-errors", true),
-		chromedp.Flag("disable-gpu", true),
-		chromedp.Flag("enable-automation", true),
-		chromedp.Flag("disable-extensions", true),
-		chromedp.Flag("disable-setuid-sandbox", true),
-		chromedp.Flag("disable-web-security", true),
-		chromedp.Flag("no-first-run", true),
-		chromedp.Flag("no-default-browser-check", true),
-	)
-
-	if options.Proxy != "" {
-		opts = append(opts, chromedp.ProxyServer(options.Proxy))
+	b, err := pool.Acquire()
+	if err != nil {
+		utils.ErrorF("screen err: %v - %v", raw, err)
+		screen.Class = ClassBrowserError
+		return screen
 	}
-
-	// create context
-	allocCtx, bcancel := chromedp.NewExecAllocator(context.Background(), opts...)
-	defer bcancel()
-	ctx, cancel := chromedp.NewContext(allocCtx, chromedp.WithLogf(log.Printf))
-	ctx, cancel = context.WithTimeout(ctx, time.Duration(options.Screen.ScreenTimeout)*time.Second)
+	tabCtx, tabCancel := b.NewTab()
+	defer tabCancel()
+	ctx, cancel := context.WithTimeout(tabCtx, time.Duration(options.Screen.ScreenTimeout)*time.Second)
 	defer cancel()
 
 	// capture screenshot of an element
 	var buf []byte
 	var res libs.Response
+	recorder := NewNetworkRecorder()
+	events := NewPageEvents()
+	jsVars := make(map[string]string)
 
-	err := chromedp.Run(ctx,
-		fullScreenshot(ctx, options, raw, 90, &buf, &res),
+	release := RateLimiter.Wait(raw)
+	err = chromedp.Run(ctx,
+		fullScreenshot(ctx, options, req, device, &screen, recorder, events, jsVars, &buf, &res),
 		fetch.Enable().WithPatterns([]*fetch.RequestPattern{{RequestStage: fetch.RequestStageResponse}}),
 		chromedp.ActionFunc(func(ctx context.Context) error {
 			node, err := dom.GetDocument().Do(ctx)
@@ -110,11 +127,14 @@ func DoScreenshot(options libs.Options, raw string) string {
 		}),
 	)
 
-	// clean chromedp-runner folder
-	cleanUp()
+	release()
+	pool.Release(b, err)
+	RateLimiter.Backoff(raw, res.StatusCode, GetHeader(res.Headers, "Retry-After"))
+
 	if err != nil {
 		utils.ErrorF("screen err: %v - %v", raw, err)
-		return PrintScreen(options, screen)
+		screen.Class = ClassifyError(err)
+		return screen
 	}
 
 	if res.StatusCode != 0 || len(res.Body) > 0 {
@@ -133,7 +153,12 @@ func DoScreenshot(options libs.Options, raw string) string {
 		}
 
 		if options.Fin.Loaded {
-			techs := LocalFingerPrint(options, contentFile)
+			res.FinalURL = raw
+			target := NewRuleTarget(res)
+			if len(RuleJSVars()) > 0 {
+				target.JSVars = jsVars
+			}
+			techs := LocalFingerPrint(options, contentFile, &target)
 			screen.Technologies = techs
 		}
 	}
@@ -142,41 +167,112 @@ func DoScreenshot(options libs.Options, raw string) string {
 	if options.Output != "" {
 		if err := ioutil.WriteFile(imageScreen, buf, 0644); err != nil {
 			utils.ErrorF("write screen err: %v - %v", raw, err)
-			return PrintScreen(options, screen)
+			return screen
+		}
+	}
+
+	// webp can't be decoded so take a png of viewport for thumbnail and hashes
+	thumbSrc := buf
+	if !CanDecode(options) {
+		thumbSrc = nil
+		if err := chromedp.Run(ctx, chromedp.CaptureScreenshot(&thumbSrc)); err != nil {
+			utils.DebugF("thumbnail capture err: %v - %v", raw, err)
 		}
 	}
+	ProcessScreenshot(options, &screen, imageScreen, thumbSrc)
 
 	screen.Image = imageScreen
 	screen.Status = res.Status
-	overview := CalcCheckSum(options, raw, res)
+	SaveNetwork(options, recorder, &screen, imageScreen)
+	events.Apply(&screen)
+	overview := CalcCheckSum(options, req, res)
 	screen.Title = overview.Title
 	screen.CheckSum = overview.CheckSum
-	return PrintScreen(options, screen)
+	screen.Class = ClassifyCapture(res.Body, screen.Uniform)
+	return screen
 }
 
-// fullScreenshot takes a screenshot of the entire browser viewport.
-// Liberally copied from puppeteer's source.
-// Note: this will override the viewport emulation settings.
-func fullScreenshot(chromeContext context.Context, options libs.Options, urlstr string, quality int64, imgContent *[]byte, res *libs.Response) chromedp.Tasks {
+// fullScreenshot navigate to the request and takes a screenshot
+func fullScreenshot(chromeContext context.Context, options libs.Options, req libs.Request, device Device, screen *Screen, recorder *NetworkRecorder, events *PageEvents, jsVars map[string]string, imgContent *[]byte, res *libs.Response) chromedp.Tasks {
 	// setup a listener for events
 	//var requestHeaders map[string]interface{}
-	var uu string
+	urlstr := req.URL
+	uu := urlstr
+	override := needOverride(req)
+	tracker := NewNetworkTracker()
 
 	chromedp.ListenTarget(chromeContext, func(event interface{}) {
 		// get which type of event it is
 		switch msg := event.(type) {
+		// replay the original method and body on the main document
+		case *fetch.EventRequestPaused:
+			params := fetch.ContinueRequest(msg.RequestID)
+			if override && msg.ResourceType == network.ResourceTypeDocument {
+				override = false
+				params = params.WithMethod(req.Method)
+				if req.Body != "" {
+					params = params.WithPostData(base64.StdEncoding.EncodeToString([]byte(req.Body)))
+				}
+			}
+			go func() {
+				if err := chromedp.Run(chromeContext, params); err != nil {
+					utils.DebugF("continue request err: %v", err)
+				}
+			}()
+
 		// just before request sent
 		case *network.EventRequestWillBeSent:
+			tracker.Start(string(msg.RequestID))
 			request := msg.Request
 			// see if we have been redirected
 			// if so, change the URL that we are tracking
 			if msg.RedirectResponse != nil {
 				uu = request.URL
+				redirect := msg.RedirectResponse
+				recorder.Response(string(msg.RequestID), int(redirect.Status), redirect.StatusText, redirect.MimeType, redirect.Protocol, chromedpHeaders(redirect.Headers))
+				recorder.Finish(string(msg.RequestID), int64(redirect.EncodedDataLength))
 			}
+			var initiator HARInitiator
+			if msg.Initiator != nil {
+				initiator = HARInitiator{Type: string(msg.Initiator.Type), URL: msg.Initiator.URL}
+			}
+			recorder.Request(string(msg.RequestID), request.Method, request.URL+request.URLFragment, string(msg.Type), initiator, chromedpHeaders(request.Headers))
+
+		case *network.EventLoadingFinished:
+			tracker.Finish(string(msg.RequestID))
+			recorder.Finish(string(msg.RequestID), int64(msg.EncodedDataLength))
+		case *network.EventLoadingFailed:
+			tracker.Finish(string(msg.RequestID))
+			recorder.Fail(string(msg.RequestID), msg.ErrorText)
+
+		case *runtime.EventConsoleAPICalled:
+			var args []string
+			for _, arg := range msg.Args {
+				args = append(args, remoteObjectText(arg.Value, arg.Description, string(arg.UnserializableValue)))
+			}
+			events.AddConsole(string(msg.Type), args)
+		case *runtime.EventExceptionThrown:
+			if detail := msg.ExceptionDetails; detail != nil {
+				text := detail.Text
+				if detail.Exception != nil && detail.Exception.Description != "" {
+					text = detail.Exception.Description
+				}
+				events.AddException(text)
+			}
+		// dismiss dialog, otherwise the page is blocked until timeout
+		case *page.EventJavascriptDialogOpening:
+			events.AddDialog(string(msg.Type), msg.Message)
+			res.HasPopUp = true
+			go func() {
+				if err := chromedp.Run(chromeContext, page.HandleJavaScriptDialog(false)); err != nil {
+					utils.DebugF("dismiss dialog err: %v", err)
+				}
+			}()
 
 		// once we have the full response
 		case *network.EventResponseReceived:
 			response := msg.Response
+			recorder.Response(string(msg.RequestID), int(response.Status), response.StatusText, response.MimeType, response.Protocol, chromedpHeaders(response.Headers))
 			// is the request we want the status/headers on?
 			if response.URL == uu {
 				res.StatusCode = int(response.Status)
@@ -192,132 +288,511 @@ func fullScreenshot(chromeContext context.Context, options libs.Options, urlstr
 		}
 	})
 
+	tasks := chromedp.Tasks{
+		network.Enable(),
+		emulateDevice(device),
+	}
+	if headers := browserHeaders(req); len(headers) > 0 {
+		extra := make(network.Headers)
+		for k, v := range headers {
+			extra[k] = v
+		}
+		tasks = append(tasks, network.SetExtraHTTPHeaders(extra))
+	}
+	if len(options.Screen.Cookies) > 0 {
+		tasks = append(tasks, network.SetCookies(chromedpCookies(options.Screen.Cookies, urlstr)))
+	}
+	if options.Screen.JSBefore != "" {
+		tasks = append(tasks, chromedp.ActionFunc(func(ctx context.Context) error {
+			_, err := page.AddScriptToEvaluateOnNewDocument(options.Screen.JSBefore).Do(ctx)
+			return err
+		}))
+	}
+	if override {
+		tasks = append(tasks, fetch.Enable().WithPatterns([]*fetch.RequestPattern{{URLPattern: "*", ResourceType: network.ResourceTypeDocument, RequestStage: fetch.RequestStageRequest}}))
+	}
+
 	//var imageContent *[]byte
-	return chromedp.Tasks{
+	return append(tasks,
 		chromedp.Navigate(urlstr),
-		chromedp.FullScreenshot(imgContent, int(quality)),
-		network.Enable(),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			WaitPage(ctx, options, tracker, pageWaiter{
+				selector: func(ctx context.Context, sel string) error {
+					return chromedp.WaitReady(sel, chromedp.ByQuery).Do(ctx)
+				},
+				eval: func(ctx context.Context, js string) (bool, error) {
+					var ok bool
+					err := chromedp.Evaluate(js, &ok).Do(ctx)
+					return ok, err
+				},
+			})
+			return nil
+		}),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			if options.Screen.JSAfter == "" {
+				return nil
+			}
+			// the script error should not stop the capture
+			var result []byte
+			err := chromedp.Evaluate(fmt.Sprintf("(%s)()", scriptFunction(options.Screen.JSAfter)), &result, func(p *runtime.EvaluateParams) *runtime.EvaluateParams {
+				return p.WithAwaitPromise(true)
+			}).Do(ctx)
+			if err != nil {
+				utils.ErrorF("js-after err: %v - %v", urlstr, err)
+				return nil
+			}
+			if len(result) > 0 {
+				screen.ScriptResult = result
+			}
+			return nil
+		}),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			names := RuleJSVars()
+			if len(names) == 0 {
+				return nil
+			}
+			// JS variables used by custom rules
+			var values map[string]string
+			if err := chromedp.Evaluate(JSVarsScript(names), &values).Do(ctx); err != nil {
+				utils.DebugF("rules js err: %v - %v", urlstr, err)
+				return nil
+			}
+			for k, v := range values {
+				jsVars[k] = v
+			}
+			return nil
+		}),
+		captureScreenshot(options, imgContent),
+	)
+}
+
+// captureScreenshot capture the viewport or the entire page with format and quality from options
+func captureScreenshot(options libs.Options, res *[]byte) chromedp.Action {
+	return chromedp.ActionFunc(func(ctx context.Context) error {
+		params := page.CaptureScreenshot().WithFormat(page.CaptureScreenshotFormat(ImageFormat(options)))
+		if quality := ImageQuality(options); quality > 0 {
+			params = params.WithQuality(int64(quality))
+		}
+
+		if options.Screen.FullPage {
+			_, _, contentSize, _, _, cssContentSize, err := page.GetLayoutMetrics().Do(ctx)
+			if err != nil {
+				return err
+			}
+			// protocol v90 changed the return parameter name (contentSize -> cssContentSize)
+			if cssContentSize != nil {
+				contentSize = cssContentSize
+			}
+			params = params.WithCaptureBeyondViewport(true).WithClip(&page.Viewport{
+				X:      0,
+				Y:      0,
+				Width:  contentSize.Width,
+				Height: contentSize.Height,
+				Scale:  1,
+			})
+		}
+
+		var err error
+		*res, err = params.Do(ctx)
+		return err
+	})
+}
+
+// chromedpCookies convert cookies to chromedp params, cookie without domain belong to the url
+func chromedpCookies(cookies []libs.Cookie, raw string) []*network.CookieParam {
+	var params []*network.CookieParam
+	for _, cookie := range cookies {
+		param := &network.CookieParam{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Domain:   cookie.Domain,
+			Path:     cookie.Path,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HTTPOnly,
+			SameSite: network.CookieSameSite(cookie.SameSite),
+		}
+		if cookie.Domain == "" {
+			param.URL = raw
+		}
+		if cookie.Expires > 0 {
+			expires := cdp.TimeSinceEpoch(time.Unix(int64(cookie.Expires), 0))
+			param.Expires = &expires
+		}
+		params = append(params, param)
 	}
+	return params
 }
 
-func cleanUp() {
-	tmpFolder := path.Join(os.TempDir(), "chromedp-runner*")
-	if _, err := os.Stat("/tmp/"); !os.IsNotExist(err) {
-		tmpFolder = path.Join("/tmp/", "chromedp-runner*")
+// rodCookies convert cookies to rod params, cookie without domain belong to the url
+func rodCookies(cookies []libs.Cookie, raw string) []*proto.NetworkCookieParam {
+	var params []*proto.NetworkCookieParam
+	for _, cookie := range cookies {
+		param := &proto.NetworkCookieParam{
+			Name:     cookie.Name,
+			Value:    cookie.Value,
+			Domain:   cookie.Domain,
+			Path:     cookie.Path,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HTTPOnly,
+			SameSite: proto.NetworkCookieSameSite(cookie.SameSite),
+			Expires:  proto.TimeSinceEpoch(cookie.Expires),
+		}
+		if cookie.Domain == "" {
+			param.URL = raw
+		}
+		params = append(params, param)
 	}
-	junks, err := filepath.Glob(tmpFolder)
+	return params
+}
+
+// emulateDevice apply viewport, scale factor, mobile mode and user agent of device profile
+func emulateDevice(device Device) chromedp.Tasks {
+	var opts []chromedp.EmulateViewportOption
+	if device.Scale > 0 {
+		opts = append(opts, chromedp.EmulateScale(device.Scale))
+	}
+	if device.Mobile {
+		opts = append(opts, chromedp.EmulateMobile, chromedp.EmulateTouch)
+	}
+	tasks := chromedp.Tasks{
+		chromedp.EmulateViewport(int64(device.Width), int64(device.Height), opts...),
+	}
+	if device.UserAgent != "" {
+		tasks = append(tasks, emulation.SetUserAgentOverride(device.UserAgent))
+	}
+	return tasks
+}
+
+// rodEmulateDevice apply device profile to rod page
+func rodEmulateDevice(browser *rod.Page, device Device) error {
+	err := browser.SetViewport(&proto.EmulationSetDeviceMetricsOverride{
+		Width:             device.Width,
+		Height:            device.Height,
+		DeviceScaleFactor: device.Scale,
+		Mobile:            device.Mobile,
+	})
 	if err != nil {
-		return
+		return err
 	}
-	for _, junk := range junks {
-		os.RemoveAll(junk)
+	if device.Mobile {
+		err = proto.EmulationSetTouchEmulationEnabled{Enabled: true}.Call(browser)
+		if err != nil {
+			return err
+		}
+	}
+	if device.UserAgent != "" {
+		return browser.SetUserAgent(&proto.NetworkSetUserAgentOverride{UserAgent: device.UserAgent})
+	}
+	return nil
+}
+
+// screenFiles get image and content file of the url, non desktop device get a suffix
+func screenFiles(options libs.Options, raw string, device Device) (string, string) {
+	name := strings.Replace(raw, "://", "___", -1)
+	if device.Name != "" && device.Name != "desktop" {
+		name = fmt.Sprintf("%s-%s", name, device.Name)
+	}
+	imageScreen := path.Join(options.Screen.ScreenOutput, fmt.Sprintf("%v.%v", strings.Replace(name, "/", "_", -1), ImageExt(options)))
+
+	contentFile := fmt.Sprintf("%s.txt", name)
+	contentFile = strings.Replace(contentFile, "?", "_", -1)
+	contentFile = strings.Replace(contentFile, "/", "_", -1)
+	contentFile = path.Join(options.Screen.ScreenOutput, contentFile)
+	return imageScreen, contentFile
+}
+
+// needOverride check if the browser need to rewrite the method or body of the main request
+func needOverride(req libs.Request) bool {
+	method := strings.ToUpper(strings.TrimSpace(req.Method))
+	return (method != "" && method != "GET") || req.Body != ""
+}
+
+// chromedpHeaders convert chromedp headers to map
+func chromedpHeaders(headers network.Headers) map[string]string {
+	result := make(map[string]string)
+	for k, v := range headers {
+		result[k] = fmt.Sprint(v)
+	}
+	return result
+}
+
+// rodHeaderMap convert rod headers to map
+func rodHeaderMap(headers proto.NetworkHeaders) map[string]string {
+	result := make(map[string]string)
+	for k, v := range headers {
+		result[k] = v.Str()
+	}
+	return result
+}
+
+// rodHeaders convert rod headers to list of header
+func rodHeaders(headers proto.NetworkHeaders) []map[string]string {
+	var result []map[string]string
+	for k, v := range headers {
+		result = append(result, map[string]string{k: v.Str()})
+	}
+	return result
+}
+
+// browserHeaders get the headers of request that the browser should send
+func browserHeaders(req libs.Request) map[string]string {
+	headers := make(map[string]string)
+	for _, header := range req.Headers {
+		for k, v := range header {
+			// browser will take care of these
+			switch http.CanonicalHeaderKey(k) {
+			case "Host", "Content-Length", "Connection", "Accept-Encoding":
+				continue
+			}
+			headers[k] = v
+		}
 	}
+	return headers
 }
 
 /* Start using new lib */
 
 // NewDoScreenshot new do screenshot based on rod
-func NewDoScreenshot(options libs.Options, raw string) string {
+func NewDoScreenshot(options libs.Options, pool *BrowserPool, req libs.Request, device Device) Screen {
+	raw := req.URL
 	_, err := url.ParseRequestURI(raw)
 	if err != nil {
 		utils.ErrorF("invalid input: %v", raw)
-		return ""
+		return Screen{URL: raw}
 	}
 
-	imageName := strings.Replace(raw, "://", "___", -1)
-	imageScreen := path.Join(options.Screen.ScreenOutput, fmt.Sprintf("%v.png", strings.Replace(imageName, "/", "_", -1)))
-
-	contentFile := fmt.Sprintf("%s.txt", strings.Replace(raw, "://", "___", -1))
-	contentFile = strings.Replace(contentFile, "?", "_", -1)
-	contentFile = strings.Replace(contentFile, "/", "_", -1)
-	contentFile = path.Join(options.Screen.ScreenOutput, contentFile)
-	content := fmt.Sprintf("> GET %s\n", raw)
+	imageScreen, contentFile := screenFiles(options, raw, device)
+	content := fmt.Sprintf("> %s %s\n", req.Method, raw)
 
 	screen := Screen{
 		URL:         raw,
 		ContentFile: contentFile,
+		Device:      device.Name,
 	}
-	if options.Screen.ImgWidth == 0 {
-		options.Screen.ImgWidth = 2500
+
+	b, err := pool.Acquire()
+	if err != nil {
+		utils.ErrorF("screen err: %v - %v", raw, err)
+		screen.Class = ClassBrowserError
+		return screen
 	}
-	if options.Screen.ImgHeight == 0 {
-		options.Screen.ImgHeight = 1400
+	page, err := b.NewPage()
+	if err != nil {
+		pool.Release(b, err)
+		utils.ErrorF("screen err: %v - %v", raw, err)
+		screen.Class = ClassBrowserError
+		return screen
 	}
-
-	browser := rod.New().MustConnect().MustIgnoreCertErrors(true).MustPage("")
+	defer func() {
+		page.Close()
+		pool.Release(b, err)
+	}()
+
+	var response libs.Response
+	recorder := NewNetworkRecorder()
+	events := NewPageEvents()
+	release := RateLimiter.Wait(raw)
+	browser := page.Timeout(time.Duration(options.Screen.ScreenTimeout) * time.Second)
 	err = rod.Try(func() {
-		browser.MustNavigate(raw)
+		if err := rodEmulateDevice(browser, device); err != nil {
+			panic(err)
+		}
+		if len(options.Screen.Cookies) > 0 {
+			if err := browser.SetCookies(rodCookies(options.Screen.Cookies, raw)); err != nil {
+				panic(err)
+			}
+		}
+		if options.Screen.JSBefore != "" {
+			if _, err := browser.EvalOnNewDocument(options.Screen.JSBefore); err != nil {
+				panic(err)
+			}
+		}
+		if headers := browserHeaders(req); len(headers) > 0 {
+			var dict []string
+			for k, v := range headers {
+				dict = append(dict, k, v)
+			}
+			browser.MustSetExtraHeaders(dict...)
+		}
 
-		browser.Timeout(time.Duration(options.Screen.ScreenTimeout) * time.Second)
-		browser.MustWaitLoad()
+		// replay the original method and body on the main document
+		if needOverride(req) {
+			override := true
+			proto.FetchEnable{
+				Patterns: []*proto.FetchRequestPattern{{URLPattern: "*", ResourceType: proto.NetworkResourceTypeDocument, RequestStage: proto.FetchRequestStageRequest}},
+			}.Call(browser)
+			go browser.EachEvent(func(e *proto.FetchRequestPaused) {
+				params := proto.FetchContinueRequest{RequestID: e.RequestID}
+				if override && e.ResourceType == proto.NetworkResourceTypeDocument {
+					override = false
+					params.Method = req.Method
+					if req.Body != "" {
+						params.PostData = []byte(req.Body)
+					}
+				}
+				if err := params.Call(browser); err != nil {
+					utils.DebugF("continue request err: %v", err)
+				}
+			})()
+		}
 
-		go browser.EachEvent(func(e *proto.NetworkResponseReceived) {
+		tracker := NewNetworkTracker()
+		go browser.EachEvent(func(e *proto.NetworkRequestWillBeSent) {
+			tracker.Start(string(e.RequestID))
+			if redirect := e.RedirectResponse; redirect != nil {
+				recorder.Response(string(e.RequestID), redirect.Status, redirect.StatusText, redirect.MIMEType, redirect.Protocol, rodHeaderMap(redirect.Headers))
+				recorder.Finish(string(e.RequestID), int64(redirect.EncodedDataLength))
+			}
+			var initiator HARInitiator
+			if e.Initiator != nil {
+				initiator = HARInitiator{Type: string(e.Initiator.Type), URL: e.Initiator.URL}
+			}
+			recorder.Request(string(e.RequestID), e.Request.Method, e.Request.URL+e.Request.URLFragment, string(e.Type), initiator, rodHeaderMap(e.Request.Headers))
+		}, func(e *proto.NetworkLoadingFinished) {
+			tracker.Finish(string(e.RequestID))
+			recorder.Finish(string(e.RequestID), int64(e.EncodedDataLength))
+		}, func(e *proto.NetworkLoadingFailed) {
+			tracker.Finish(string(e.RequestID))
+			recorder.Fail(string(e.RequestID), e.ErrorText)
+		}, func(e *proto.RuntimeConsoleAPICalled) {
+			var args []string
+			for _, arg := range e.Args {
+				var value []byte
+				if arg.Type != proto.RuntimeRemoteObjectTypeUndefined {
+					value = []byte(arg.Value.JSON("", ""))
+				}
+				args = append(args, remoteObjectText(value, arg.Description, string(arg.UnserializableValue)))
+			}
+			events.AddConsole(string(e.Type), args)
+		}, func(e *proto.RuntimeExceptionThrown) {
+			if detail := e.ExceptionDetails; detail != nil {
+				text := detail.Text
+				if detail.Exception != nil && detail.Exception.Description != "" {
+					text = detail.Exception.Description
+				}
+				events.AddException(text)
+			}
+		}, func(e *proto.PageJavascriptDialogOpening) {
+			// dismiss dialog, otherwise the page is blocked until timeout
+			events.AddDialog(string(e.Type), e.Message)
+			go func() {
+				if err := (proto.PageHandleJavaScriptDialog{Accept: false}).Call(browser); err != nil {
+					utils.DebugF("dismiss dialog err: %v", err)
+				}
+			}()
+		}, func(e *proto.NetworkResponseReceived) {
+			recorder.Response(string(e.RequestID), e.Response.Status, e.Response.StatusText, e.Response.MIMEType, e.Response.Protocol, rodHeaderMap(e.Response.Headers))
 			// only get event match base URL
 			if strings.HasPrefix(e.Response.URL, raw) {
 				screen.Status = e.Response.StatusText
+				RateLimiter.Backoff(raw, e.Response.Status, GetHeader(rodHeaders(e.Response.Headers), "Retry-After"))
 				content += fmt.Sprintf("< HTTP/1.1 %v", e.Response.StatusText)
 				for k, v := range e.Response.Headers {
 					content += fmt.Sprintf("< %s: %s\n", k, v)
 				}
+				response.StatusCode = e.Response.Status
+				response.Headers = []map[string]string{rodHeaderMap(e.Response.Headers)}
 			}
 
 		})()
 
+		browser.MustNavigate(raw)
+		browser.MustWaitLoad()
+		WaitPage(browser.GetContext(), options, tracker, pageWaiter{
+			selector: func(ctx context.Context, sel string) error {
+				_, err := browser.Context(ctx).Element(sel)
+				return err
+			},
+			eval: func(ctx context.Context, js string) (bool, error) {
+				res, err := browser.Context(ctx).Eval("() => " + js)
+				if err != nil {
+					return false, err
+				}
+				return res.Value.Bool(), nil
+			},
+		})
 	})
+	release()
 	if err != nil {
-		utils.ErrorF("error screenshot")
-		return PrintScreen(options, screen)
-	}
-
-	//browser.MustNavigate(raw)
-
-	//browser := rod.New().MustConnect().MustIgnoreCertErrors(true).MustPage(raw)
-	//browser := rod.New().MustConnect().MustIgnoreCertErrors(true).MustPage(raw)
-	//defer browser.MustClose()
-	//
-	//err = browser.WaitLoad()
-	//if err != nil {
-	//	utils.ErrorF("error screenshot")
-	//	return PrintScreen(options, screen)
-	//}
-	//browser.Timeout(time.Duration(options.Screen.ScreenTimeout) * time.Second)
-
-	// get headers here
-
-	// capture entire browser viewport, returning jpg with quality=90
-	buf, err := browser.Screenshot(true, &proto.PageCaptureScreenshot{
-		Format:  proto.PageCaptureScreenshotFormatJpeg,
-		Quality: 90,
-		//Clip: &proto.PageViewport{
-		//	X:      0,
-		//	Y:      0,
-		//	Width:  float64(options.Screen.ImgHeight),
-		//	Height: float64(options.Screen.ImgHeight),
-		//	Scale:  1,
-		//},
+		utils.ErrorF("screen err: %v - %v", raw, err)
+		screen.Class = ClassifyError(err)
+		return screen
+	}
+
+	// the script error should not stop the capture
+	if options.Screen.JSAfter != "" {
+		result, err := browser.Eval(scriptFunction(options.Screen.JSAfter))
+		if err != nil {
+			utils.ErrorF("js-after err: %v - %v", raw, err)
+		} else if result.Type != proto.RuntimeRemoteObjectTypeUndefined {
+			if data, err := json.Marshal(result.Value.Val()); err == nil {
+				screen.ScriptResult = data
+			}
+		}
+	}
+
+	// capture the page with format and quality from options
+	buf, err := browser.Screenshot(options.Screen.FullPage, &proto.PageCaptureScreenshot{
+		Format:      proto.PageCaptureScreenshotFormat(ImageFormat(options)),
+		Quality:     ImageQuality(options),
 		FromSurface: true,
 	})
+	if err != nil {
+		utils.ErrorF("screen err: %v - %v", raw, err)
+		screen.Class = ClassifyError(err)
+		return screen
+	}
+
+	// webp can't be decoded so take a png of viewport for thumbnail and hashes
+	thumbSrc := buf
+	if !CanDecode(options) {
+		thumbSrc, err = browser.Screenshot(false, &proto.PageCaptureScreenshot{Format: proto.PageCaptureScreenshotFormatPng})
+		if err != nil {
+			utils.DebugF("thumbnail capture err: %v - %v", raw, err)
+		}
+	}
 
 	// store HTML data too in case we miss with probing
-	html := browser.MustElement("html").MustHTML()
+	var html string
+	if el, err := browser.Element("html"); err == nil {
+		html, _ = el.HTML()
+	}
 	content += html
 	_, err = WriteToFile(contentFile, content)
 	if options.Fin.Loaded {
-		techs := LocalFingerPrint(options, contentFile)
+		response.FinalURL = raw
+		response.Body = html
+		target := NewRuleTarget(response)
+		if names := RuleJSVars(); len(names) > 0 {
+			// JS variables used by custom rules
+			target.JSVars = make(map[string]string)
+			if result, err := browser.Eval("() => " + JSVarsScript(names)); err == nil {
+				for k, v := range result.Value.Map() {
+					target.JSVars[k] = v.Str()
+				}
+			} else {
+				utils.DebugF("rules js err: %v - %v", raw, err)
+			}
+		}
+		techs := LocalFingerPrint(options, contentFile, &target)
 		screen.Technologies = techs
 	}
 
 	if err != nil {
 		utils.ErrorF("write screen err: %v - %v", raw, err)
-		return PrintScreen(options, screen)
+		return screen
 	}
 	err = ioutil.WriteFile(imageScreen, buf, 0644)
 
 	// write image
 	if err != nil {
 		utils.ErrorF("write screen err: %v - %v", raw, err)
-		return PrintScreen(options, screen)
+		return screen
 	}
 	screen.Image = imageScreen
-	return PrintScreen(options, screen)
+	ProcessScreenshot(options, &screen, imageScreen, thumbSrc)
+	SaveNetwork(options, recorder, &screen, imageScreen)
+	events.Apply(&screen)
+	screen.Class = ClassifyCapture(html, screen.Uniform)
+	return screen
 }
@@ -9,19 +9,22 @@ import (
 func TestRodScreenshot(t *testing.T) {
 	var opt libs.Options
 	opt.Screen.ScreenOutput = "/tmp/"
+	opt.Screen.ScreenTimeout = 40
+	pool := NewBrowserPool(opt)
+	defer pool.Close()
 	url := "https://fides-carry.siri.apple.com/application.wadl"
-	result := NewDoScreenshot(opt, url)
+	result := NewDoScreenshot(opt, pool, libs.Request{URL: url, Method: "GET"}, Devices["desktop"])
 	fmt.Println("Screen: ", url, "--", result)
-	if result == "" {
+	if result.Image == "" {
 		t.Errorf("Error RodScreenshot")
 	}
 
 	fmt.Println("---------------------------")
 
 	url = "https://35.184.252.145/"
-	result = NewDoScreenshot(opt, url)
+	result = NewDoScreenshot(opt, pool, libs.Request{URL: url, Method: "GET"}, Devices["desktop"])
 	fmt.Println("Screen: ", url, "--", result)
-	if result == "" {
+	if result.Image == "" {
 		t.Errorf("Error RodScreenshot")
 	}
 }
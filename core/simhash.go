@@ -0,0 +1,164 @@
+package core
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Cluster group of overviews that look similar
+type Cluster struct {
+	ID       int      `json:"id"`
+	Size     int      `json:"size"`
+	Title    string   `json:"title"`
+	SimHash  string   `json:"simhash"`
+	CheckSum string   `json:"checksum"`
+	URLs     []string `json:"urls"`
+}
+
+var (
+	wordRegex  = regexp.MustCompile(`[\p{L}\p{N}_]{2,}`)
+	digitRegex = regexp.MustCompile(`\p{N}+`)
+)
+
+// SimHash calculate locality-sensitive hash based on DOM structure and visible text
+func SimHash(doc *goquery.Document) string {
+	var weights [64]int
+	addFeature := func(feature string) {
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				weights[i]++
+			} else {
+				weights[i]--
+			}
+		}
+	}
+
+	var count int
+	doc.Find("*").Each(func(i int, s *goquery.Selection) {
+		tag := goquery.NodeName(s)
+		parent := goquery.NodeName(s.Parent())
+		// structure of the tag
+		addFeature(fmt.Sprintf("tag:%s>%s", parent, tag))
+		count++
+
+		if tag == "script" || tag == "style" || tag == "noscript" {
+			return
+		}
+		// only take direct text of the tag so text is not counted twice
+		s.Contents().Each(func(i int, c *goquery.Selection) {
+			if goquery.NodeName(c) != "#text" {
+				return
+			}
+			for _, word := range wordRegex.FindAllString(strings.ToLower(c.Text()), -1) {
+				// numbers are usually volatile
+				addFeature("text:" + digitRegex.ReplaceAllString(word, "0"))
+				count++
+			}
+		})
+	})
+	if count == 0 {
+		return ""
+	}
+
+	var hashed uint64
+	for i := 0; i < 64; i++ {
+		if weights[i] > 0 {
+			hashed |= 1 << uint(i)
+		}
+	}
+	return fmt.Sprintf("%016x", hashed)
+}
+
+// SimHashDistance get number of different bits between two simhash
+func SimHashDistance(a, b string) int {
+	x, err := strconv.ParseUint(a, 16, 64)
+	if err != nil {
+		return 64
+	}
+	y, err := strconv.ParseUint(b, 16, 64)
+	if err != nil {
+		return 64
+	}
+	return bits.OnesCount64(x ^ y)
+}
+
+// ParseOverview parse a line of content summary in JSON or text format
+func ParseOverview(line string) (Overview, error) {
+	var overview Overview
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "{") {
+		err := jsoniter.UnmarshalFromString(line, &overview)
+		return overview, err
+	}
+
+	data := strings.Split(line, " ;; ")
+	if len(data) < 3 {
+		return overview, fmt.Errorf("invalid overview line: %v", line)
+	}
+	overview.URL = data[0]
+	overview.Title = data[1]
+	overview.CheckSum = data[2]
+	// simhash is the last field, or followed by technologies
+	for i := len(data) - 1; i > 2 && i >= len(data)-2; i-- {
+		if len(data[i]) != 16 {
+			continue
+		}
+		if _, err := strconv.ParseUint(data[i], 16, 64); err == nil {
+			overview.SimHash = data[i]
+			break
+		}
+	}
+	return overview, nil
+}
+
+// ClusterOverviews group overviews which simhash distance is not greater than distance
+func ClusterOverviews(overviews []Overview, distance int) []Cluster {
+	var clusters []Cluster
+	for _, overview := range overviews {
+		found := false
+		for i := range clusters {
+			cluster := &clusters[i]
+			if overview.SimHash == "" || cluster.SimHash == "" {
+				// no structure to compare so only exact checksum counted
+				if overview.SimHash != cluster.SimHash || overview.CheckSum != cluster.CheckSum {
+					continue
+				}
+			} else if SimHashDistance(overview.SimHash, cluster.SimHash) > distance {
+				continue
+			}
+			cluster.Size++
+			cluster.URLs = append(cluster.URLs, overview.URL)
+			found = true
+			break
+		}
+
+		if !found {
+			clusters = append(clusters, Cluster{
+				Size:     1,
+				Title:    overview.Title,
+				SimHash:  overview.SimHash,
+				CheckSum: overview.CheckSum,
+				URLs:     []string{overview.URL},
+			})
+		}
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Size > clusters[j].Size
+	})
+	for i := range clusters {
+		clusters[i].ID = i + 1
+	}
+	return clusters
+}
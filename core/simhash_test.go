@@ -0,0 +1,63 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func simHashOf(t *testing.T, body string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Error parsing body: %v", err)
+	}
+	return SimHash(doc)
+}
+
+func TestSimHash(t *testing.T) {
+	page := `<html><head><title>Dashboard</title></head><body><div class="nav"><a href="/">Home</a><a href="/settings">Settings</a></div>
+<div class="main"><h1>Welcome back %s</h1><p>Your last login was at %s from the office network</p><ul><li>Projects</li><li>Reports</li><li>Billing</li></ul></div></body></html>`
+	login := `<html><head><title>Sign in</title></head><body><form action="/login" method="post"><input name="user"><input name="password" type="password"><button>Sign in to continue</button></form><footer>Copyright</footer></body></html>`
+
+	first := simHashOf(t, fmt.Sprintf(page, "alice", "10:11"))
+	second := simHashOf(t, fmt.Sprintf(page, "bob", "23:59"))
+	other := simHashOf(t, login)
+
+	if first == "" || len(first) != 16 {
+		t.Fatalf("Error SimHash: %v", first)
+	}
+	if d := SimHashDistance(first, second); d > 6 {
+		t.Errorf("Error SimHash similar pages too far: %v", d)
+	}
+	if d := SimHashDistance(first, other); d <= 6 {
+		t.Errorf("Error SimHash different pages too close: %v", d)
+	}
+}
+
+func TestClusterOverviews(t *testing.T) {
+	lines := []string{
+		`{"url":"http://a.com","title":"Dashboard","checksum":"1","simhash":"ffff0000ffff0000"}`,
+		`http://b.com ;; Dashboard ;; 2 ;; No-Content ;; ffff0000ffff0001`,
+		`http://c.com ;; Sign in ;; 3 ;; No-Content ;; 0000ffff0000ffff`,
+		`http://d.com ;; No-Title ;; 4 ;; No-Content ;; No-SimHash`,
+		`http://e.com ;; No-Title ;; 4 ;; No-Content ;; No-SimHash`,
+	}
+	var overviews []Overview
+	for _, line := range lines {
+		overview, err := ParseOverview(line)
+		if err != nil {
+			t.Fatalf("Error ParseOverview: %v", err)
+		}
+		overviews = append(overviews, overview)
+	}
+
+	clusters := ClusterOverviews(overviews, 3)
+	if len(clusters) != 3 {
+		t.Fatalf("Error ClusterOverviews: %v", clusters)
+	}
+	if clusters[0].Size != 2 || clusters[1].Size != 2 || clusters[2].Size != 1 {
+		t.Errorf("Error ClusterOverviews sizes: %v", clusters)
+	}
+}
@@ -0,0 +1,230 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// TechDiff apps changed between two technology files
+type TechDiff struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Changed []string `json:"changed"`
+}
+
+// DataDir per-user data directory, can be changed with GOVERVIEW_DATA environment
+func DataDir() string {
+	return utils.NormalizePath(utils.GetOSEnv("GOVERVIEW_DATA", "~/.goverview"))
+}
+
+// UserTechFile technology file saved by update-tech command
+func UserTechFile() string {
+	return path.Join(DataDir(), "technologies.json")
+}
+
+// ResolveTechFile get technology file to load: the custom file, then the user file, then the embedded one ("").
+// A custom file that doesn't exist is an error instead of falling back
+func ResolveTechFile(options libs.Options) (string, error) {
+	if options.Fin.TechFile != "" {
+		if !utils.FileExists(options.Fin.TechFile) {
+			return "", fmt.Errorf("technology file not found: %v", options.Fin.TechFile)
+		}
+		return options.Fin.TechFile, nil
+	}
+	if utils.FileExists(UserTechFile()) {
+		return UserTechFile(), nil
+	}
+	return "", nil
+}
+
+// FetchTechs get technology file from URL or local path.
+// A URL ending with "/" or a local directory is read as split technology files
+func FetchTechs(source string) ([]byte, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		if strings.HasSuffix(source, "/") {
+			return fetchSplitTechs(source)
+		}
+
+		tmp, err := ioutil.TempFile("", "goverview-tech-*.json")
+		if err != nil {
+			return nil, err
+		}
+		tmp.Close()
+		defer os.Remove(tmp.Name())
+
+		if err := DownloadFile(source, tmp.Name()); err != nil {
+			return nil, err
+		}
+		return ioutil.ReadFile(tmp.Name())
+	}
+
+	source = utils.NormalizePath(source)
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("technology file not found: %v", source)
+	}
+	if info.IsDir() {
+		return ReadTechDir(source)
+	}
+	return ioutil.ReadFile(source)
+}
+
+// fetchSplitTechs download categories.json and technologies/{_,a-z}.json under the base URL
+func fetchSplitTechs(base string) ([]byte, error) {
+	dir, err := ioutil.TempDir("", "goverview-tech")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	utils.MakeDir(path.Join(dir, "technologies"))
+
+	if err := DownloadFile(base+"categories.json", path.Join(dir, "categories.json")); err != nil {
+		return nil, err
+	}
+	for _, name := range strings.Split("_abcdefghijklmnopqrstuvwxyz", "") {
+		techFile := path.Join("technologies", name+".json")
+		if err := DownloadFile(base+techFile, path.Join(dir, techFile)); err != nil {
+			return nil, err
+		}
+	}
+	return ReadTechDir(dir)
+}
+
+// ReadTechDir merge split technology files of a directory into one technologies.json.
+// categories.json is looked up in the directory then its parent, like the upstream src/ layout
+func ReadTechDir(dir string) ([]byte, error) {
+	var files []string
+	for _, pattern := range []string{path.Join(dir, "*.json"), path.Join(dir, "technologies", "*.json")} {
+		matches, _ := filepath.Glob(pattern)
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	categories := make(map[string]json.RawMessage)
+	technologies := make(map[string]json.RawMessage)
+	for _, catFile := range []string{path.Join(path.Dir(dir), "categories.json"), path.Join(dir, "categories.json")} {
+		if data, err := ioutil.ReadFile(catFile); err == nil {
+			if err := json.Unmarshal(data, &categories); err != nil {
+				return nil, fmt.Errorf("%v: %v", catFile, err)
+			}
+		}
+	}
+
+	for _, filename := range files {
+		if path.Base(filename) == "categories.json" {
+			continue
+		}
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		var content map[string]json.RawMessage
+		if err := json.Unmarshal(data, &content); err != nil {
+			return nil, fmt.Errorf("%v: %v", filename, err)
+		}
+
+		// a full technologies.json
+		if _, ok := content["technologies"]; ok {
+			var defs struct {
+				Apps map[string]json.RawMessage `json:"technologies"`
+				Cats map[string]json.RawMessage `json:"categories"`
+			}
+			if err := json.Unmarshal(data, &defs); err != nil {
+				return nil, fmt.Errorf("%v: %v", filename, err)
+			}
+			for k, v := range defs.Cats {
+				categories[k] = v
+			}
+			for k, v := range defs.Apps {
+				technologies[k] = v
+			}
+			continue
+		}
+		for k, v := range content {
+			technologies[k] = v
+		}
+	}
+	if len(technologies) == 0 {
+		return nil, fmt.Errorf("no technology file found in: %v", dir)
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"categories":   categories,
+		"technologies": technologies,
+	})
+}
+
+// ParseTechs validate technology data by loading it like LoadTechs does
+func ParseTechs(data []byte) (*WebAnalyzer, error) {
+	tmp, err := ioutil.TempFile("", "goverview-tech-*.json")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	tmp.Close()
+
+	wa := new(WebAnalyzer)
+	if err := wa.LoadApps(tmp.Name()); err != nil {
+		return nil, err
+	}
+	if wa.AppDefs == nil || len(wa.AppDefs.Apps) == 0 {
+		return nil, fmt.Errorf("no technology found")
+	}
+	return wa, nil
+}
+
+// DiffApps compare apps of the current and the new technology file
+func DiffApps(current, latest *WebAnalyzer) TechDiff {
+	var diff TechDiff
+	oldApps := make(map[string]App)
+	if current != nil && current.AppDefs != nil {
+		oldApps = current.AppDefs.Apps
+	}
+
+	for name, app := range latest.AppDefs.Apps {
+		old, ok := oldApps[name]
+		if !ok {
+			diff.Added = append(diff.Added, name)
+			continue
+		}
+		// encoding/json sort map keys so the same app always has the same output
+		oldRaw, _ := json.Marshal(old)
+		newRaw, _ := json.Marshal(app)
+		if string(oldRaw) != string(newRaw) {
+			diff.Changed = append(diff.Changed, name)
+		}
+	}
+	for name := range oldApps {
+		if _, ok := latest.AppDefs.Apps[name]; !ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	return diff
+}
+
+// SaveTechs write technology data to the user file
+func SaveTechs(data []byte) (string, error) {
+	utils.MakeDir(DataDir())
+	techFile := UserTechFile()
+	if err := ioutil.WriteFile(techFile, data, 0644); err != nil {
+		return "", err
+	}
+	return techFile, nil
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestUpdateTechs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goverview-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old, ok := os.LookupEnv("GOVERVIEW_DATA")
+	os.Setenv("GOVERVIEW_DATA", dir)
+	defer func() {
+		if ok {
+			os.Setenv("GOVERVIEW_DATA", old)
+		} else {
+			os.Unsetenv("GOVERVIEW_DATA")
+		}
+	}()
+
+	// local mirror of technologies file
+	latestRaw := strings.Replace(testTechnologies, `"website": "http://nginx.org"`, `"website": "https://nginx.org"`, 1)
+	latestRaw = strings.Replace(latestRaw, `"PHP": {`, `"Caddy": {"cats": [22], "headers": {"Server": "^Caddy$"}},
+    "PHP": {`, 1)
+	latestRaw = strings.Replace(latestRaw, `,
+    "jQuery": {"cats": [59], "scripts": "jquery", "script": "jquery(?:-(\\d+\\.\\d+\\.\\d+))[/.-]\\;version:\\1"}`, "", 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/technologies.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(latestRaw))
+	}))
+	defer ts.Close()
+
+	if _, err := FetchTechs(ts.URL + "/missing.json"); err == nil {
+		t.Errorf("Error FetchTechs should fail on 404")
+	}
+	data, err := FetchTechs(ts.URL + "/technologies.json")
+	if err != nil {
+		t.Fatalf("Error FetchTechs: %v", err)
+	}
+	latest, err := ParseTechs(data)
+	if err != nil {
+		t.Fatalf("Error ParseTechs: %v", err)
+	}
+	if _, err := ParseTechs([]byte(`{"technologies": "broken"`)); err == nil {
+		t.Errorf("Error ParseTechs should fail on invalid file")
+	}
+
+	current, err := ParseTechs([]byte(testTechnologies))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff := DiffApps(current, latest)
+	if strings.Join(diff.Added, ",") != "Caddy" || strings.Join(diff.Removed, ",") != "jQuery" || strings.Join(diff.Changed, ",") != "Nginx" {
+		t.Errorf("Error DiffApps: %+v", diff)
+	}
+
+	// saved file is preferred over the embedded one
+	var opt libs.Options
+	if techFile, err := ResolveTechFile(opt); techFile != "" || err != nil {
+		t.Errorf("Error ResolveTechFile should be embedded before update")
+	}
+	techFile, err := SaveTechs(data)
+	if err != nil {
+		t.Fatalf("Error SaveTechs: %v", err)
+	}
+	if resolved, _ := ResolveTechFile(opt); techFile != path.Join(dir, "technologies.json") || resolved != techFile {
+		t.Errorf("Error ResolveTechFile: %v", resolved)
+	}
+	if err := LoadTechs(opt); err != nil || len(WA.AppDefs.Apps) != 4 {
+		t.Errorf("Error LoadTechs should load the saved file: %v", err)
+	}
+
+	// missing custom file doesn't fall back to the saved one
+	opt.Fin.TechFile = path.Join(dir, "missing.json")
+	if _, err := ResolveTechFile(opt); err == nil {
+		t.Errorf("Error ResolveTechFile should fail on missing custom file")
+	}
+	if err := LoadTechs(opt); err == nil {
+		t.Errorf("Error LoadTechs should fail on missing custom file")
+	}
+
+	// local path works too
+	if _, err := FetchTechs(techFile); err != nil {
+		t.Errorf("Error FetchTechs from local path: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Evidence what a technology was found by
+type Evidence struct {
+	// html, header, cookie, script, meta, url, js, dom or implies
+	// and status, body, title, favicon, hash for custom rules
+	Type  string `json:"type"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// Technology detected technology of a page
+type Technology struct {
+	Name       string     `json:"name"`
+	Version    string     `json:"version,omitempty"`
+	Categories []string   `json:"categories,omitempty"`
+	Confidence int        `json:"confidence"`
+	Website    string     `json:"website,omitempty"`
+	CPE        string     `json:"cpe,omitempty"`
+	Evidence   []Evidence `json:"evidence,omitempty"`
+}
+
+// Technologies list of detected technologies
+type Technologies []Technology
+
+// UnmarshalJSON also accept the old "name/version,name" string output
+func (t *Technologies) UnmarshalJSON(data []byte) error {
+	var flat string
+	if err := jsoniter.Unmarshal(data, &flat); err == nil {
+		*t = ParseFlatTechs(flat)
+		return nil
+	}
+
+	var techs []Technology
+	if err := jsoniter.Unmarshal(data, &techs); err != nil {
+		return err
+	}
+	*t = techs
+	return nil
+}
+
+// String flat form of technologies: name/version separated by comma
+func (t Technologies) String() string {
+	var techs []string
+	for _, tech := range t {
+		name := tech.Name
+		if tech.Version != "" {
+			name = fmt.Sprintf("%s/%s", tech.Name, tech.Version)
+		}
+		techs = append(techs, name)
+	}
+	return strings.Join(techs, ",")
+}
+
+// ParseFlatTechs parse the flat form of technologies
+func ParseFlatTechs(flat string) Technologies {
+	var techs Technologies
+	for _, item := range strings.Split(flat, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		tech := Technology{Name: item}
+		if i := strings.LastIndex(item, "/"); i > 0 {
+			tech.Name, tech.Version = item[:i], item[i+1:]
+		}
+		techs = append(techs, tech)
+	}
+	return techs
+}
+
+// ToTechnologies convert matches to technologies, sorted by name
+func ToTechnologies(matches []Match) Technologies {
+	var techs Technologies
+	for _, match := range uniqueMatches(matches) {
+		tech := Technology{
+			Name:       match.AppName,
+			Version:    match.Version,
+			Categories: match.CatNames,
+			Confidence: match.Confidence,
+			Website:    match.Website,
+			CPE:        match.CPE,
+		}
+
+		seen := make(map[Evidence]bool)
+		for _, evidence := range match.Evidence {
+			if seen[evidence] {
+				continue
+			}
+			seen[evidence] = true
+			tech.Evidence = append(tech.Evidence, evidence)
+		}
+		techs = append(techs, tech)
+	}
+	return techs
+}
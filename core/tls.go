@@ -0,0 +1,60 @@
+package core
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+// tlsVersions names of TLS versions
+var tlsVersions = map[uint16]string{
+	tls.VersionSSL30: "SSLv3",
+	tls.VersionTLS10: "TLS 1.0",
+	tls.VersionTLS11: "TLS 1.1",
+	tls.VersionTLS12: "TLS 1.2",
+	tls.VersionTLS13: "TLS 1.3",
+}
+
+// ParseTLS get certificate and handshake metadata from connection state
+func ParseTLS(state *tls.ConnectionState) *libs.TLSInfo {
+	if state == nil {
+		return nil
+	}
+
+	info := &libs.TLSInfo{
+		Cipher: tls.CipherSuiteName(state.CipherSuite),
+		ALPN:   state.NegotiatedProtocol,
+	}
+	info.Version = tlsVersions[state.Version]
+	if info.Version == "" {
+		info.Version = fmt.Sprintf("0x%04x", state.Version)
+	}
+	if len(state.PeerCertificates) == 0 {
+		return info
+	}
+
+	cert := state.PeerCertificates[0]
+	info.Subject = cert.Subject.String()
+	info.CommonName = cert.Subject.CommonName
+	info.Issuer = cert.Issuer.String()
+	info.SANs = append(info.SANs, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		info.SANs = append(info.SANs, ip.String())
+	}
+	info.NotBefore = cert.NotBefore.UTC().Format(time.RFC3339)
+	info.NotAfter = cert.NotAfter.UTC().Format(time.RFC3339)
+	info.Expired = time.Now().After(cert.NotAfter)
+	info.SelfSigned = IsSelfSigned(cert)
+	return info
+}
+
+// IsSelfSigned check if certificate is signed by its own key
+func IsSelfSigned(cert *x509.Certificate) bool {
+	if cert.Subject.String() != cert.Issuer.String() {
+		return false
+	}
+	return cert.CheckSignatureFrom(cert) == nil
+}
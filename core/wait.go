@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+	"github.com/j3ssie/goverview/utils"
+)
+
+// NetworkTracker track in-flight requests of a page to detect network idle
+type NetworkTracker struct {
+	mu         sync.Mutex
+	inflight   map[string]bool
+	lastChange time.Time
+}
+
+// NewNetworkTracker create new network tracker
+func NewNetworkTracker() *NetworkTracker {
+	return &NetworkTracker{
+		inflight:   make(map[string]bool),
+		lastChange: time.Now(),
+	}
+}
+
+// Start mark a request as in-flight
+func (t *NetworkTracker) Start(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inflight[id] = true
+	t.lastChange = time.Now()
+}
+
+// Finish mark a request as finished or failed
+func (t *NetworkTracker) Finish(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.inflight[id] {
+		delete(t.inflight, id)
+		t.lastChange = time.Now()
+	}
+}
+
+// Idle check if there is no request in-flight for the duration
+func (t *NetworkTracker) Idle(idle time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.inflight) == 0 && time.Since(t.lastChange) >= idle
+}
+
+// pageWaiter browser engine functions needed by wait strategies
+type pageWaiter struct {
+	// wait until the css selector appear
+	selector func(ctx context.Context, sel string) error
+	// evaluate the js expression as boolean
+	eval func(ctx context.Context, js string) (bool, error)
+}
+
+// WaitTimeout get timeout of each wait strategy
+func WaitTimeout(options libs.Options) time.Duration {
+	if options.Screen.WaitTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(options.Screen.WaitTimeout) * time.Second
+}
+
+// WaitPage apply wait strategies after page load, a timeout only logs and we still capture the page
+func WaitPage(ctx context.Context, options libs.Options, tracker *NetworkTracker, waiter pageWaiter) {
+	timeout := WaitTimeout(options)
+
+	if options.Screen.WaitIdle > 0 && tracker != nil {
+		idle := time.Duration(options.Screen.WaitIdle) * time.Millisecond
+		err := pollUntil(ctx, timeout, func(context.Context) (bool, error) {
+			return tracker.Idle(idle), nil
+		})
+		if err != nil {
+			utils.DebugF("wait network idle: %v", err)
+		}
+	}
+
+	if options.Screen.WaitSelector != "" && waiter.selector != nil {
+		wctx, cancel := context.WithTimeout(ctx, timeout)
+		err := waiter.selector(wctx, options.Screen.WaitSelector)
+		cancel()
+		if err != nil {
+			utils.DebugF("wait selector %v: %v", options.Screen.WaitSelector, err)
+		}
+	}
+
+	if options.Screen.WaitJS != "" && waiter.eval != nil {
+		js := "!!(" + options.Screen.WaitJS + ")"
+		err := pollUntil(ctx, timeout, func(wctx context.Context) (bool, error) {
+			ok, err := waiter.eval(wctx, js)
+			// the page might be navigating, keep polling
+			if err != nil {
+				utils.DebugF("wait js err: %v", err)
+			}
+			return ok, nil
+		})
+		if err != nil {
+			utils.DebugF("wait js %v: %v", options.Screen.WaitJS, err)
+		}
+	}
+
+	if options.Screen.WaitDelay > 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Duration(options.Screen.WaitDelay) * time.Millisecond):
+		}
+	}
+}
+
+// pollUntil call the condition until it return true or the timeout reached
+func pollUntil(ctx context.Context, timeout time.Duration, condition func(ctx context.Context) (bool, error)) error {
+	wctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		ok, err := condition(wctx)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-wctx.Done():
+			return wctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
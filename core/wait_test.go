@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/j3ssie/goverview/libs"
+)
+
+func TestNetworkTracker(t *testing.T) {
+	tracker := NewNetworkTracker()
+	tracker.Start("1")
+	if tracker.Idle(0) {
+		t.Errorf("Error tracker should be busy")
+	}
+	tracker.Finish("1")
+	tracker.Finish("unknown")
+	if !tracker.Idle(0) {
+		t.Errorf("Error tracker should be idle")
+	}
+	if tracker.Idle(time.Hour) {
+		t.Errorf("Error tracker should wait for idle duration")
+	}
+}
+
+func TestWaitPage(t *testing.T) {
+	var opt libs.Options
+	opt.Screen.WaitJS = "window.ready"
+	opt.Screen.WaitSelector = "#app"
+	opt.Screen.WaitIdle = 100
+	opt.Screen.WaitTimeout = 1
+
+	var evaluated []string
+	var selected string
+	count := 0
+	tracker := NewNetworkTracker()
+	tracker.Start("pending")
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		tracker.Finish("pending")
+	}()
+
+	WaitPage(context.Background(), opt, tracker, pageWaiter{
+		selector: func(ctx context.Context, sel string) error {
+			selected = sel
+			return nil
+		},
+		eval: func(ctx context.Context, js string) (bool, error) {
+			evaluated = append(evaluated, js)
+			count++
+			return count >= 3, nil
+		},
+	})
+	if !tracker.Idle(100 * time.Millisecond) {
+		t.Errorf("Error WaitPage should wait for network idle")
+	}
+	if selected != "#app" || count != 3 || evaluated[0] != "!!(window.ready)" {
+		t.Errorf("Error WaitPage: %v %v %v", selected, count, evaluated)
+	}
+
+	// never true, should give up after the timeout
+	opt.Screen.WaitIdle = 0
+	opt.Screen.WaitSelector = ""
+	start := time.Now()
+	WaitPage(context.Background(), opt, nil, pageWaiter{
+		eval: func(ctx context.Context, js string) (bool, error) {
+			return false, fmt.Errorf("not ready")
+		},
+	})
+	if elapsed := time.Since(start); elapsed < time.Second || elapsed > 3*time.Second {
+		t.Errorf("Error WaitPage timeout: %v", elapsed)
+	}
+}
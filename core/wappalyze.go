@@ -3,14 +3,15 @@ package core
 import (
 	"bytes"
 	"fmt"
-	"github.com/markbates/pkger"
 	"io"
 	"net/http"
 	"os"
 	"regexp"
 	"strings"
 
+	"github.com/j3ssie/goverview/utils"
 	jsoniter "github.com/json-iterator/go"
+	"github.com/markbates/pkger"
 )
 
 // WappalyzerURL is the link to the latest apps.json file in the Wappalyzer repo
@@ -28,25 +29,54 @@ type StringArray []string
 
 // App type encapsulates all the data about an App from apps.json
 type App struct {
-	Cats     StringArray       `json:"cats"`
-	CatNames []string          `json:"category_names"`
-	Cookies  map[string]string `json:"cookies"`
-	Headers  map[string]string `json:"headers"`
-	Meta     map[string]string `json:"meta"`
-	JS       map[string]string `json:"js"`
-	HTML     StringArray       `json:"html"`
-	Script   StringArray       `json:"script"`
-	URL      StringArray       `json:"url"`
-	Website  string            `json:"website"`
-	Implies  StringArray       `json:"implies"`
-
-	HTMLRegex   []AppRegexp `json:"-"`
-	ScriptRegex []AppRegexp `json:"-"`
-	URLRegex    []AppRegexp `json:"-"`
-	HeaderRegex []AppRegexp `json:"-"`
-	MetaRegex   []AppRegexp `json:"-"`
-	CookieRegex []AppRegexp `json:"-"`
-	JSRegex     []AppRegexp `json:"-"`
+	Cats             StringArray            `json:"cats"`
+	CatNames         []string               `json:"category_names"`
+	Cookies          map[string]string      `json:"cookies"`
+	Headers          map[string]string      `json:"headers"`
+	Meta             map[string]StringArray `json:"meta"`
+	JS               map[string]string      `json:"js"`
+	HTML             StringArray            `json:"html"`
+	Script           StringArray            `json:"script"`
+	ScriptSrc        StringArray            `json:"scriptSrc"`
+	Scripts          StringArray            `json:"scripts"`
+	DOM              DOMRules               `json:"dom"`
+	URL              StringArray            `json:"url"`
+	Website          string                 `json:"website"`
+	CPE              string                 `json:"cpe"`
+	Implies          StringArray            `json:"implies"`
+	Excludes         StringArray            `json:"excludes"`
+	Requires         StringArray            `json:"requires"`
+	RequiresCategory StringArray            `json:"requiresCategory"`
+
+	HTMLRegex       []AppRegexp `json:"-"`
+	ScriptRegex     []AppRegexp `json:"-"`
+	ScriptBodyRegex []AppRegexp `json:"-"`
+	URLRegex        []AppRegexp `json:"-"`
+	HeaderRegex     []AppRegexp `json:"-"`
+	MetaRegex       []AppRegexp `json:"-"`
+	CookieRegex     []AppRegexp `json:"-"`
+	JSRegex         []AppRegexp `json:"-"`
+	DOMRegex        []DOMRegexp `json:"-"`
+}
+
+// DOMRule what to check on elements found by a DOM selector
+type DOMRule struct {
+	Exists     *string           `json:"exists,omitempty"`
+	Text       *string           `json:"text,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// DOMRules DOM selectors of an app, keyed by selector
+type DOMRules map[string]DOMRule
+
+// DOMRegexp compiled DOM rule
+type DOMRegexp struct {
+	Selector   string
+	Exists     bool
+	Confidence int
+	Text       *AppRegexp
+	Attributes []AppRegexp
 }
 
 // Category names defined by wappalyzer
@@ -61,9 +91,10 @@ type AppsDefinition struct {
 }
 
 type AppRegexp struct {
-	Name    string
-	Regexp  *regexp.Regexp
-	Version string
+	Name       string
+	Regexp     *regexp.Regexp
+	Version    string
+	Confidence int
 }
 
 func (app *App) FindInHeaders(headers http.Header) (matches [][]string, version string) {
@@ -92,9 +123,14 @@ func (t *StringArray) UnmarshalJSON(data []byte) error {
 	var s string
 	var sa []string
 	var na []int
+	var n int
 
 	if err := jsoniter.Unmarshal(data, &s); err != nil {
-		if err := jsoniter.Unmarshal(data, &na); err == nil {
+		if err := jsoniter.Unmarshal(data, &n); err == nil {
+			// a single number, e.g. requiresCategory
+			*t = StringArray{fmt.Sprintf("%d", n)}
+			return nil
+		} else if err := jsoniter.Unmarshal(data, &na); err == nil {
 			// not a string, so maybe []int?
 			*t = make(StringArray, len(na))
 
@@ -115,24 +151,53 @@ func (t *StringArray) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// DownloadFile pulls the latest apps.json file from the Wappalyzer github
+// UnmarshalJSON accept dom as a selector, a list of selectors or selectors with their rules
+func (d *DOMRules) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var rules map[string]DOMRule
+		if err := jsoniter.Unmarshal(data, &rules); err != nil {
+			return err
+		}
+		*d = rules
+		return nil
+	}
+
+	var selectors StringArray
+	if err := selectors.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*d = make(DOMRules)
+	for _, selector := range selectors {
+		exists := ""
+		(*d)[selector] = DOMRule{Exists: &exists}
+	}
+	return nil
+}
+
+// DownloadFile pulls the technologies file from the Wappalyzer github or a mirror
 func DownloadFile(from, to string) error {
 	resp, err := http.Get(from)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %v from %v", resp.Status, from)
+	}
 
 	f, err := os.Create(to)
 	if err != nil {
 		return err
 	}
+	defer f.Close()
 
-	_, err = io.Copy(f, resp.Body)
-	return err
+	if _, err = io.Copy(f, resp.Body); err != nil {
+		return err
+	}
+	return f.Close()
 }
 
-// LoadApps load apps from file
+// LoadApps load apps from technology file, or a directory of split technology files (a.json ... z.json)
 func (wa *WebAnalyzer) LoadApps(filename string) error {
 	if filename == "" {
 		f, err := pkger.Open("/static/technologies.json")
@@ -146,6 +211,14 @@ func (wa *WebAnalyzer) LoadApps(filename string) error {
 		if err = jsoniter.UnmarshalFromString(buf.String(), &wa.AppDefs); err != nil {
 			return err
 		}
+	} else if info, err := os.Stat(filename); err == nil && info.IsDir() {
+		data, err := ReadTechDir(filename)
+		if err != nil {
+			return err
+		}
+		if err = jsoniter.Unmarshal(data, &wa.AppDefs); err != nil {
+			return err
+		}
 	} else {
 		f, err := os.Open(filename)
 		if err != nil {
@@ -163,13 +236,23 @@ func (wa *WebAnalyzer) LoadApps(filename string) error {
 		app := wa.AppDefs.Apps[key]
 
 		app.HTMLRegex = compileRegexes(value.HTML)
-		app.ScriptRegex = compileRegexes(value.Script)
+		// "scripts" used to be the src of script tags, upstream now use "scriptSrc" for that
+		// and "scripts" for the inline content, so check it on both
+		app.ScriptRegex = compileRegexes(append(append(append(StringArray{}, value.Script...), value.ScriptSrc...), value.Scripts...))
+		app.ScriptBodyRegex = compileRegexes(value.Scripts)
 		app.URLRegex = compileRegexes(value.URL)
 
 		app.HeaderRegex = compileNamedRegexes(app.Headers)
-		app.MetaRegex = compileNamedRegexes(app.Meta)
 		app.CookieRegex = compileNamedRegexes(app.Cookies)
 		app.JSRegex = compileNamedRegexes(app.JS)
+		app.DOMRegex = compileDOMRegexes(app.DOM)
+
+		app.MetaRegex = nil
+		for name, values := range app.Meta {
+			for _, value := range values {
+				app.MetaRegex = append(app.MetaRegex, compileNamedRegexes(map[string]string{name: value})...)
+			}
+		}
 
 		app.CatNames = make([]string, 0)
 
@@ -190,27 +273,55 @@ func (wa *WebAnalyzer) CategoryById(cid string) string {
 	return wa.AppDefs.Cats[cid].Name
 }
 
+// compilePattern compile a wappalyzer pattern with its \;version: and \;confidence: modifiers
+func compilePattern(value string) (AppRegexp, error) {
+	rv := AppRegexp{
+		Confidence: 100,
+	}
+
+	splitted := strings.Split(value, "\\;")
+	// patterns of wappalyzer are case insensitive
+	r, err := regexp.Compile("(?i)" + splitted[0])
+	if err != nil {
+		return rv, err
+	}
+	rv.Regexp = r
+
+	for _, modifier := range splitted[1:] {
+		if strings.HasPrefix(modifier, "version:") {
+			rv.Version = modifier[8:]
+		} else if strings.HasPrefix(modifier, "confidence:") {
+			rv.Confidence = utils.StrToInt(modifier[11:])
+		}
+	}
+	return rv, nil
+}
+
+// patternValue get the value of a pattern without modifiers and its confidence, e.g: PHP\;confidence:50
+func patternValue(value string) (string, int) {
+	confidence := 100
+	splitted := strings.Split(value, "\\;")
+	for _, modifier := range splitted[1:] {
+		if strings.HasPrefix(modifier, "confidence:") {
+			confidence = utils.StrToInt(modifier[11:])
+		}
+	}
+	return strings.TrimSpace(splitted[0]), confidence
+}
+
 func compileNamedRegexes(from map[string]string) []AppRegexp {
 	var list []AppRegexp
 	for key, value := range from {
-		h := AppRegexp{
-			Name: key,
-		}
 		if value == "" {
 			value = ".*"
 		}
 
 		// Filter out webapplyzer attributes from regular expression
-		splitted := strings.Split(value, "\\;")
-		r, err := regexp.Compile(splitted[0])
+		h, err := compilePattern(value)
 		if err != nil {
 			continue
 		}
-
-		if len(splitted) > 1 && strings.HasPrefix(splitted[1], "version:") {
-			h.Version = splitted[1][8:]
-		}
-		h.Regexp = r
+		h.Name = key
 		list = append(list, h)
 	}
 	return list
@@ -220,25 +331,43 @@ func compileRegexes(s StringArray) []AppRegexp {
 	var list []AppRegexp
 	for _, regexString := range s {
 		// Split version detection
-		splitted := strings.Split(regexString, "\\;")
-		regex, err := regexp.Compile(splitted[0])
+		rv, err := compilePattern(regexString)
 		if err != nil {
 			// ignore failed compiling for now
 			// log.Printf("warning: compiling regexp for failed: %v", regexString, err)
-		} else {
-			rv := AppRegexp{
-				Regexp: regex,
-			}
-			if len(splitted) > 1 && strings.HasPrefix(splitted[0], "version") {
-				rv.Version = splitted[1][8:]
-			}
-			list = append(list, rv)
+			continue
 		}
+		list = append(list, rv)
 	}
 
 	return list
 }
 
+func compileDOMRegexes(rules DOMRules) []DOMRegexp {
+	var list []DOMRegexp
+	for selector, rule := range rules {
+		dr := DOMRegexp{
+			Selector: selector,
+			Exists:   rule.Exists != nil,
+		}
+		if dr.Exists {
+			_, dr.Confidence = patternValue(*rule.Exists)
+		}
+		if rule.Text != nil {
+			if rv, err := compilePattern(*rule.Text); err == nil {
+				dr.Text = &rv
+			}
+		}
+		dr.Attributes = compileNamedRegexes(rule.Attributes)
+		// properties need a live page, so they are not checked here
+		if !dr.Exists && dr.Text == nil && len(dr.Attributes) == 0 {
+			continue
+		}
+		list = append(list, dr)
+	}
+	return list
+}
+
 // runs a list of regexes on content
 func FindMatches(content string, regexes []AppRegexp) ([][]string, string) {
 	var m [][]string
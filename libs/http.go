@@ -30,4 +30,50 @@ type Response struct {
 	Beautify       string
 	Location       string
 	BeautifyHeader string
+	Redirects      []Redirect
+	FinalURL       string
+	TLS            *TLSInfo
+	RemoteAddr     string
+}
+
+// DNSInfo resolution information of a host
+type DNSInfo struct {
+	A       []string `json:"a"`
+	AAAA    []string `json:"aaaa"`
+	CNAME   []string `json:"cname"`
+	Private bool     `json:"private"`
+}
+
+// TLSInfo certificate and handshake information of https response
+type TLSInfo struct {
+	Subject    string   `json:"subject"`
+	CommonName string   `json:"common_name"`
+	SANs       []string `json:"sans"`
+	Issuer     string   `json:"issuer"`
+	NotBefore  string   `json:"not_before"`
+	NotAfter   string   `json:"not_after"`
+	SelfSigned bool     `json:"self_signed"`
+	Expired    bool     `json:"expired"`
+	Version    string   `json:"version"`
+	Cipher     string   `json:"cipher"`
+	ALPN       string   `json:"alpn"`
+}
+
+// Redirect a hop of redirect chain
+type Redirect struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"status"`
+	Location   string `json:"location"`
+}
+
+// Cookie browser cookie loaded from cookie file
+type Cookie struct {
+	Name     string  `json:"name"`
+	Value    string  `json:"value"`
+	Domain   string  `json:"domain"`
+	Path     string  `json:"path"`
+	Expires  float64 `json:"expires"`
+	Secure   bool    `json:"secure"`
+	HTTPOnly bool    `json:"httpOnly"`
+	SameSite string  `json:"sameSite"`
 }
@@ -16,7 +16,13 @@ type Options struct {
 	Headers         []string
 	Inputs          []string
 	InputFile       string
+	Ports           string
+	DetectScheme    bool
 	Proxy           string
+	Resolvers       []string
+	ResolveDNS      bool
+	Method          string
+	Body            string
 	Timeout         int
 	Retry           int
 	Level           int
@@ -29,6 +35,8 @@ type Options struct {
 	SaveRedirectURL bool
 	InputAsBurp     bool
 	SortTag         bool
+	StripVolatile   bool
+	StripPatterns   []string
 	JsonOutput      bool
 	Verbose         bool
 	Debug           bool
@@ -39,10 +47,13 @@ type Options struct {
 	Probe           ProbeOpt
 	Screen          ScreenOpt
 	Fin             FinOpt
+	Cluster         ClusterOpt
+	Rate            RateOpt
 
 	// for report command
-	ReportFile   string
-	TemplateFile string
+	ReportFile    string
+	TemplateFile  string
+	PHashDistance int
 }
 
 // ProbeOpt options for probing
@@ -50,6 +61,7 @@ type ProbeOpt struct {
 	OnlySummary   bool
 	WordsSummary  bool
 	ContentOutput string
+	SimHash       bool
 }
 
 type ScreenOpt struct {
@@ -60,10 +72,51 @@ type ScreenOpt struct {
 	ImgHeight     int
 	UseChromedp   bool
 	UseRod        bool
+	Browsers      int
+	Recycle       int
+	ChromeURL     string
+	ChromePath    string
+	Devices       []string
+	UserAgent     string
+	Format        string
+	Quality       int
+	FullPage      bool
+	ThumbWidth    int
+	WaitIdle      int
+	WaitDelay     int
+	WaitSelector  string
+	WaitJS        string
+	WaitTimeout   int
+	CookieFile    string
+	Cookies       []Cookie
+	JSBeforeFile  string
+	JSAfterFile   string
+	JSBefore      string
+	JSAfter       string
+	HAR           bool
+	RetryOn       []string
+}
+
+// RateOpt options for rate limiting
+type RateOpt struct {
+	RateLimit       int
+	HostConcurrency int
+	HostDelay       int
+	BackoffRetry    int
+	MaxBackoff      int
+}
+
+// ClusterOpt options for clustering
+type ClusterOpt struct {
+	Distance int
 }
 
 type FinOpt struct {
 	TechFile string
 	Depth    int
 	Loaded   bool
+	Enable   bool
+	Source   string
+	DryRun   bool
+	RulesDir string
 }
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7c5b73eab8f2ef57f9975f27b37c01923855e7219060cc008b4082b1ff3535254bc216c897b16583d935dffd946c03e64ed6d9bbf6c3a156115bea9f64a9d5dd6ab5b4f42f81f8b320165efe253884b989fd03069e38afc531c1a213a4384a095e72f21b89841741fc8a7114ef00621c41f152c90741f7c2206243c05ce1e5e2471e8401f0b0f0227880f8c283f01640e145101e844f1039989dfaba4dfcbd0a4641700a273c087dc0a02bbcfcaff043f8f341183340b1f0c2a2049789110671e00b2f42cc53ff8370887d847d98bdfc4fa5cdc304470bc28226094427f83bc151263c085ad02614c7bc76def41ff907c38583117ffd73c3821c70810122f490f0504578205ad880e158e4b5451789fc2ff11dd1c31effe41b0ef3ef8108ba24c5e29a84c283602733c21b67670cc7c28300032f8c701c8b330a18ae663845816d7a4d899da77d06888f2391929895197895bf4559c882ed8b088a2f1409484237ef40994655228ac12e81e17e12298d86ac1e6588c46738f20115315a8208c587304a49c808dce5b81ea8a4b6c523e0a384117a82142736a37847f0506397e0e52a2958af24aa1d885d20efa594c6e35eba212b95f4c12719adf069d590d4fdd4aead1e80c101540c1764253c08d8870122be53791541eccbd5b40d62fc58dfcb213ec8a57b9b03e3b49a7402bb9a7471f55be29c6b53251d62af9a5c799ce1388a8288b77a46016fdecc63fb327e5adf2a00e0a3cc06d40da8274210438008384430e8ce56a2cb3c7ab28282bcba4c0db9f9da2341370a3c8c4211a2300a72c1bf40150184388e894d2861d935ac4f3cc048cec08bb830a404e64808a08bafc11344587c056403b870a220f151ccad12bc56a91d05cbf8d0341da1f2e6c52c888073ad420862760d82c26b88f85a3f1160800bfd3518b613e7c8f49e8071560511c13ebb65e450e05d47dcfaedc08b7d10c66e708d6f1c79d318602fa1b7746386f9a47a19e36280288e63bc0a71443cce207abd481846c18cd0ab9d273ec22b8c907d151726d7d843fc38c4900557bf794dd729c870c4227c8dcd3470ae203c7c64cf4e60bce0d06e1d817ccc9641b4b882e29e0805d7ea0aaf0b5088a3591079c0bf6a3f2a48463c4c897fb5c46d9211253eaff00a2ac63089ae5be4d21672165efdf06d2a166731c31e77beaf0059e1fc5e0161e6e2a898e32fe22200afa396d8e673c5b596e530e69e3512e5cb1572693bafa116e7343ccee2d27dab50b147a210c4048a4e806271ebb5c617611e08af0344aed81e082f0239e6725539428c30b229808b63637100e75d3ca8700618714518d04383e504bf4738669958fc4d95637280c42840e7f2454a6c11c4313ef4160e2047f3f0011de11948e8954a8ae1bf8c3961bd0f10f3cb155090f8d03d54dd3320be18722ed777c2e33b409c183027b093d90c503ec145f888b604b1e8d0c03e4b10f962ecd8f255111e389a90abe438f319585da38b471ed8090cc5ab4b0de1dde726f878600f3031e366eb34ca652ce4eec349621804f42c61b72e0abd23cfbd8a0b372be2b3f472a57c4c5f9ecb1697272c9213c080d24cccff5e20151ee725005e31ecc724f08fbe5f459d9c859c8002be728b8224cc5d729146c949482ee076323b25e9d7e316c788e050e08f2094d8f115c8099d9a07b10b2202fc72c40fa871e0ff4e188e000ba2220e54a12eb0ef674e047c26c6c0278cacf1618485d028113188b372397b9e2872dd3e0ed1ec63e627b4e608b38c788bff1f0241c7d6e51051891a1cce78a7a34a37459eb067e3cb01ac6a8cea165ccc5070d43ec67c6e6cf3890fd8f4a8ab259dc4801dfa759e93b0b508fc981ce413065d4ca9cb0bbadcaf20873c0e108efcdf9d80fb12308922ecb3738808cf2886ec60da0d813f9f29922489c03f9c1263407c3623b12b421744e8d0dd8b49948431f6f982214a0ecb8633b926c2c08ec029425886562a0486bddc410eec80c56c75f031b6f46cd14b222f896afb94d5ef300259d988dfc308cfc80aa3df73f79d1d896c167b41804427389cd13684238d2a091483055f305e24720911b9ea1f4adc29dcd66e04220b1638ff6c6ee67e049123aec44dec2d764586238ff8c50ab50af1311377a1ce2392cbbc934578be0858b1da3f49e4031ee3939586894d098c93d92c0fe3edd1e32cde6971e2c76086f94c590cc22130f18f2be03d112b91c1f3c4bc89a5c37b1ec43b932fc62fc3366dbe0d2512847d4666e4b85ffb05e620043e2ea239e7518b20c2e068f4f73131f142cabf88a04bae57c922800823810fe84df8c4273040a741db5e7342524eded751b95b08e09108eda319383dca3b2162b3e78ba373b1555b57ef88c222e0c7dc46e4d4c0a1f8470504c210fb0ef1f1be645c87899be0dd2d580418e00ed1cd05cac8cc2dd0885b53fc1708c9ad2592886e036717f017615bff6c1760e7395bfb74237e49cef0648bdff110c7b08ccfdf880ec2629ebb09cecafd945bd0db3e203c4bcf48cc85521e8e63e0e08dd5fd46c98d127da7c8d511d995d9ec86dc029e118a3993bf01675978eb606f57c037601dec935b478e1bd71ba141b499cdae63c308381eb8117cfbb8c7ece6e148711497f1f2f3e8ed5aea3ca4f41d8b8cebe3bb072f13df2ae1909815c1e30b458a486a914166005e91a27dfcd511e76219e7062322210ba290fb8b2e88ddf2210244715453364918c15d62e6a7fcb570bf72878a612f2c37b389574c57f9731b292b521591040e2ed7eb05691e62679b0873ef6837eb640c03ba9705c3a49a9c792c0e2256cdf2316351c1b69d78c7b9ba57b3c280d26afab048397494b0bdec98f80ec5334a1c77efab71164340a9885718e29c4d47a4d231dc39093866c5d477ceb7cc632524106771f12441c9baa258196ce10fd126ceee359f09f2f7d2ce7b456c9e3f442fa18c8420675a9ef17712308cc288f8ac5ce1f9b9d2165e330b2baf220c8205c173105533f99f0dcbf732cbd66ef32a5e07cfe3d67aa3a53c9d449c92af418338e7a4f020947bc1fc91dbd532bd53bf083b78156e5f7661b7dd9ec44647601e94d8a436b19f4d7a3b0a7c09919f6538a2941c3fca8f33dee852126316c12017815db82dce7c583e76d59782213c0885efb6d3a5bdb418f2450bcf2d7ab3f36acb37ee4dca8ffbe9e73cc9a549781052eca33c2473bc0c832e802e50a4db50614033b92635aea0f30757de5b719b131297c04994e2cdb1930b387781669711c7274e2e80aff4988b2df263fe2b1d9d0bc0ad5e3849aea157716114acb22b404574430017175004f9e002390a92b303c5d7b385c53d45cda534dfd3c3a24d108992b3dc3c5a9c9c056d649857780bcee7f5fdf9207ce2986d8f86f909a545d6f6305891d50f106fe4cbbf849b8ec8f501f13707d77ef9489e16f403f40b454527f8e1e5fb465a30293d9e1741fe213f0afffcf3cf83302bfa7af12ce18b1833c0082cb6ee7fe453f74b710c913f116680d0bc12bf380d58c13d08315963e1e5497e7c7c103c6e725eea8a94bffe95dba217419114f977e9f977a5fe293fbfd4eb2ff5fa8fc7a7fa935457a5fa6fd2d38bc42d0b89ff429c7b3340639cdbbdfc8c234e8517f9f1e9e949e1b5f6f30fd46af2f3f38330a0c45f082ff283a0fb81f0d2a83f3f3d498dda83f04590f0d290e40741e36fbce008f18aa4076108d05fd009fe928497ff951ef27f7f3e08afbca9718821ffe6983fe447457d961ee5baf4200c629ea3a84f7555797c7afee741e89fc137eacff50d7edbc57f1e84d637f14d1231f75499862a3d4a757953a6213f379e1ed5062f33ae0c4493067011f312fc7d518c515d521f1f8476be90e0acd0b09f3f7b7108229cbf7e94af9c357fe602e4e2e8d7cf9a8aa83892b877e07477aab4a41e9f2add9d1bdd9d132d74b33c265a0ac9fe39d1eab9cf027da0d8c509cc9ddeff7f640a4a1f897764e1dc7aeaf7845df8e741408001e14518b6daef9fd2e0cb9834dbbae64ab6b1fcad355f054893635d5bb9b60755dd9b24fabcfec75073a9697cfcd622af8ede7a7db68d89648e9b73a0b533a84ca4e1b83b99bc9bec674b4f874ec031ceb0330a91b6a2c3054a9137c9904729ca9a636bda4ca1fff1dcab95f40d5e5b85b617ffc1dfab3fe84de2e1b8bb461d1a5bd341601913492fcbec7ecd007a136f38ee06a8335ac27590f614d9c5da60619306b1155582b551664e9b734bab27a6a2b29e62a5b6ffc1cc29a2505199357e967a7333e9b7d439acf553cb5313d4925da44cd6b622d39e27873669701a29f990fee7da3e58d85eb8ee7983d8ae4d1696b772a1f755b6bbfdb73975525ba30466aa84142a216d92f58c6e6ad7466bd4e9bab0c5fbf29af45bb26b779a8139aeaf7bca600d33b5c28bd7c43668d2f378be5eb6fd7937c61a4dc0f2a8ad99652c55dd1f48d85851a8b8d49a7e6cf8b1fb696e6619a6aa7bae843acdf54ff29c9aca28017e3f3195558a8c91676bedcc1a37e6b622a5a611bab8a5c6c0e8ae7b8abc303d35459d8104bdf6b2b7fe48fae3faaa6c3fb334fd74bbe7cb146813d77aabffd19aaf0a9e7fd64b7a4301c6a4a677aa63d424a63188ac5a37454663f193bcce2dcff27e56fbacc92ef45068cf03a73f6f2ef15b59be23fdc1bf076ba386ad7d71bd21b6326a0c9de0d9d2a8a26b83d89c0ed6c371776e2b0dc93468624d75b29397518848736e6bed355c4b047a6a4def8c28ee7c30dce9335399247c4cf5ce2b432d69c59f269124ae8b153e9ff8d692d9c607eb7feebe55c59bfe4e3fabf9c090fda331acfc4c63c575fc0fd398c4d6b47f3cde5599af75e7fbb28c42a4b9b2498ab1065e9bdada64dd9b36335b09a959fba8e6c5c840a1edf753d35ba5265199391d48d6544f791fade968dd5342d7e2b2973596b60745e8b56bc3e92843c6d7c576016d125a8a2b0dc7faaafff67a02bb95fdddef6d4520f9567e3068edc61569933a6ac912988e626bac1fe30b39ecd8358b42df0aad2974be9441661993042a6e8a5a5b7b79645b862d3518bcedcb434f19856823236fcb94cb0797c94d3eb7ef79bb9de0591f4b4c6f0f2473da95f4ce28d535446d6f9299d311d5b5918bb477a737967e6bf9ec097aedc452be9cde6290dafe88dafe683d9b721d582dc0d4744c65e5c25a5fd58b3e5c96f31b6578404ef0eb6de55e1a63c46d4a311fcd4c8fcf61f16599e874a9359708aec5c964d2edcfa6d245fcb69f5e57b2499320adce60a71bda463bc3e3226d1b27ece15bfd198c777cb0bc77c7f2de993575256bda4d4c63c9727b9b3563cbb0b89e3ff794c56f95763df794f7631938d665cff6ba6bf8763c170db5e3f21519e472e05bd30f55a72ab1b57602b6b6f524be9cb3f4f9f17c569d07b6e3ca79e398fe28e94d07d454d4c4d27e8d67a6e252e477539bc8193050805a2778a651c9d626eaec73999a1f47ed3ba1875662fa83e5cfa3f9eef5f944f9ddaf33e2f303456f12992addd8341ad1657e94f322b96c434fc919301a9e9d7d9f5f4539790ea6ddb9ad7d39b636f1504bff6dd852c321697e22ad2da169ff51efb0a71ee5f66024c39aac0e5beab1aef1b9f5cebb0deffa96d1f091e614bc5baca8ed210974bec1bbcd3c726aae1e9fd4c9adcdd233fdb43daa4de6a63259c35fe8afa9a80b6bbcaf4f855c8ca8a9b8b7ebd3857ec14e970e9df009670ddd32da0b6bda55671f41b7f5b94c0b5a70724eab7ee3705e311535ee19f2a2f7f971c6ef994848539393f6c858f93a692ecce9c8ed4d47a9a5a0d8fa94886da80bd35892722e64bbb9459f731bf3a1b8d454d81a19b23a3b3bb75ff7ad0a5d1864e6ba9887c686ec7f69ed5c8e2eea4369872d83faa0f3a1ea7373d96f9db3d9a77c9565bae1cb1ecf767ec3de38e4fec286465e59eff3c8cfd88cd1e19ceed9daa4de3306dc6fa0f0605e1fb6de596fdc1cd8cac8b55b957a5bbbbacef84ddbf12fc7cab1bcf6c21a9fe1814617c37197cbdd1bd02673501bc8f6f43c8f91d626c0682caca9a3ea445a9dc3414fcde5c5d2a86b6baa7f0e674ebba1399663d3e8525b5b514beb3686e32ec35f726a69edf8536b13cb589eb16d4d177ad4ed196e6869236acf25823a5df9a0af27f5ed602c58c16779018c766c2b90e89d6e6a6b5faaee8d52b3366196d1385c675465c3ed8de537bea6e1b8dc3ffcefc8fd76ecf9777a861523832ece95ebad8ffb336ca9dc773d90c5bdbc43dbf30c3af9fa699b8f6b8cdade88db2fbe1e747b63c9b1f8da95afdd5a12fbf7af079b8dd2c65df5a107a42971ffb267aca8e59fb68bf0db6b95d767581b65b6d23837b6bf0db5f7ed1c053a230976fa8fbd4cf5c1741420432f6318c11ad606a13556fdcada871cc48a42983597f9fa44533dfd5d5ddbc664614d276bbdcdd78e16e575d84ac3b5b92dfbac3ff76a03097a6a621dfb22059fcfc80798f61d9bcf45e3668db753fff987158e9b049ff15fcef471178bf106a93d56ffeed7066b604cfe065e40868b6635cdfdf9df74ad9d58ad66885acd109e6933f426b165b4d796f1e1a0dc1ee90ed25caabfcbdd49ab1983dcae0e68efc4f8f66a27e7e66545560a199916b1b8f37abf8da724a627cf7b4657469d516a9366681bd0c167da5ee8380aadce28f8495eb341ad59d75bedd0ced7b423d7f63f9e2ed908beaeb2149af48c6e9a7fef3370fad3efd5617a6abd371d04a6314ad13a706c2e1fe3571718f2d2ae7525d36848277cd7dd4feba6d01b5138ff85b21d2edba3d054da71cf68c7c040c94fd264c0182db83fa91b94414dcd90d64ed089355b518774c2e7ce7f87637199971a4dacb1bcf1171ef5b7be023bce6d6d286c6eb56ced9b654b5bc4fd483533a7a3d056ea8fbad6486def463e14f52cc074b0b4b576e327973d6f15dade17b3bc15c5adef8ce920b53535dbc4fcf8bfefc9547b0e1494d9b54962b5e4b9adac522e1fba64693fdfded723f20bf245e4cc344661be167b1b2cbfd79f9d8ce6fad1e1fda30bbd33ca4ca3b186dc77e5f3fa37fa08b5f6c2e232b32eeb7ca3df6c53115f870a7db4b8decebfdba715459d9c2fbcfd736034fc9fe475d99b5bdfe60d6ec96ba0b517760d3eea6f4d5e7ec5e5b7ef735e757dd37b0ffa9f8b65aff5bafaf9f91aeb6fef8d7e6be9f45b75f98fefe87b4bae59463702d30f766877fa1dcec336ff76f61ff9763556dd6aba683a4abf33dee57e87671a726877168f7a0bbdd98a1a01e3cbefb59a7334edaec1d4a2dfa9b33745d4f458885a32b16b4e2e0bc53836895debf2b92ce7d32fd8f3107b856c9a9e9a59e5fec7b7e4a225a7bd69a11f60638fde5e937eadef98c62ad6f33d9d890bbfa3cb95f1479dae6bfb831069342dc6a4ced7e4aead2d0b5b6cb4b36ff1d290534c64097aed042a5402869a14fa3058eb5a3bb6735fa041cda9fe0d7bfacaec69ffdfded67f5f7d4df5c49aa6e087d765662613341d497cec80a62ad6548ff55683d8469f6dd6b63fbdf61c6954b1c6cba33296a7ced1b47fd97ff96fea74b906b0b4c9dcae75dd0d3f7fc587b96803dfba4bdc6a6696d275ffd8b475affdafc960bcf8ce3c9de6367b3a6a1432eac8dfd271458ded9afea8b706e5ec6cdeea2be56b006cecf6950f7fe55eed29ffdde36b3398357c5b517d5b9bb850a3ebd2979fc35a5fb4bc3603c6aa31fc1aa4b6c2426bac9fdee33def47bbd51854554e757239de5769670dd56062fa93866d4c5c53513dcb9bd0fd35c71719ce57dc37bfa4fbf9ef68bfae866a286b1034a5cc32da735bb13ccbd8ee3dafa1329160a6f2bad38f85fcd633ba924de4d85650daf3078a95e99bfdc2169a2e1cdbf872cc7173530f6f179fcf931b6203c5388ebb8cf305187596c75fdf0219761c325c74653c6e326bdce43c4d2dcfa2d6e73285b5a66be7b1cbf793312f7866cfec6a7cf1d374ca3572b9ef70727feafb6babf7ddbe9bd5bab0e6ec5c59dbceb96c1defbbdbb526b5b96c682b6ad64621da9e23a8aca7cfe9cafe7afc09670dc3e27e80a126e7f6a40a3efcfa5adc9c1eef7596bc5da34e37b53d98eff17cd42619f426097a1fb9789ac7dcb7f4ef8cfb867ebc575ac4737a596388a6a3ccce8ad86419afcce312c068c79b380f5406199836a54d4caba2af735b19d19e17ae9031c97079e601f86dd99a761bbd4f3de993ba549e2ff91b66f9fec2daac7543d8f9f8ad355f6dde4fecaddf7e4ec49a8e026baaa7fd717ddd9bc314f8fd14691309e4f18affecb76f3fa312ae7b4a15d360c0a827c0ef93e17c996e787c8ddf96c6fbd298db9a2a5b9a159bd3eec63ebac06bd77bca2a34fd7e6a1ba3ea799874d0aaaf7af3f79c379c769e2fcbd4f4d405cee541cdcf4c0d9de0ffe467d8f3ffc8bc3da8b53b8d256ccee3e1fbe58f659bef973fde2f7fbc5ffe78bffcf17ef9e3fdf2c7fbe58ff7cb1f4f03ee973fde2f7fbc5ffe78bffcf12ae27ef9e3fdf2c72ded7ef9e321ea7ef9e329c0fdf2c72ae67ef9e3fdf2c793f4fbe58ff7cb1fef973fde2f7fbc5ffe78bffcf17ef9e3fdf2c7fbe58ff7cb1fef973fde2f7fbc5ffe78bffcf17ef9e3fdf2c7fbe58ff7cb1fef973ffe3b2f7ffcbf000000ffff010000ffffa2959de7497a0000`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd6b8fa3c8b228fa578eeaebf48c01976b8a96ee87c265302edb5d7ef13a3a5a8284024cf268831f786bfdf7ab48c0c63660dc3d6b1f6dddab91a70b0892ccc8c87867e47f3db9c157183f7dffaf27db4d9cadf1170afdceba1bc7aed5b1c39db5d9b9d61e1ebfbb9ba7ef4f9d4d18261d3f34b7d87afaf624fa51b8493ef5c479faded8c0b7a7a9ee5b4fdf9f7cdd0d9ebe3dbd87e8e9fbd3d3b7a7a5beb1ade4d4b21d760c37b878711e86c9ed97277a829ca7effffbe9afa7fff3ed6991e8d87afa9e6cb6567e31b7f4380c9ebe3fc5f0e87f99566405a615a0f4fbff6ae86707851b189710f22eb662681f3afc971d3e7d7b8a3cdb32e1cfff530c9c003436e79b4fdfca88f1f58d67e8891577a0b54de343007103bbe35b3e8cf1dd8ac8f7f40d72dc9dd539bad1d3b72763fbe542e78c34b1e2a76f4fc887bb28f4a38d15c79d2fac2756f9867d742f008ed835c8f320d1ddc0da74b01b27f90deb40fedaa451129efee8e8d977c8dd0e7223c7da9cafcdf24333d6cf1716329d8bab8b8726d3ebd16ce906c66e94b8e87ce7cb8d62fa993adf703cf3ab74e5eb256027f2acf3951b24d626d071c708376e60d73ee81886dbf034ae7c88c2204ef420495cbfea9356906cc228edece8bfa8bfa80a809b715d3fb94478d5d38e8dfc2608ecea4d2d18aeed87660300722ce4353c373786ddf0f872e6ab1ec77ad3f36bdaa880d8eb1b337e04acf3e55ab869cc97d475fbf882dc6e1efbb8794c3ef6aca6290bdc38b19a3e900174be5c3d6980da3476227674a6f7d20cd06d7edca3992680ad9160ab0120c1716303f0bca10748474e43f3a615c51de08be1c6b43677e050b4bd036187a6656c1b089d40d5b0811cc4d1e386a5100638ad78eafa11aeb8bdd1832a0286dbdbc4ad7a234ee3cb977cb357bab8a4d92b12bd7c71839e4b17e5d76247a72fae2e48ec92a2ae09e89a5e125c625b098e6f10760170e851a5d50f579dc8730f4fdf9eac008566c6f88b3f3b7a1cd0e56b438fad2e737de7e5f9e28e1be89bb47c07c5bbf2a51d1ae54bc72a7fbdb30685e4eafa34a4da0704ec0beb76dc0c1246c91d88bdbbb16e20e07fb9a0bf7cb0bb404664f9e5cb830ff4656d36e1063e0add837ffce452a1f9dc5a1bcf4d42ce0d3b76f8736b6dd24b003d305343c74e88fd0ed263a49bae7e0d9120e7ebd071121f5736903d3e343f8d4043bd78849c4de85b66d44166b40993b0f9694747c88a63d770b19ba4f76003d7d713370ceec145117611812c385923f8d67493f80e90a123cfde84dbc08c418146f71a3536e13eb63677a048f7e224dce8f6bd06911e27f740cce81e447c6f9ca69ee8b064ef8101c7bed1b32bc00055e1c6b582a4cdcc99a17f1fa2edb7433f0ef42876c27b783343bfdd1c58fe16b719c697053654338c63e926b6e2d83a44d6c6f50141f8fe2b51b409bf5c7c77f06e605a07cb348dbb70d1f61e7adc208e2c948477bf796fad633db536c9c6ba87661cda77207ceb869f55c0f8e126bd031458c93edc7877a0c068c6fabdb6a2fb0414599baf70e3eb017a0012341fec0677df6847199b6d90ab524d50b185b69bfb1c39e78580c2bb1f6eb7c4e2344e2c1f7c27770093ccbf7107c84a1c2b374d1be1363aba0fb5b70c7d6bbaf77a46c012a79649e47fdc799cf3ce7b505edd0a8fd338d7564b4f2ddfdd447aeca28e1d9a71e7e49c881bc17c3dba0fd08185edeb512320c0343745203a1bcb34b08ebc5b6671050e43bc6af04b4f5ca783427ccdb0ecf0cf8d15276927fbff8eb97d1c9a9d4d68d6ddef60d7e8e8716c257123c88d1cbe7a6e5a5ffa16df69249bfe66980aee7d05b16e6e00ebdb0039d6a61550a75096eb412b34be2b888a09b34363fbf5a56310709babe9b64363afc71d1b8746ed830ef8dc6e395f19c2d76f0472f9719c06897eb8f7bc73a38155c060ebd0d411187e67b30dacf80e4c9c00dbaa8672922402f5a1f2611486b8f6c1d96e89fc1bcdbd0c1715eecfdae7b95bf4f6f9beee76675fc191ec108518a7b05e71daf0a853f8086a01ac436205b11b06711354a514b243ac077607f4fb88a8e41dbcd95682100237b65f55947edf497d0b116eac3b20d835e23b20156b6a1dc68ebe71f5c252bd7a1a87c19f6e626df424dc74ecab71785610a4f6460f924eac076ee21eaffae8eb2ede6c3b961ea7b9e95dffb0636cbfbeac4d33ccba62d5dcc0ec376e720bf480d7ff96bb5c439c56c7adc4bb06cd42086d603a966f58662b480848b4818b1333bce95f924078e74f22f874035b35cfdd584faef53adfde26c78e1ec4eed57d37418e85b1030d3ba057b8d7380e4d6b13fc6987a04ba0ed666305491dc4c6fac2164aaec46ea407eb2f86a228702a5c0d2ad6dd20f97263a7831c7d635eab7bb1bbd946b1157470686fb6d7ef465f74b7834263736528640fa2dcb5527a90583e68799bd0089338395c7d2cd9fb46c7df6efceda67bf9e4f027dae869de893fa38df5e51e2cf34fa2be2737249bc67e189a1d3b0c8dea07372b2a7f802ddd0383b1f1215008f88f3796791feec437c24e127a16f92c61737f851bbb73e8e41ebf38763a89b5f1ddcc9b7601125849e71cd1ba79640671c70c62df8ae39ce95e4380fba9ee7e474f42bff62190446c557e36da1ad845f1f6ebcb3d5c3f8fd392bb731bc4fa9705b2d4da54016e83db0660ac9dc261d7f890506dae12d703c16088b9de0c56f4b91d54c735ad2071bf5c6bd3fcc25a8ff4c08aad66282fdc587ad00c13833b1dbe6822c7bddf64b2d14d177c293a6e05bf0d5c149ad540a751c383ad6eb784228aa38e9266e844b79b01b6c9d76b715109d9d8ab933278f324d9e8410c5c84bc17dad8faabf4ba1e455660bb8175ea484bb04ee1de6b030bee4050995abf90fb6eda806e80df5affd223b7ed1bdb0d3eb9d61ae01bc14e1adc89b2c89d13076b099ffbfc1be04fbd36ad18e51efc96d079c8a15de3491e416a037d1a83697ded74fce85b391f2fb8ee036f168be89157ac43ebcf14f19236c0e024832979003c49a3b6937db2915bc0da56e0b69db93c56d906b488cab6818d36baedeb2d81dbcf7b9cb49e8e9db589738f7a3df4c9daaa07c9b5cbecc6fdf9bd00cf2f1e7ac376e36493de7925f3b56637dc2f1ddda1a24bf8bb330e64191386b171a324dc44a051e69170f8a70311860d09be924bb441e78baf0022acb9fa05ff7412cb8ff2ac26d7cf8428f9f7e44bcbae4e33472e738b9efcdd5947967dba8888767482d60df7e232d683f2b5e1c6d90c9cefa489a5e38b36ca3907a79bc8d191a3bfe65cf07c1b8c6ce8c72641e1eee249b42d5f023f8030267613ebe2be9fc4e1e6a24b76a86f907379a7f04b5cdf8a2fef9dc33c97f7c30b38ff0a2b8195249b8c6c4ef7c2988487cbb7a210e38beb3c1460a170738194ebb6729abe1e7a4187a07fbba8ea0922be92aa27d6c14d9c30f4aa9ed9956dd9a813233da87a94dbe115f713a7ea3e098f75b06e58b8ea719c56b616a731d231ee6037d81eca00601c6cdcf0e2961bd8d8fac2aeed5ccc649c6c50185cd059eec2bb466e9c06176880ebc48a2f5bcb7b641d2c6405bbaa47b96972ba0f4d64cad7f9164c77f6ff1d537e7065f664230c3b5f043b10c82b567566bd66cde2f0c208c9a7269f09f8a79325f9e57f26c5d34ebe324f7f774867fc2c0805ff74fc2d4edc48278b8ddcf8b90d13cb8c366e90e4ae8c80c81e30eec0fb59fab383c2d073adb5be29df84ff152be7e2663eb2d3bdd2886eee75f418b96ee513b8626a9fa0d0f7c3a0f671fcb5cb9f0556e21683010db49073f06cbb011a26fc218c092540aa6c866ee05727c6f5f4ed295fc7e42fdb3a44a73f72b7345c9fe27e05d9233b2c5d157c2cc679d821677e67c2cee919ee65240c947be61139613e7d7b829194a5c9c5752702b31da0320238db75f95f9d6df245bf5c5ebf66973fb7191c50efd3b7a79d1598c47f79ebb3c8840243b5838a429cd25daa77079a340d32a82d5c910cd5007c228c2299b40dec9dfe567a5eea004f2bc3de26711bb868131ed23b804cc78974e43540b966a0d73c06c74b963558f5941013094f5b1dc335dd4d96305f0b5ab6a26b810a528306dbc005597b7b4bf720837c69c5c929b33dd8629cdd3ae5b267b7265972fff7ff7a6a95d73fd1dda0c8bcafdc23208493d0bcbaddb1c3bfb29c63219472cdfafb13fd17fdf2f4ef7ffffbdb13f08c7b9b12be776248d6411de295fa0b74437883c4e4bfffd7936925ba8b492341b6f5a004f7ed298670c1f7bf598afef6e4c3c2fefecc50e4cf7f019f79fafec450cccb9f34f527fdbaa45ebff7badf9fbb7fbd30ecdfd4df14d3d540ecc4ff3261c05f3a8e2dc258e063efd6eee9fb4b8f629ebf3d8941f8f49da6e967faefdeb7a7297603efe93b4d506c3d7def76e9d7d76f4f2bd77cfa4e7d7b12f27f957ffd2bd24d8afc3d37a135eadbd3a2d45d0e7b59ef9f29f6e5db138743e4c54fdfe9976f4f6f89eb432716167afa4effcd325dba4b532fdf9ea631dc796129e6f5f59579f9f7b7a74905e80bd33d811623fdf7b7a77e7b50e55fffda06dbd8329fbeff6fea1bf58dfa3f643e21765bb987a4136e93eb7d24e7cd22d9c3dbbd22e75d21e79d2019f1e61b41f229b9dc0952ded791415f51febb155d2c8cff016b2517b5b0583dbbed1e9c8a85f3ef6f4fe0437bfafef4d9e7074b6aba92258e17058732e4fd1ffdf52134053a16858363f888157d692bae9f3f3e0507abf2ec8fbefb668bfdb75743962875c1ad75814f1123519f8b91240dd4e4475fdc7dda21c0d89fc379640a07fce9993bd39752d3c7d84cb985a6703b14cc5ec7ddfc79012f1c22c38f3fe0ddf20ff952fcb9181dcd218e35651a6ab2448976780123f6b910f992ffb91885e670be47c770376668c712a69ee1f65c836129d49da7aac2ad35e179ab326c3266b49d11cc1255313162d8445bbc52e3b5ba9df4d935ea4e769acf6ecd3eed988c7434181a8f7d3a32dc1e3c73733cecfe737d9f7a861f1dc7fe3436ba92a7f90707f9abbcdffc4f55b17786805d94b294c960ca14a4742c8f7646777e34872307f5612c6fdb499f768c2117aa8be7e398991e51ca9670f1b63564bc1dfb705fccfbfe7a9e63016ff5fd4d5f534ddeb36230a52cf98011e3604d9915f838ff0427d56495157d873287dcf187fbba5399f9560f265b9539ec4c79ee1b029f6a8bdeda60a89d2a478ed567635d1e1dc70ceda93ebb3387530af9fc7e7c9c6d278be743deff4413c4ea7eaff73b5d901cedfdf9a3bf3e64385f3ee7cf7b8c2e4b5d71589e23ce55e5e946eb8e76a6dcf37eb86f6bcdd7fc1fe5310bb4837c3332d6a13d59737beb3d7f7f487df4d7fb1deace7b86b08275e31accbcf76987af9a80195198c6aa323d7e2e466b83e951aa8cb79a22ba677a9947a6cbad0d813fa223e5229fed8ac339b686b3c41a4e129591b630a7e2f02d31fbd401fe555d8a12d7c578c8afe25bfbc49067c96479fe56e9f7aa06e2aeeabe2ed3c1cd1c967eaa7c8035fea1ca52ac2927faaffca1ee687d49cb66640a0eadbad95ceb3e8f0d413a8e152e359808abdd59f95e6cca666404939dea1f76aacb26aa32a53445dc013e35657e1c3391a301eda5bdbde1a30ef2f9eea7324f4d79d5d82f5d90228d71a8cf857898bcbf55c0befe7173effde022f7a1fbe1b47f9e5753909ecd3e4de9ca3cd616e22d3cf97143a3ab61146891a6207bc54c534d96b688717666ffc42fcbf34e7e9f7d369cbe5fd2c3989947664123effb1dd007d064711ff83be9b71dbe8a0b2a11f929a52a234a1cce77a26062c3975255996351983ba630b0c70bea8f7e90fc8d7c7eab312b7bec4d774630c746303f7e29b0060e9eaea8b6ca1c1cd49db0623686663a6f49c353b7025fef07a71a87d9cf049e92c9a32fd507191637d3c47084b535e55add782b49a3c9974235c29fc6e98f28c3e55c53784ed0701419329f5a8becda902bf8e1fbf3abbe38e341f307b6e60f124d71284d196d55799f107e9b72b1266bb0ce5fc78cf747a95faf636650c61bf955ac65dff0474754f0a9d2ef53b87dfffce3800e024d99b122665d43e0b7fa89b756fd0a9925ae6fe559e9779e57c08dad06f3ed5899629561b79af06b385319079bc16867b874aacb6668f62b702660ca1024f66bb9dfa9b39bfe55ac436dab06d3fd05efcfe9b3e2fdf36f3807f980cd77ca559851accabd4d333e72b9e84e1ea6335deef946fa38beb2f7e8b5ae8cd686b0b20d41f2cdbef8c7679f8d3e5d6e690a3c652a93177198fc3dc6c00fe634ead2ec679fbd5d6b205bafeffd7f1777134dee05a66067b8f30ed8f04d4a1fd6e3eee2baff665bdd24d25c2e30fb6fdb19c3d24630b3270acdfe4fc4b1c9f0a9e1e3adf60b78069dd0f06ff0fbb795f6de8daeb43587342b0ea79121e35855441b097ca02993963c91fbdb62a4ad36a4d97ebd4e74db8e30a89427651cd5f0375a65a618752719bf9369af02e77f7c0a5e098f9a230a9a3396a73bed96ce000f2b4d9e860fc881fa710db9545b3e7f009f5c80be2c482991e533ef75dccd9eddccdb30f95b97557b8c8b3918b15fb774f7aa0912e8ba313aded27a2ebf8fa64c27aa32ea7d622d425dc93184434f14b0a7c93d4a97a78ed1e7f6aa6c627404fd5a4a886c3de9f157bff7036d54acabf38ffbdbea8e1cc337b1d8ef2d75998ec0ee80f156c317b68ff7c7ddf90f92bfc701cd8aeb1ecc4f266b9620830e51c57c9c7fc3e46f4dee79b57d78dfef4cb0c56fd6ce7ed7845fab9b60c39fc3bc54ea84e5f6aef5329561e3b14c7be3e5acc66e90285360b795f42e1f02d1e53c55993b6365bed31833d696946bc8aca7ca7b37d72593332ec535a1bdd59cfdaad589efdb24e4379ca6ea11f437e0a36f5b497068431ecd54651e7e2934cccb4a1f4a898a39c7141cf64b01dc1f8e9a42b3a40f321dac049ef0ec13fe164d7a4ff63304d6d104bcd58e14e84a2f568b774c067ba660b3e27a924efa75f05536c57e57e0ff626ecefa7db9ad4caf2f9eb96fc9b8583b25f8be5ba97bfb86203d8fe529e8f7185de9df9ffd41325e705383993b46bfd46efe5d68ab0c7ffe9de92ca7095bf3794fabc39980bdcfc508e66e3407be6f8775babeabcb3d4f536c5674a9431d1cf259428f9a801d4360833a38551945ea828e5579840de1803561d4fb5c8c126b45ef34818f9702ef6af2be462e720ef2b133969d4813e6d85853ae391cd15763fca34afe5ccd4192e197f674998f0d06b9e270b43384152bfaf39dda9512e0935736d4f9f7be77c60bfa1d7c0e0047ecb77f647d5dae953a1c56cd39ace3b1acc5a68cabe4e0d58f8b0d86f774b9177c2e46b1aa44bdba77c6153cf0b3cf823d7a45b717f7aef9e1ab3e243e91d3fd321fedaf019f94ad813f0afc317d2af9e77d3c5c2fe7bb1f0d7441ece2a9cb5160338ee503d6826a5e8d1ef63fbcbd826fd4607a75f4406461612bebc3398586939771ca06ba320f4d59ccfd92e11175a791b66083923fc3bdf2ff4628e5f6c4e720b0be38608f862c799a221d451efc411a86360ca6e718efb93ced821f90dd6ab7ba6f9dee437eba32b10d19f80cd7857e8a3f3eb468c1b9568dbe5c33c6b37fd59fee8c05fb73d29d1e7559faa9fba1fbe971e56bd047ff10057eabf5b9c8ec7311aae933f8b435993f6af2cc06dfa3a688b62938581cd023a9cfc53ae1c1533cae98df71f7ed761edf0ffb12ad6434a264fef57a5e71f2916e559f5e8fe5116d0ee73bc3e5224346b655d377f2eed08cb4e13cfce1bea5d32ef72cf6f9c8207efdb96304b3bf6be888bc0ffa9cc6e02df8abc9f796a13d511e6b43f5d9e7b1320d5579be338fa16df8ec565bbc39ba4cef8dee8852e51e55612b9d7fc26887fc3946eb5f787708b43d8f54868fc7321febb2b9fde172892ecf3d4358d9a28c1324b0a929f05bb3c20f73f21f578fef7a2e9a71093ac8820e351907fa70f622be4f1834b4dbf521f7e394deed3ef86ece8be6e0cf01bf616430cf2fa2d023b6dc03ed78ba32dd1b02dffb01b4e7431c689568fe015bfd47e674ba3304362dfcf8f0df6334c5af75c64c8daeb4d5faf4da600e3ba00f91d2841fef83e3dcfd05fa72e95495e711f1afbc4ff756ff17da58e7eb6308e3c39e389ca7aadc3b22d0a7e51ef5c81891c07b99de9ab7f98e1fec531633430c7ed160ddae1f1dd3019b438217e8ff1ae4fc0ff76d3f5e6b0fb633da597dfaa80bbc6774d18bf8cec1fb07a0df49c03d8bc351a0fa8370b2f4f6e3fedbe1c7f22d16df07bd497f6f4ffacff4c723ebbd4f773579b4d1955972cd772643c0210fdf4eff23df2ec79ffa9c632af3dd23f39dc7307d55a62363e8bd887df3dd60d88d2eaf82719f5b9bcae8a82b1a7ea4cdb16262d54f22b34fbb46d726b490cd23e71add91a72922c1d32ff0f3c802ffd131b4559f4db53ca6f9105df4e9dd58c9d6875ef0a3f7b7ed047c31f201e2da91e14b0e727f6dfe21b66a04d3c814f08ec404fbcf4794728e21ec335e2cf3e943b894e99de5d210e7dc220653bacc6eb3f5303d8a021f1b4417e861f079b5e7a76f89a14cfef1befe73ed71557e38f21bfba3444d69d754e614c8125d60194d1163b1df730d79921476f00f9f5f9b0266b4c5fee61dcd67d7a632b1ad9673fadfbea6731b4013a4b5d11d39053e7f458769e481efa3bdd5e7528d19391f455f2ffaffb69d2ebc47e4f48ef06c65decb68d4a61fa11f956163a32bbe88fd692e9dd576df1e52afe36e966f50a7ebe7f91755fabb0fb6194a7b81c1b08121480e12f031d7e52197a3a3f97ca2cb87dee76aba339824d2166275de46bd1eed9474ef0b3a15ddcab85a553fbb66176dd540ea19b2e4a80ceb6bbe842f6d8e95fbb93e806e5edd8fa6187cd7ec9a69cf35159c6832bf3618cdd7e4533ec911727850ca42dbbb9947bf8f65f0ebd3b1c198bb713065b4542c7200faa6e2d986bcb221ff276f07fa05f27cdbc29f90cde362043ee040979f13e2f37f0f6934b4dd4f6f445b0b2ed1161ce074a7f91ad696e007e61c83c4440715b6cf7e872e7dc0d7367ea53d0ef4385eaa766e23e7b144f19fb1ad06e758bad66fb03987776cdbf5c1a9caa531ba1c368036840356218fea941b54b2a7ebd6caa53d0e7e3659033d4066b775f1852276f3abb6b8aacc6ada041b74b4337c4462ddb3ae94225fda9a83b96329248e767afec8bc5ff93bcff490fb73c669efd354e6a991667eccdcb749fc12e0772bfc3c8899a6bac251851facb45ed70633c7633f3a98b2945a791e931ef0b4a68c7ae3a5b89db8cf549e33f613a524a67554bba3080d67902f54fc7dbb561fc8fdd2947908b93113c8ef5aa39d1e4cc04f45e9a03bfd87bfdd3eef2c3a8e99324c2fd1e567c805733f21872ac7f13d7c6b42ef274a7b6b4360694dd062551915fcd1d17dfe19e22e6a30d919f2bc9ce3b69bf69f0fe3f580e0069ed5e365bf537dd6b3083db0240ff2d30eff9f2748bd2475244ec997e70c4b9299d9267739b190138438b45d2bfe0b0a9134a730df821799cccf2cf337f57aca65eebe5ce732d37fd2f49f14b3a47bdf69e67b8f793883f9a52a8399a19e1fca602eba5993c3ccbebc562531536c97a14fe9c62c45bd745fbbcf3749cc2fdddeeb4b8ffd9b2940a9cae4e58bd6ba1445b1aff4f3f3ff9fbcfc3f2b79f976299c7398331d5ff4100379c1b42382bfc67dde8ebbd3b52e4889bae8fd440cbb15dd3dc81f5765784a93cd1df23146a9f872ce07150ff06ec966700d9f4fb405e8aba3f7a53471c76fe76724e7ab3b8acca1e7fe70df0e996ce2d813cc5a747f5cf8ee20c78bc6f00dd1a3c1c7ed403e9deab30ef2e7c7bc7f196cc0a5bacca6ba32ef01fc24d71dbe16058c786ce8eb00e2b0aacf1fb50597a872cfd1182945a91897fab2473e061c50168cef3d8f510da902c69df6cbb879b345bfe718f20ac6eace05766dca34c40833dbc57db0ed457ddb12833d881137f7d7bb6dd36de8af3cdd19328d913fc50fe2b9db80e7992eb0949172812a1f624d19459af2288dd8f53482cd4867f0a374d16ba00bd1e84e29f0d38843ce317c296ec6f1e006c793e55b034df35b553ef44c01af1f9dbbc972d0d0ee086c9047f1709834d0c3c2e719559aae918ff7669ff311e048019e90347ee7c75bc577d286ef28d3a329af6cd0795426c12868a48ff4963e0654c37ccaba3cc746ca11bf39e8ef4d7d9fee6ffbdeb40e670c9b18b2b435fbdcd182fc6b996ec64dc51c34adc9152381ef91f0cf069cf42a70d2b42605b0f5c5613eb7c3c9a36d3f37b4ad68b2686bc1c83164a96b74479b663a471574ee35d0391d21660a7907b03677a6f268dfc57d43df790df261bb7768e4ed660ed349038d2c07f4f2c1759936ad4b89915c7138853cf87b6be5b962fc4d32f15d95a721e17d4de3dfdff6b7490eae7cbcd6f3189828489eaecc7777fa4d57f49b6ee8f787aa68ceaab0890413f62f85ba3c6d9e47bb621c0d785fcaacabcb072c0a5a0af468326caa771fc67fa3ac443e1b009f857d3b5aca419e7460ca2487f3517c35add14f24109f4b64f8c8465d7c340529695ea7eaed3a5d37ac533cc5aa328279875c5f88253cbaa68e93069a823560c87c64f41fd3a926c726193a1d2cdd0765f2b1517f75909f6013e21d69169332649ec42e1e9411c749da921fb81cb686b0ef84e48634e37c7ffb9d667d96a75479e420e6ce5c56f4bf49862e657eff30ed1dd506bcf31ec9a311f0f11e2fabc2f5b401d74b5fa2c8ba4f394f53b448bd838bc92d8f39fe68c0f152963c5d1ed888813c20297d58f73c36ad4988474e1de36199396bb2f9662af195833e01794990cf3ddd3d2e976669c33756aa8c2047694bf29d8387fbdfa41fbe2f164d389edce078fabeaac771b6ef2b35987d4bbe5a61f7bd37d0361e61436629f0fbe7b9f30fda52b326f933d0140d1b02bbcf74da47fbdd60a7793d6c0ec176886dd49def90cf07dae2d1f61b68dbd330e488213fcbd37f74dd4c1b6db6f974f5707b0d720673994f2390224398ff82bdb36a5a2b1392a7204c4355993db84e568dbae1caa31ed441564deb6e395fbd3ec823564d3a20a73253d89f4cdde3f915fa36dda4f7cdbaa31e821c33d0c9eed98f556d37c893551772fef11af6ec98c2cad660ff8d02b16e367d740c4d724552a618b9dca7e18f76aa32f2eecac5b78af617ad7d05b03f3d55effb7a2a68506db2cfdecff9c3a3e383fd679a6caa95c03bcd6bdcbe5de3eb4903cfc08e2a4d1ea46fb569bd2c8037a3608ac521971a5de71e6ebb15ed37ad9f09e4938bc2c881d8bb7ad7177a6bb74fd74d724b1a3d603755f5bd496e15b95a36c4fe90708854b9b7d6168fe9f2d3263fa3c7af41d7430c061bf017fc316a93cf716930d308f67b18021be872ef51bbb5db642bade8e9644ccf27ab945b6b246e0b7a2b4f740853998704ef02f1f33f86af465b8af7351fc7ba4cbeb32fecdac7bfd1645ff17b24b09111cc73fd93c4323c53861c17f4e0fca026d9b7349511a329f8f8a02dd16db2a7660bb62ff2738cbaf35fe96fd35ae6efdbc6275c7f9cdb1d51b077d2f059f02513fe52c2bd3b594d92998f29e4e31bdf02d0138913f1a5b1f6df0ea531d9273baeff662b271c014ee6b8886713db18e867cdbf8f57c00f469105fb0352ce1187d31eeaceb1b1e0769acb75c1cf8886a39dee4b6bb37f8ae1105a18f739daf03103fe5e585788617d7368428c1ef6a8a4e2701e6a0b6e0dfa62169b017d5d027a4acd3ef8fd67709daf798d36fa9c77a91b933e405e1ae4463a20bb4d4672213751532087b2e78902bb25f97e0bee08f25c5326457c2d1f37d40acaf4bf4b1a7fb3453cc5669f2ef81ad0803bf3f06ae56155b95843006b272b81ed6aca086b7d9ac4e0087c31577ca65b2c0bfbc9cd75eb0b5a857920b58dc8b73ede5f5fb25cb1c450d63df38399ee207fcd809c9321d41cc9dafeeadb9d1fc13455bb0752e7e0634923d1655d5d99a7ba62efa01d3d98be12ff9270704c413a7e2c2e79a70e712790cd7df1304be97efeee1675b54b1dc587bcce434e976fee6ae0cc2e9e07677f368c6102f965050e2ee04cac92dcd46c1d92dc89f722f7ebf9003992a73e042bf776ed4dbd19c3a7e6cdda9e3ad9b739a33c3f93328efb6fe7bd7ffc89cf01ef5c67732d5df321e0ef476da845405bf04cac9fbf067abaa0913ee1017df1381f4cb9152eed3f3ff35ee85304f527c8374f63eeed6f7855be76c9faef8be1e731a24ce610c2dc67319d38faa40f68ecf34734dc1f359ea5d190f8f091d287fc924b7a38ad23d2c7ac3e0ee472995db33b5e4f3d35ebc7da60a88ab9f10e3385dbb7e44f7573511ab77750156e3f0eb8ad76b1ee46793ecc9ccc579173a6f0fb1785dfefe097c7b390d27fee4dc0c7c5030ef0d5b3de4f441fd0e765db90737bd4a1be4ca9ed330ef23e919c9ed75b1c78dcd1940ff4b86b62d56d47a3d3a557c39bf1da609e735b458acd45ef685ecdbf5e456f017cbb7784bdcce6e0827790b6ce7b1539f6dedc57d54642a42f2cc88004be7f8b8341322731a81b5fcd5a55b23993cb6bec5da46b6881e43701bcb83e386365bad6e41e96e9e7d74f4cfdfc74c56aba397fff2e1f3349dee28ae479ddc5abc70b2b8f9fce173da6c845247dc3cfe1d88da3117f403fc89e42f0ff3e032d1eea707db56e779fa96328fcc11b636af3b17cdda972a6afc33a56f83da925f6b1644f347bf39d7b3c36cbdf75b41c2fba5c45bbfc401c487d5dba1e7bddbc0df6f7e6ed02be2f66fbbeb36f80bd00f5c340a6c13ee9cb79eabfb924df7195c1d63e5f70c43f395f8da24b1b1264459647993de3d606432706f39c7deb3c2f66b53c1c61d5c7c70ade53c11fde4eb9d19a3c8a50dadb6a90d3d3176f74ead96a3e5b0ef81f2d79e461f276b79fdc9ce726b3d5f31609bda05e265ff21c11f7f2f5fd0a7d3714b7677e6472c24310df5d9b406bc0e78167fe4dfce10cd903d0bd9e0725e7919fc02b52bb23ca40a7cf91c21fba459ba64fdafb89faaf6e0dcd34f3dd53bf5096e79956e1969eb5c66bcdfc6b8254c896a8e0bdb3153d1bc3be021f837d98d70281fa6790a735b3d5c0b3e70cbbd398031ee7fab2e1723b244850476a672aa3b5b6e04469404f2eecf0f4acd716ed64fb6bf9e9aa7ff3fe0cf9ec4f4d9e526397e39652b67718fa0b7b3be17d5378b575418a511ff4f351569f24cdf6aa43dd16d0df91fb7c293f86f3c4e8dfe0c7fd24b5360f99fee9be815ee1180af7fa1cfd743ea4c41a4b17fe48d0935ef3da60a4f6a2d21f013ce1df4a5f74efcafaccc6871c25d053bdf18a063d37d616f42c1bc7adecab9557d93e02c750deb69a2031c55a3feb6b2382bf9570a0618f6f5b9ab918effbeaaecc9ec920af0e18f9844e9222863d96c0072d1df33d0b17f830142e2ef4501183cf7e3e43be74bcb2dd134d993b57fd86fb01c847f89ed105dbfdcd553c424b336328c13e06db741da408b30dc81490371fe9f3cf8fc51c29efc949a6fdc0073459d4e8b90db2c550de12b3fbb635baa3a012e714d434015b5e6c295fc4b4866ed628ffbedae5f00f97757ef8fcce788f1c83397fa34c2b7a5e43e8329f92fcdc551eefcbe9f073798ae7be763ef804f0b55516991edd56be97f97369dc153cba029f600f31fcce38f5a346dff6f8cf55fabb3a6685fdef458ee9f3cb22ff9ee4f1fba40e1ee4123a868f13d833220a501f80e4581ec1de168778671675af169c8352ae67745736b46f3087785cabafc1385aeab664ccbdc8f0737b0c1fd00f66ba337c2dd20469ab32ab97c97a556e07bec52de9c9166c64584b3af0bbfe08681eea9efa2447cd9fe21febd5fe5ab6cd56ec721c8cb0168ca08eac08fefbbaef421cebd4d721556faf04d2160949006b90c83286df21ea2d347cd8e3e341fd9ba3d9671da33bfd757d8fd0101da98ce318c3e95697e3ad067b3787920b7610f9665aa903aec40106bd2c357c891207e0b738f4ac7c7dfc533a8bd4e746a47e8fdfc355766dcddcaf16728fd4a2847abeb05eaee7cb1acecf3a84fb000f23f882fc9053fbf9dc48db2a5e76d97f4ed5a579f47f1d3f814329cc14ea726c9ac77fe9abb1044ce912e86960d3b2a1aecc81ee9e616fb2e8fec378a3705bddb73b9955e34965d89dce5ce7c6e7ebd5e387aa6246b94fab8c3358875faa627e5902f61af153e513f57865b17a263a97bae00243605df06daab2e6434c22e3871739475b4d99931ccc06be076d56d8a851851f2ac3dd18c3bef1e986c4101f98636d08f5a1f942a77f488f32a10e0af177881f57bccd9d49e6521c809c9a479a8fc14e84fa308e013906ade53caab32349cc24cb513ec9e74455a2eb7c7e4f53f2f10af8bc86e8893d03d92e601f6af65dfa614f7e6d90b191caaca0b68d43eab4421f41962d38ac2ad3c8187ac4d76dfa90d3c46ec7277ff72aceebe8789ac2c54617e024d710f03abf0f711c86e0849f62b52ba5da828378d3867c6370702c594ac774512b83f8bf27aa82215ebcd4e4299dc50cdec2731c09ea3196c7241d4541db2197a3118c01787c9f2363cff298f0fae413cfe573b15609fd32ecd6001fbe2079e210641c4b237f8a51faecd6f026c7ec4eeefa556632ed583ebb1579f045714bd07fa05e10ba6b4be775d815f338f68bb9ceebaf5fce6572ca49722be59842e2fbc3494b1a1cecdbeb9a07c75446a0f77c81fd650a7c04b5d84c457c511513fc6ed77b432ed67cdeaf5b5df00a872b90c1fd5fd3fb615d66fd780b65fa80b47ecffc48edce0f97434adf714d19c75a9f3bf99c147e1f7d2c5fa34b5b3f42cafba04ea7a9983be20771cc2ea9cf0bf64d66bbcada0eeabb8e89acafe021602729d3a3be1ae196b281f9f1562d1b2aedf772fb02869a155b51e0695360937cef33f8326db58093479121c0bae38e0673a0b31815e71b5dd19eade65cbeb69519c50f61ff7766b341dc7eb5157912dbcaf72350a0fb3a4650c4a44ebad45184ba9d27be0b6b97c80c6eb1e0ca71c0de388f87a90aeca1009997d5f1207e0258af8a14215ff24481f7f4006831d7c749adf411d43c827deadc7c30c8fac09befb315b227eef3a1dc77b35fbbde21b707f20a23d55fddaefb2b39a3cad3a3eaf3fb936e9b3eff1c33fc1a31a33cde20dea1a17cfd9fe722df3f5bb9c6f318e16bcb35ae96befd664f8fe20336519183f05ac8020ff82ae40fe98c047a23d4aa21f8366429057992e19bdb01bf1dcbac3f96a68e21acec0f9edb011f470c1b9b0ad069efd8a02314df6dab27e4b989d3dd233ae0989112d5973c98abec7bb9aff9c1f932d28c5757e97e6a77962c99e7967335a9b3fd2b657dde76a19f2d0d46a3a0d6b3b6a01d94d2ce583acbf90f7e0af94111c9e561e6b4da9d657169a13e67bc697ecc01bbadf013ffacd44fe529b5ccf95281a30b9aaed1c7721c536399add7c5e4f908ec51a3c83368e117981cd5167eac72bbb7b648b156c878fba3ace6ac8fb7909b51f001f2afb272db8d731e95be07f4b455958a58b0c77b4b668a55b9354ddd8f399edbbc2b9fe78c94e88a746cb32635e1756bc83ce424a7f9ba2c7d1ff0cd7b069c1522f0ebcca7526ddf42fd9f7100753e34dc7e7d5fce39f91619630fd6f8dee8b360c36094b23f510a3583abd67ec57bfcad0fe447f9cc129e6ac93be63b95911cc3a50bbb8ad8de553c44e34751e1ab6ba1271c8b7cad6b3de1e4f72631f3f05593695793e799bf3bcdf32a8e342afa49621b0c1d82be94c7d33dd0ed3585c8b5d45cb0afa40606e330aa323a7e4a7b57a67b48c4d44f88e1e5b6329e50031223f9e1f3de9790b7976268c3d186d9d860ada060b5fb124edff8527d1ef4f4f463815f3f21efc3b53b3f7c9cef0b3d404d9c48a69fff5016c911f9d357554aac4f4c6df4e1086baec72afd5139dee3687cd5185e2fedfd4b1eb080dc66e4b6f4459cda161b79dd25ef80b9882ef000351b35f04de003cafa1c1dbf84cb78d30fe087d9792fafaa3c7f2dd699ce83de5b8ddb8f85788736335db6786f1c8ce81a7adc19c2fe77f94fa54ccbdb2e649aa7cb1087e7e32b3975a12f8a432e5695b96f744709e459a9cacc465d0eab0cd495c62fa0b3401c00f9525ef309782dda36cd3bf1dddec62a2ae59b2acf53805718de33311b69fd4a3f0ccc31d405fa42027e5618ecb5e7656f2eac2755391c3fa48286d9d8e00bff55eb798579abe73350d76ee8b59c57f5b9665e2fe40aecc16f213f8bda3129e45b03bd821f36d7a3b336c8b8a3e35ddf5c1e97c9c7521f3795e76b559986bfcd534b3683264c1dc4d86d75d6a5b93a801cc035f4b23425620b57d2924ab3ae96fb3d155a5a2cabe1d626cf6e975d6e5f2d7fa794c2f49abe03ef131e91d742ae96cfddf917c92f78809e159c589097a42c727e76ba4e481cfd13e69de18ffa89c65f814f1676eeab2acfa2f1e5daa4910f3ce936367fbfed53ada11d12d8bd92b65c4ff97cd7afa7f95217d83daa8ac1d5c5ac6afcbf65dbf4b2ddfb6b4b1388ee728477606d2161b533f37a1ee3766325791eaa3cf710e3ecd090839cbf063e325fe902bea6f9bab5453f241f8ab6a17d616023865d93ba60c2086a0442edbacc4f7cebe35cabf2b3adfa2b1b6c534d368b1a7090cfbdbbf24d6475c9b39c5de263341815e411f1999c70e78ab57c4fe3e7a1ae4cdaf2024713e65481b3b66b08e6c3149c08d1273afe89d2d756f646915f02b5c275a5792e0d8adf5a8bdfa3df6a1fd5dc9304963302af90f75739d40e9cdd007629d80814d8abc682a37481a74461eac0f946da82cc1fd6484e34f884208ef79acf554ecbc2a8270ea5a3b62039d48e3824fed9ae2ecfe15c35dbe842fc36cb8b68b0793d8d9fef6672afd7d6ee55972387c48525f61cbb7d686e59c708f0292789e4812f5892e302ebf83a7fafc55cef54e87fbd2d9de8e0536d99c339d957cf755d7ecda9fd35393fc0fe1c4ef7644de6672d29fdd1291f43e98b7f5ce421f45952c7f213df890bc3be04066ad1e1b54e6c99d14e1be277724d1fd087203190af0fbedf1f349c810339f3aca3bdd34884bc031ffb9654b40f7cd30ecafd18411bcb0489eb5e63fe489d5f413de1f8966f93fab2421ee3eb8f660bfeeda1f9857c49c8b7a8cb45d1841ed618fef7759e86bc12b94f735a5e873ff3ef16b9dfe023c9be5fe87775f651c94e7dd5f39c509127b6dd4f5293f16c676e5479bed564d3c9f404e26baf5ebf801f5f0a54d9bef5715cad3d99ee396325324f6df79f7fe6ebcd6ba97f9e705defff9fef4016cd20b76e08f5a5a7c7df8da5d7f0d8dbefe4b213f845e19f079f2e4af7b0e73d2d7cbbb9cf7f8d7cf688521ace94067b3cbe7c8773d010fce9d37c5f4bbd4f5d135817fcf286cfc796328fd46e6bf9785498e9972eefb7937eef4b059c907b17fcef1a0ef25ca04629adc2fe6fb777b98f6b40439c93467e7e6e4fb90f90ab4cb36b85c1f1783dd82a0cc8b9ec5e131cc80a38afc594a71093da96f6477168c84d35b0d1218676b3d71d6869faa5d26c64f49fd33166d7400be45eda00077c3c9852288058269b8ecb7bbd146ebf94250741fc35f7add5e22a6d89d3f49fc2e9ac254e67ff144ee99638a51fc5e9a99d2175478fbecceb3598738dd4d27a007b10d66ba296d6eb38df2755e50b867644d8ef20cc0513e2f6ad630d83e7f6b1dfec3bc4e724ccfde23b6d65c22ccf5dc9eb5508d2801dcf57cf6c1bfe9f9f85bc9a49a39528e4fb8df0dcd1b23cb1b5e653f7f8cdbbc11cbc790039c310b3b8937fea4d85e56a7fdfd787d9b5e64909395391f023b18e160a9e9cebf6ec5af3b35ac117741f1436f8a81817f868bd2ffe803e5bee57ca6907d64c02e74566711e3897fb62de92a2aefa7dba9aaf966df5fef73adda72aae38efc13ad6e5892d81dfa0c89dc9f894fd41be7bb209a8722d96a2f6dd4d8c3a977373c5d9939a8f643f36f74efc4afd2b19758a4db3a41e7353de07a121794e198b963e60cc1ec1ae348403f8a5b679cd8d815af0825afdbf569720366de1b3bc9ce372ee0a1b6af2610f39680a2d2dc70cf41964139cd300792852f7fe7c4bcfe053d06592db59debfd98a066a73d7ba5cce07a77b6d19eed46504f116575b46d8025f828fa1663a5698730e555bbde66abea7455d55b1ef70f3151515bace69dfcdd5be5d7276cf10f41d384393e41465792210cf4bcf3664a61b15f5d8f83d1a163404791ef4752cbb762fd0c5d971a5780912f834ab0d5b6e07f6f3c039ee5abe27166a77c039db507380dd82de3256e0fc4c1b621d8682f7ee074dff54e12c872b997a59639f4622f8c4fa23f3c3e7b1b1a087c82f9f01c8990d7609a97d57af3f5fd284e8b2d81426bb62cfc6957f0270b2d394f97a4c7c9970ee5e562bfcbaff6346a2d4cccf710957d3e77bf97d84fef2daf0659d77cc5cac8152be552f9b533bbc5abb2739c3976959e44b3189417e862ed453e04f7ea9b6727aff987fec97fa62abdd11061e85528ee4769b592e10d406dceb64af9ae3987d2e04592a0a531a6a2a41ce47964fa04506c9c923f602a50b2b5b677a3b9379b6499e799627093ec3a21d5793e15c696483af06ce0ad5152dd286129c319ce9985017389834c5687695b939eded075957a6d14ddd9b1add5f823c4805ec885addf1acb7c3f9c90cd4f5149b74e907fa317ba41f74553f6a74935a3d5513d835ba5a27671e7a963563067b63e544473ba263f868a70b6ca22d7e67cd1c1c53eeadf5fe7f42ffa8967733e6b033e599bd1478daf0a7e1396f4e7a86716b02ef422e13897508973acb39afe939cbab19347e23ae7bbe22350b40a650459eed0ef2de907ba3c3d4ae8d4c4790beb4b26c94d8920ff5b7749991e16bbb5fd4653c83991e0bfbc7c8f19cdb411097cd72ca14c8a584da20b3065dc5dc2dc939532d7593f55b0d7d4cf7b98c00bbe765cc0c5e721deb4563d818e2ffa2db969e8a3ee579d6bca9ad28acc82b642ff8f974d9e77056ef57cc7393891f3af7515fd2539efbb8cecf6ebcc707612fe604fa5ae19b6e9f3f7bd516c49c15fe702cfb3fc97eecdfd93777aa7b71c95b20bf501b4a6b7338d99df05ecf37160633da2dc8becf96fce1ad5a4f68d04d53f846b6b7546c2b774bfdca6960e06063f866cf1489d2053605fa16f9f90eea357e0c1c4eea7b854c740ce1b033bb13db92593acb65cdf7db2e38c26f4ff56894492c422e389c99e5c3be3054d66f492d994cfec259d926861a5b5043b529b641e8971fed549f15e15b6d731f3466a4e8838303fca42a369c3da78f5a6e2397700ff319a8d80c57c3d1ce1a7af5eff3a09bed1b9e438e4ea30dde7a0d2815b8807520f6b35a058faf819c564a791c2663432c1b72ac63d43dc05a7a05fab786f8156aa169dd036574b948ed1e12c4a0e84b904263c879e017ff689b0701eb8cb9f033414d2c0ffc6828654bb47d5746aff273d2fe2339ac57dfc86c7ef9d926b5aa86a75a5590ff431bc3793456348809c25e08381fec98e503ed6d90a1709e882a1f22c4e4f9abf95a30bac0abe9d37d62bf81fd505c37f3d6b63a66c1cff21ca3fa5c46d05ba14e098921638d5fe1e96849657aade2dadb8fd4f37e6f4f42adafb1ec4bd89dfad0e817e845888a966d63133fde1edfbf063831a0ee04e891ddc287598dbb2bb8c4f4f9d82c7c7775fac8d59c421b0b3cb99bebbaf4598fd4b448c556f82efaa737e5460c3507745dd84b01b904f9be98dfb5054ffe06d1e7bb241f007239d33796c4cd786aa332fc5e1f82bca2cbfee63abf575d3fefe28c9ce1b17828a78421b513e53981cbf6efd4eefdf6604d987d6e690e475895a9dfcd41bc9081576d578cb566dd0fb5a3c9b311a25947f5594693885ff14bf3d9b8c8edaa59bb57be61c8ebe461ed13bc68ca8882d88726f34991530ef9b19630dd215f2af202daed572d74ae2c367b2cc6d840a70017a95d29f9ed7871c99f4ffc5f90cbeb638fe40b3383c4f20689bc7e4b7e48acf981f7aed8f73aa59c80c0a04ef5d5e17c232436e8316738ea966f57c862559e9f72c2cadf50fabdada6cc76da31df8fde3627ac84b786d86f4f6b9dbf3f3bd4d06eb53c1d626cb8675d5225718f1ef055d847456a1990bd32c399ad95ce5f1af7b9c4604691219cf677ee0d066fcd3ebdd35cfaa8ca07a803eb5879ed83265dd292a56d5bfd51cd7001becc350ae0dc9fdfaf3375d7f7477054cf6360dfbf9eb6b32beaf7929c6b1b7e0a79cefa82cbfcb8459e7de18365f26fd27772184e7d6b69bf839ddd9dd4ca52448f30a96b96af873a7c16f66eeeff75b46184919f9cd648d6d6637a693e8eba39c01a23392df9ce6132fba53c9599d13531ec231caf46a4cead887bfcc4e5384d36b1bae0980f924bff18ed5dc850d2ce9d3c9fcbb1dcd69804b98cb3b34b45bb7a9c55f40efa9fea1f76a4be37e4e12c7aa9b9a8e245da0e7cb92d715d2747434de63d58b757edc033752c713b939152a807340bbc5c0fcbbf9bed4bb36758cde9e0a27fd7f4efc359694645dd945a1ce4dfa9cf79d3a0f6059c9ddc921fdf5bef97fc01e237da7b2fcb591de4f55925c86528f6e58e52a32b05fa30ab65456c7fc857e4b568b6a03f0dff1019c42743bb8b3ec769a47ec888b6c00e7439e28b433e9de7de64f60ef062a8630b67b2e7f60e250a977601d127b2b1c3d9d3b0963d38cf5913667fd4d15989b61dd20f17ce76f76ef5a42b394b7286fb34d1ed7499c853e7621cfe1cb7e21b05ff2ede5df43cad7e4ef7aae2fdae8cadca85f0619f0d12f85ef9ef163ec2336c1e931c31e44cedadd1453191ad0be2ffc6c845363afb810bff50a2ca09c8f0c8ec737b839c3b3e8ab50591e930561bcefc16871cd432085585d81881c1b01ebc93c5c9479401f5dc86f3d82279b3534a950f240eda1803077c07d0bf9672a73cd6ca7d07e7e7ca294f21b7976bf8ea850cbae299f939f57189a6f7aa72ca9b2df717e6f4960e616ca75a3062f5faaea2c9f2f9f0679a6bd873e2ec5aef515dd6faab21cf3db7b7aef0eef3a1d1c55f790ddc1ab9efec2c896548ecab7acf87a30b6c4fe99e63474df352a3778446d76b7caf6ddd5555ee6d54b987c70c8ccdabd21522e44f9df6fbd1560ff9a77465b486fd47e73c0212bf4948ac1ece7f119c1df0e7e2ec9d3c87606d2a53ca6068a8697234c197df1d51b95e4d7469d0c5c1ae6b5a77c5b72b7cfa9776e3655dcb62bfc7e97d5817bafcba6bc56399133eebeaa546c897dad6ac38fe8a6e76519b0ef7381dea8c177cbed1b783495ddb5b795433d6aeb31d2fa8e789c01fd4e3c81907704def27321f55d9c4ba32caf618b61bfbe147ddd80b7bf812defd04daf1e9cc4638b28e0abe4fe6f994dba12c22a7e84336a76c0efbda46669ffa5f619b35f8b4709ac70c23a392a7250ed4feff5d7aa8cef1c9db266bcf8c0d6654e4075020b344016293643f724f14cee73d7d0ca683a5ebe5679a65b580cef52bc4a2ee01c4e29c624d9e6b27709e06e76df8526a2da0d615e4f864e7fd43dd24a8674472274a7943376bb8d9eee074864f54d94ba4e1c831822999134d6e967f177c217bbfc2bf51bba637aa4c3bfaa2d6e6cd9f737da33b7b3cef6b59a71337caaa8dc28c1294e7355c8c03d6944f7fa1aedaf02c6cc457233d0d463bb35fca1513e6d814c8990eaed1853a32c46712007d11fa59703e5c835e01fb91f23dc82ee00a3138307c9e821ce4465e5e376757fed3531fdbf0afcb798578153596f338b22256cfb322e5fd6f37b7d3655dcdd74a1db7d47e1eff1434e0c9d7e753381767670da73b2d9877556584affc4acd76e5ea6a3cfd913587ba950beef26c2efedcfea85f27476aedb758977b9bbcce80a3333c6d04241733d1ba23acc9b047a8b7be3a170ffa77f22f8883514f1c94fa7a0ced4f6160676d4621d4e8045f68ae5376c06e85986361a77d2cd9d277cd5caf2de4c4b35ba3cf9ced66bf8ca78a9aa397f97d1315ea11c33e8f967bad4b38a98d7be84ce288036725f16f2df5b4c1f3f8ed57ebe21e228d7128d597b6da7be4c0b71506ecdf37f79774109fdfe8cc2021f343ea50bc96ea8b79bf5a2397f46b2c937e55d8d0f56b5e670675ba594c7227e4414b1c8bbd1a9c56eac28660ee0c2233595f83dae870468ee0807fe5544f0c319093300d2fea0c0912c9a3c9f772424e433c8618a4bcb2a136ad291fa806de79fa6e5b9ff24c3e0406738854a93813b8dace29da2dfa3c0e4e73f80fc662cbb1aebc5ff5723836fac456ff049b6245499f22cfe5b65c9bf95c5135f379bb4682293614c8bdd322b0c58afcdc1f3e1f1b3c1b1998dd19bef4851869a774b33edcd7d7889db415072cf0f8e57cf57ada572b0a2cd052a22b11f83014a33bf25650cbad3b29efbb26fe2b1d7c344251df24af5335bc3ddfb92ee7ba3803a1aa3f2b815feb17f5013dbb6a7e6b6811eccc44979f9325d3c36369ca2f53a8d53edd54d8686deb9ad7eade502f5f1b72a9a640ce1c9b6a29bbcf6a9af78e63868f8d3e1d192e0df66702f304b9e02a1357d621835a8d5a77b413a1be4a568bbd6d3da1746257ebecadf927d0b52005c87f4d56e773b19295cf262bd81fad400d9c70f7cb79661736e021d6643335526e6130303766e55edb2aff03c85db0b7c9fb5dd0194e757d499d380d6af42b90b34717b5cd1374acd4b36243607bd2aaadff7190de95e1e736efc4e0aff4176fb4039d167c4297796c90df89a5c542acd1ef2a63dd5c36a6967ebafec82aeb342a33df823e033836ba983ed75f7ea86601c183297bb5ba8631e4c067bebdf54d3d8cff124fc9cf85a0a196c8a13b96e8e7b10cfb317843c10764a6b40967f4288ba4a2c63aa245b7965ef73af8b7fca8eadc953af996bdf3fbf2aae0e1b738e98b3b35ff4ee5793c690dddd4eb8901d4d43065315f537c8cc01690a3e3e9ef8216cf7dcc6aeab6d7671eda2b61c87c4f5dd49e5d42f4193518ed4c889dbbe7dc5d88d314fb2572ff018d04a085950d79f3661e2387da02f9b97fd056439e191963c5baa6b1295c8f1de8c7c450174dcdf62394c602ba292797cf5522f915987a3067b146f7cce22fdd49dadb211fed8ab9a9e07f790d638e5b0edad6c356e9c7cfa91b71123dcdbf71eb27807ac0b3d5a13fa3d8c52acf3fad590775f4f102f9fcb3cb5c6c7b29b08ed6e7faaa0c79af401b6f217ce763c179ba3285f3ff5c33df4b63404c41267aef65febecb51c8276796aeb35c5fbccd686a8a458167605fa97aaec95b9c0f599c1779f2818d6592dbeb89e0dfcaf708d6d119d4691ecb52acba7762d2a5b14b5023e0b467493ab6b509a15eb69ad7c3ce730f31ec3d83fc1a526fe374ee2fe0e6b59186e07c7fa8e5660a3734f0701dfd2abd5582bac2839aeff160c798a92a53d93943c3d2195efde23c4f3685fd81791df463b6a7348fc59218bc097b996c4366a13e7e64f9b087c4c1ba22da2097355f8bd42bfb4713d86b3f33d0bb233370769bfaf373613af91c117e3aa2af73e172fc28f3123ddec8929c46489bb7b5b3980227c45ed97ea41aa5caa8b46f3be71d275c5faf2ba03fdec8ea3186ec88e113cb7f86fa3c81721d07cbc7670ee7a92e8fe88ab92e7e6ed65e857e21d32f86dba34e7eeae237a44ad71cdb77abfa7aa16b15e36e956790c5adcae70fd3a7f387afeab9434dd76afdbce035cc6167ca731ff9ecb6f5799fc741b52caef6d1e5fdc9f7460fb29a7b457c4bf3f9a3f92b3ef6a22e53ee23d7e443aff4ee5124f271108b437276cc0ed6cbe51e97deb1a8fb3d93b8519eab768ea305135b630e3b15ecd3a157d4fc8f8d2eb2c9be26b9b7067fbd2ee040ef13deba450cec0be08e4890b036bcde4b38df56ea56548f5bae56458d3a67ac84fbf112e7e7c46571002d18ed8c6006b550a851ff57ce46d5227551ecf5772ee601ea128d6ef4743b99c9f4cb7835f5c7ab7be7785ce9153ebb1daf2efbfd9f3bc7e0ad8ef75fd477ac8e8de7df1c48ef13b725ddafc55fa17bbe9043a46e86c2d1a670da9757d41cd8c3fe335160fdb35c20eb374029eca1869a02349cadb8cf656e395f232ec9922c77fa1c878a8bfaa4990d64925803591fa0030ce17c85996dfa38354f7b61c93708fd83ee61324ea43236c9154281b485f63419a7a2c0760db7047f77bf60137d1667709d6834e74fe0ebf6dcbbfc1372d0531aab47f1ff06adc1771b79ec7c356dbb9f8199ec7fd3f7817bfc8c9eb4c4d9adbc69ca156c873bd0a5a0d67482915bb35efe7bf438febfc116c8bf51ef9f28fbf2ccee24d1e4837bd777e7d5aee7b6ba4117a5bd0b7d6dcc48b1aa4c210f899c5509720df641409cb1913ff29087d58e3f4edf7f492f98412e59b10fcb607a10ffbac8fdce7cfbf91e1c1fddea00449760bb06c4be0412fbcadb7eb6170a9cad2751c53979a632c5b98d0c395b24174d14c0ee75761ae8da70be02338d8a334bc087509cb977c28900e737b034e19dc2ab6dc8fca6dc1e4a21f74db43586cdda8458e4a93e68931ff990ed1b14b2bd0aa775a09470e136cb5ef0df5b6dcfb02ee60097da6f92f517799697732fba6c4e6fbd121ea016ee018d7df01b7b9d8a1a92a5fcb84922d3cf5b057c74c1b93f63b9e863e1bb633f355f2be4c3ecfc2db19af6ea794d963b57e0b8365fb7c0ebe4d89287d7e7e134f07074c9c38bf12d27e9ac252fa78f937bfcfc77ececf71abed29d1c9bf9c7946f7d7ee671f52bfc6399c9ac953d87dc04797fe6978bb7704549cb8fb3cf0d43ae83d1e78e85ec2af4234dee39aa7fc0a87c5ef0494f9a62b013f277c1564ac421c91b84d89903f5649bf7cf57ebfe12efac6e6411e0bfb0e182293ed7dacd719cd3553b9aa8a087ff97b877eb4f14f9be875fd0f7620063ffe26530821aa523caf14e2051a430747bc457ff7c565185a0a098ccfc9fabe9310a75dcb56befb5d7faefe32e27d71edff487aeded56c5f49e345f3184bbdaff2f285381d5f134c9f2975ad25f1d0c7d8e098cbf339638f4bf868e09ec101c5eae0b77edf442ef140f117e161e1aa8ae0d03819709afade07d7d8f4508ce314305fbc6658ffa258b1b5b9cb70786d6809081fd3cb384dfb72bc60437fd33d46ef77ed65d0d2226f3dde36f449ae7de687e6fba60f666735969db4296f86f6dd395e176b1cc0a152ca572ee66a07ba30d140357167d9ba79cc9df9f9057cddc5f97cde5395fd6a78d6c6341675d3577ccc9f63fa14d0a2b034326ac98791c0db27c26fdc16fcc62d1fab91791eab9130388c5f83df33c51d8ec9b87a1ed999d4607f1e7f770f3fcee3d6d449c99e35247ed8e82c44ee34adf0b16f604ec5d0b5874ce785c69b6a398dc141054e1c2fa45c423457de707c84c7b0a82278ea28873c38e7805ba1312ceb78f252f9af17d3fb37f49f65cf329f465d39762c8dc6ef19af16f180318406480cad4c57f462600d331d4c86a711e688e35bc197d732059e3bf25ac38ddba5fc732c36276ee1977f4ccfdf471d113076d0c4471ec15509e548f7631d71b5c8b586a81b5941cb99c7a6fdfe90f8aa92b8f9e74f611d0f27c7bf817b61641d974e6c22a71f396baab373984fc5653e17a443f2b9a8f78fc947f77aafdeabddccdf119b4fb4fdadfa5a7bcf32850f7bdcf0ce5977572ab739e893d3fdfb22b4c4cb1812b4c5b9b4b988f3a33e0f783bf07ff11af048c1bda3edb78eb2b72614b7385074e248cb9d27219f8078406d1c86afdd13df7ff4598fd56766efcff27c9576c8b517857d20bfce557335973a62d0fd6feea6f5ef637ec3b5dec389f9da31bdc7677b6955f85d897b92de53a93ee6f316717c8feb192316d5d2963e7c4a68eba1de43a5f1b5fc5ec562db5457c24b657a96812b3be896de87766caee3d0e7f5057eea8f0add6b3e1ecc475c82d72be3c8d213476caa0174aefd1bd135011badecdc7c4c697c731fd8e3fdf933b1d0fe6abf626e0ff7a348d39ade29ea6c6ec107923d8ba45eda7e9d99f7f926aeea651fca99e33d673ffe73da6c6fa0cf8e249e4af780f31e846d834f2f04964e7ecae95ef40d694ccad64557adaa71b9110b88b528bbaf2b34f61af4cdc8fd7ebd5ba91df5b56e94d7fef4618f17137bf8f7a7e37053ffdc1c8e6c81f49ae745ca6d6b8e2be5bfd36457020fe203dc1e11f88b8f6dbf454e7a0c8ea70467fce794689f86a08f38be06ff9daf35df7eed48efd3338ee3cdacc01887cde60bfe13e313c77cec1ce9a96a2fe7daed3fe6fb28eee5d81480639ca777ed5a1e773ae3c478be91720d247ef8fc98af707e77e287956b741d58c765f33a979abd527d66eddc1662bc039e53323e6c9918ec7b396e03710deb69cb799c192760397713ca455f18f1d32cae9a9d69b21707a2a72a29f5112c61e1d81370f8ed5de978594f11816fe1ca3692edce4dc51c1bf493dc3cea2272ec19cbd117719d37b169ec0e56fcffcff379715eabdd97d0b5972b0f76ac12f3c8c77ed8a379bce23e29ce7b94cc068dd67036be4ded04ab83485d53dfcf2573c7f9e10bcfc75cecdcb40d9c40e84e6fda911bf724d68fb0ae368fff5dd6e81d24264bafa97fb672beb5d66796823ae8043afb6cdd9bd3699697f4a436cd6f7a2a09fd5841ac8571b7e7ed7c332ee27da3b0b0be7be03980ff0fbd2f1a27da7bb1b90b589e00d82be0a8066a51c381e91ad37b9626a00e61c43886f17dc4aa39eea014ebe1f50c149fcc7306157b735defcfe5fd8a9259c5dab9cc31f135fb66345a6341794e9b9e43e7da95d44945e0dfff1949f9fc6dbdf3fc81a310761778775ae3027e97aafddbc8264be7b91bc5795fffdcb863f0ef647bb8d99974acd5c86f642faef668bef6a6793ce62a27798e755cb4f9fe9ceb4b7f3d6910ab6aaf4dca75a8807f3e09fa64ea5a41026eb0fc59757e4fbd5f00bb12b9b6b37355250d5460197d1a6729ac87eab871614c1adeadd38770b9e7e7731b4239e7ff7f3e3bc987aa6de0d357acbb82ad1d6caad7165bc78b076bc2516f16eb49b03e522cfa1bd338c6398b1cc77be9de47eb1571962ffdfecb23ebf5cd481bc74dd96f589efde26c2d9c49b93f318a5d31e367f8b731eac3259f3f2b7ddada677ff90ffc668cd5481498769756857f2ebce7a5681b333dec50f8c78f352fd36116febc4dbf92c2def88e7dc49af76df5dceed17a7318bd8ed34fceeb5ab33eeaed6a335b1a74e59e6b6b892b3dfd74cfe6f9f1f25cf0186172d69888f15e01e314066afbd9913a9b4fc42b5bc78d632387af3f3b6b702dea2b7f6d6e9d35e5a2113ffba84f223b860b7cf65b3af1fbdab32f991bd7d2844fc435258de66e3f55edcbb5b4bf4edc79c2e75ec6d39e7ed2dc90f83cb7da87c09e6c3d3c3f36d34f95ec901ffa54693e1b67fdb31fc36739a68ea5275eeb78f254f0040c9ffd16728adad7671f1c8cc6367f7f9fd62e6e7d680a4a66fa5988e17ef6cd03c537f78fcb2056c005fbecc44ae44ae6338d1ff58f2bc706565b235effb8826eb46323ff79dcc01ff9eca30e816ab63c076a67eff5f5c4ef1f91bfdab836fdefe9b30ffda323386dc17fb0c3e7e0e4f854958d6b0f854f55dc43ff187df45b1a6abd9ebdb823cc2d37e7bcfda4188e80c668b3776a7b2f76133f169ffd16b881701e685f5e8bb67f354f45c16bc994bf710e1bb83e467eacb45c7b50ae5587cd52a3a2a6e312e34c6d0e6262c00d494fdbba755ef2bbd79385ceea129bfade0eb45dbbdff6a78511bdcb91b557755fa4f5754bf2d3bab92ace711f7589aaf18bc699940eaf2f0befe625789b387e37ab7f67394c9978a178f25a66ca343ef67e6bbc2de64e615718160631cd020fc653d8344754c2bb65f1eb8363695f2ead47d19f47857a9437fbe8d9e1f3d69e92675a57b43efab63a48a68a6e18bdc9edfb61bff61c63ef6b964fa073cdc62dababbc31d7bd6466284132979ad6f22d6aee4d153a03ec1dbfe3e4e4f7b377349eef73bbce58ad8bf93625f2773ee5f5b2e0f51c8a73eb081e2270ce415b023e25b5976e577ed30d6351d416426e3ee3f33db0dcfb9104fdf186bebb1b5ddc7b387f02f63ffecdeae542f9c9b38e3bff94fcf15b726b6e6d93b2cf52358ff29b21ca36be7b3fd792ffc6f02471e5d8657ffa22c694b7a1467b8dfe8df90b874178c78fa6b9927cee6a73946c6f2f4cb5b385fdfd2fed47c0df11debdb35f8e5bed3acaf22826ce6681d740410f1b352c831ecd75318ccf9104ddc382ae1933b729590d6eca3e9f9ef3b43887a0f3021f0edf61b574c8e5cf3c49fb0b3e78f0b2ccd5f6de533b6bccf9854f7e8b1f64c66d28e3f567fd155fbd16d9631e472ff7792a6de518f279c3bf61b346c5b8ec34fae767362b1fff6bdcc7f7b9b505feccc6764ed153c772e3b935667369a6ffe93ae5754f62f559d7f8bc8914c3489fff29c618bfc9c721b3b62d4cdeb654e66ba822df52630f5a7c1c35deaf8b39a8cca3f1b560cf2dbaee9bfae88f687866ef98252dfe8ebbe32051ffb6411ce33adf5d79772441021c7af0b07f46eb4a78bb9bac69f2a1e00ed118cb26d6e6251fc6c6d03bc8c98d3ba923e51ced8b529b1aad45f329e34668900395e0f7531eb1953b1d34d4f7820efa710fdd8cd1bad86696d393f4bdd31aef1d5bdf38f67887384b80dc11faa16a7be420334c4367e9abe69323412b21fbb70ddef0fe704ff526a795f393b852630e41e191d891af92b56b19f959865ab2811a20f6d802aefd424754f0a40e6a72f6d0f872a425eee584e7f31d4b4bc1b35ae47fcb3192d959558e35adc7b5f8197efebe13c46fc4ff1b770fe2b82f32ff6eb2c874cdf137a68514930d709b0e1fab9a1833ff7bc51ee5be238b0f76906b88a15fe92327988dd35d5f398b3164fd1fc5c1de93824dae07c7df5dbd07f7e0a06fbaff6a31daa53dd1d978ea73c53959c955f1690bca64261cc733b1f33aeb116546f44fbb3a1753f3ddff9e8b3eeb5387e92c2a9b524efe623c11eff55af2976bfc0b788b6b6c57e4d8fa726429070f77c4d88cfdd83ccda9e69d497df9ba3578ddb6bb38e7df9ea49307f048e03b16fcb873001ec5b3c7f5be765fee7d20d76d9195df748c6ab9764b7d3ce87d02ac6da65bafb69bac41595786e68cee97763c02eed86a0b26f75dbeb19e2eee334bb73f149b627f1c5b3eb8853ed4e1e6fcbefcdbb597a2ff630d84ea7b87df6b938fbe79aae5d43d730455f160132f56c282dee89ede8f2df28bf37cbaade1d2b3906f22ab22e720d7e6f069ad5a3dd6113efedc7253e0b13e55b27553c2f47795c85b2f4fb81fe23ba8fffd44ae711a85b5e7783e96d77be26a2ed5f613ad2f400c2506f6e1d85cdf4582a65536ae376c08adcb30182f7703bbfc1846b6f87c36b7b966725e3f86da5770aaea892b110179e2005c56aa299ccf5763e1ac23c6bb91e3f9724d13c44e510f5b53eb851a109a3bf6a4a78c8f4c25ef464af342798d39ea1ee8fd95e22d6ad642792cc277682911e1af630f13672a6e1c6b4828af963a6ca33ecc57a32df698a79a9f579cf0f87d9ff92b5d39715f697cfce0ab4702deae8fe9a0660f956d90a71ad7e7df8d1c1fe59d63bf63bc3bd7efac5a2334060abc7e331ba0ada29af6d7d800f548060acd476ce7d653e6afb1fd8fb99909c0622e16e3f0e9c0f6f93bf879a6c66431913aa8bd5f21d640d71262d92dca2b40d79cdf1f2641acac3ea6629c69cb52be7beaffc1c72dd75cd3362cd006aca7fcbce6397f68c5f5c735b1aef218f07a41eb79a7be13e10f5b0b89db15690c1bbc718e744cb04e4716894acfc4fa50152f7d72fe079e67f895ae45c0e5c4e62fbf4bec1d6849763bcbc0d6119f079f4858e32397e20d74deeff9896c4dceadc92e7b4f73fdb3d29d82af6d568384fe8f2cb21bd9c8a92cd83ea9e6c2f0fbf21e58dac675aea7c1836baf93786bbdfdf4d575fd54de738d0d5f422e4917e97991de888517ce0a7e4e301feae4c7e38edd1dfc53d8838815b5908fb495c396f28375dbcbbc8f671ce68d79cbda5b110bbd87f33dffb6be3628451df23566b62ee6e03c10df1e2efd962e38b384c0f600bb0fddf37a1cd74b3843ec31d2ae711137eee113fe1eac17e0cdba4f7fdfa6fafd7c2f596ccbbfad8d499c6b82491e23a467d4a33cbe3ef855fafa12ff0f1f36fbede67f8576d4ad033e4f77e3845798e81236189c40ec5d8f6989e6efaff5bde3f1c2c839909a734c37b9a320ef48b50c89f0676e4d68cd89138aa9277504bb3ba8f7bfe2f1b6dca6863887c815693c376c83cf7696efb16fe06cb23378b83af343d176c0b7abb67fb1d69f421389c62ea89e4409ffd2d0773b690ff96ec3952e90d9a0a74ca60c97c7e2f8b9cf92d55964dac5f0c1a0f5efa532412d980b7d1a5be63eb9e0d8da5fe68b511cdd15d74f58b3ce632575a4005a8af4b30a9b573aaf4ab6095839f8cff933aa6c2bd621c50fd1f6621f626e5c899cb8c6e260513d6eb57779093cf1e6c66bc9e069c99fc7cebe3f983b7e0e5562e5ed2172f3d07bd8fbeba679c649abe6ccaf88fd26a8915eff8e81f322a84b436d66bba14f73f269fda606be8a13ec5b9eeb2087f03d2a3f13f68e72a6a5d13fecdfd45e321ee3e49de26a5e1ee1cf0e6af7f65a4fdc5859056a277d8063a1d45ed39611f76071895afb7f6bcdf1b8f41ef1129633dbc346b2b555985b6812d5f2b5579ce534eefd07bc4ddc5e5ce4e6f603a9731a599883a8f26c2ff6b5587b8d7c4bb3753648ffe575d69477e8627eff35def5fbebe6a226bffcfdc17f3f67b1b2637c540de7687c7c2846be76975ec462daec9ecef9af0a35ca0be3ac99b2e19cefe0a7c11d2b9048e44ee514783f772aefdd109cbbdade5fcba8af17a87f5451b73fb7da14874b9f43755a0e0bbda7ef8d54dece6dc40472edee8c17edacd3110d7afa6f233d14dbba0aec6112f4a3cd4071f77ab7237bc2045ac5e098dfe21da3ae3c06e67ba08097d3445c70e7169e31c9f902ce9fcda4e11fe0ccf01baf355cba52ae893a712c65f6400e5a36c2738e88e79427f650421d317f9fad1cbe1cfa597b45fbacb073cb3cfa6fd31a7fa3147fa5bf65e3755d67536b074bebecb13af8fc8e517e4e6d2dae2f69c9dcd0f74e539fb0d85760a92e6ad9b543f5195deddb68abb9457a9e94e38905d75e0a0315392e93d570321dc56ee6fb04ad61c26a07224fd2449a2f52f3b5c93082c0c705a751b956005af5e0363938564078ad35d5e26c8d77839e72722492e86a0735e04916c7a4f5eb21b4fcfc58d9f9d211f9aa08be167dd63a8b5b009bcf3865814306d7eb62aae8daac7b58183d5d1d7565c550ccee08be98f594b5e7f2ee5ab483f99c345c3371d676701cdff4859bd7607e14e7a6719d505f686283a92e1f729473b513d1fad6b4bdfb083bc08e267368fb57fa62b43d3bd7121bebcf6a2f0fafc5ecf90d307bbed4d9040c73833530806e377401b2b5895a0dd839d4ab9c38a686af395f5a2e99e606f4c8b7b7f9a8b9ff47e350749ebdd8dc3a539c854afb0a9f715e476c3cdbc4b37a4df322c5dfdcb43d9731f2f3ef14c4c3509fbe6f5c07797e27cd1f55fbe2c877631c95dda0071b301473cc4203bba5bdfa0fc447ceeffa1d97de75ef0cefce6d3d0c32bf93db34d8a03c8792ef9d90d63a097e8c3c37f81e3ad82bb867300e16ba3ecefc4bc8b58472befeb2bc3eab8fcae37bc04e53fe88fd1c71d8fe98c7e8455f3216ae45b0ded2410f9c199a98d528c9786e7f6e4fa0f942f527b1a69956ed1eebf783d663895718af3cf6b23a6ee6b3b68c5a1570cfd133342682b3468dbd290c5ed95da5af27817a24b9be3cd59617fedaddc182c70efcb8130596268c6cee3fb6fd4128b31c2838bb45d8ffa8ea19944f682ab2f60a5b676d269e7ac69857fce67f83be53f45d9fabec5bcdfeaaedef5d5c0e91dbe0d1f3c206b8e9e2387ecbb623ae7accf1e523d8242bbb1f14dbfc2d1f2356045a532091a517663e752147b82fb67d2451ecbc34b7ccd6fdfd4d31ef9bc03277884b36f3b517dfdcdfe577dd3f276adb59b9df515be12157df1af2ba278a6565f19a5df33bd8628bfc8a1b1f45d76aafdca938756de88f6bc2c8449ed5b869afcf31bb82fe196a955f8fcb814a79c63b2c56be3cafeb769edb28cd6ba91d8b7f7e53ec8cf0f73d2aae07f9d593dab1d737298fc77bb7b37c6f125f08eb7ce9da71ff0e3e133c2a977e04e3313db77904ed40db247e6bb28546d2e3f5c0058da9c2bae1b997731ea3537e6f71afb468fddbf67a1d8985dfdfdb4fd00c5e2a1f96f1e318d60d9e57553735c510b5e14c680ff5db58c28a184561edac92786e6b5fae4d48ad2fc4cf12e4e4a3e3de85ff1d22eef992bc0b797f178875d15c644f4b034b4bc05d38e846655ceae9b00f542278aaf1bfefda7f3d26a779cf6c3f52ebd0c486ba78ae8ae776f668571013125463550bcf91dfe6f6b0b19f3c3e34c70917fafc6b1e93f4625fd5e463886ff7c77f9193a11a012ba6912815da9cf3963ea32eadb837fea02f9f6a92f8710f67fa7a64d39af1bf348ec03120a7a2ffd1a6ef9b46643a61f62920c29f006b259fdbafc5dbecf90bf93fca218f5ac2ee86d70e626dfc7d9b3dd7e9b354c7c82be6e05fc3e8c6b4ff376d7c1d97956325a7b33ff594fb4377b14ec5bd87f553e6eedc7ae0bea03671f8363587f27fe143646bec1b6bbf474e7ecb24f4ac508f84c6f01bf3898c37cdee0e9579ec866da9cddb445e2bd8d15ac52e78378f89df9a64751d7c1cedf122809f1efb5c6f81f3b52e5d55a7b58334ded197bfc0ed3050db227241c0e664b61079f52d717b6497714667f18e51f8a375cd7da5ebf3a43ba47b7864657332b28ae32032edbc523c04bf29d89a36f6247d068dddd3b3101c129d14ff9f6b192eaaedd8ffe3f537035f4e606b34ced4d806a7a5bdf61087abd1d29741dfc41d6bed72bed63ee5211379cc01f15dd487e27e8858a0679bbb422d3ec55b3f103f7546267bbe08ecbcb135a46de277c5b127056bd8657657293c0f75068b2d6f2bc5d04f458bdbd5db779b520c24b3f771e6b3da6c3e338d7d7a76400d0bf99ce4bdc5dad8421b294e27f1a0d5c8fcdf91356cf33c7f23bf53293eef71bc975f9a2770c354ae25d155c95eb749d3b523fd7e69be5626b619cdad0e7cc10570441ee5ff202bc73a028b458258d9049652c45945f3b5790297cb791f157468682c147cb2e07a3761f74260e10661ddfc918c3329e34bf8e364ed211fd61947e5ae8113a5f70cca2d66e4dcc0b5f6291fb37b76691067df856fc96c0934ef4805e7fa5d8c7860615ce19bd56306a03f0e2ddb8673791c2fee602e6ab18fc0be40c396724cb0bb5c711c5ece5a8912ada300a74ce2315facf0fb10d80de0c61d5b3e5cc4170b7c02db6530155147bf76edc916f11bfa4e137ed4a0e6bc2cee2bfebe6b7fb9145bbce8efb99dd59cf935f796babbd992cfcf282635f3a77f8d1bc618c7b387b096c0511eca1849602bc1afade7f11a96df2a600af3cf698c3acfe1ddc827e0b7e37bfc9517e33c92d096c501f7a791959cde66cf251dd8d2df4b73d084cf23c3488ebbe21f5f28c504f08ee5c84e108bcbf094af32e3f2c87c00ff766e3968b47f59db4731308f4bd1a13c5dbc4dc05576122fd6c1839bdd676ad7853c463dec4ff50e6aecb4307f7d59fba9cc31aac59cc3cfe6ba3b40ddee6a9e96f2f6ec33e4f3ff2d6e293ece34de817cdd6904fdeff510f90fe2a79d0d1dbfeaf1dd7b9622d05a8166e3fb90cf34e1675f5f4f9c94f94caa9bba96b99ddbe676a00e37b92f53c4bfc37fa6b89b0b8ea3f2f7f7ee5aa7fcc00ff954c672893c03e53dec427b395916c7e17c5e257b67ad3d072d599adb727e6e3ab82f5b667a8383f87c66c6989bced6b175703b56e487ca313413b92da5823bfa465ea7d8f61b3857e4c8d70eb3cd4de6b9c6c69f630fc84758edbff43c9a7ef19883cf71b8d0b41e4966c47c42f0c1ec806fc8b857db24e83ed7ef2ffedd8a73abf2de4e3a9f8eedeedcd3f2b3d2278e15c98bfd275eff56336f7cfdb2fc419bed31f8bc1de2aa01f55f1cdb459d2472d4e8d7ceb527f773701986349f0386cbab8827022b477e35d627a8b17517637982eefc3d2ce1457f57aedae6f3467f9fef8b7470e7cc2f61b025c7d680d1afc546049279f224e4594da169bfc78fd55bb45c9bf2256e5cb493e59d29bf50378b67a2de9bc6af79aeb14b791bc7736b983af610316ef05f0bae15d0dc21e204144761695ff40e68697f47614d8caeb44fb6394e9ae1a697e5fe5ffb5b4e4b3b35c9295f8d63d3751e76ca6d60b868f81cc3fed1b75f9fefd76065b668e35a0a6278fb60dacef5aee7166aef02e2036ba41e495053f7f0a192bd9f369b7fed111f9020f62226c8cdf27c11e5db010fa64a682e37c316506c40e8c79d16fc3de613ee2a6b356be620eb431bbefacd18226f6385dd0d4792f234b73aa7b79efc3c4aa3a2eff0059bca34ad1137dd3bf632f15a637c5ed627bff6dfd2c1a2da56d4fb15b9dfc6ea6ee8bbf87fabce985ff0f39b9e2f757e04bf8f5ead05d84773389d509e8e36706f951c7ca64a26338b3cdd8e7554da89acfde5bacc5c53e7d247a16b26e3148a18d64e70a9a61dfd77e4daf2c66b11c40f397e6e9b61f92af4b079cc307f7ec6d9efc71dd195168b19ea79e32c9735b2c4a5476b698149402e5e5e01c3057fe7c206455ed55919b567d7b1439ca1d8f7d9188c2c7d873bc0a8307e17f384f53e9b09bdabbd49e7d04e44e43ea1fb93d5b7eafcac3cdb24ecdd49f5feb8c57f923ddb408df61a73778e8f5fdea187cb8fb599ba53919e4f4dbee7a91da1c1f7f27bd7ed3576be87b3363f12cb62efc21d81f230ecdd753dbe30fbaefcaaf79efe13cc2c7f7e1e8feae5dac6ed41af42173936812f01fe8fea3b0e7027007f51573e39c0a3520e48bd35b774c4d599bebb4cb0a681230707972f11c9b5dac46f8d1fcadfebc678abab6618587ea57d286bdb3dc1ff572682b2725a4765226acfbaa1f5dea6ff6fe7742499bb916d9e46adf3fe1f495ae48555befc50994d7fcad95e8815b2d816c3f584ae25d4f9e55ddd10aeef3055fda5feaeb91bc543e24ddb8917bb55677e776a04f244689a2fefdded0b7c1643edb45c9bd6e4841fd39767672a5ff85c5bf65ef3f9bddbdb8ecca3efa7dbacc609b61f9c0231daec2f2611e94f0c4d79abcd450e87baa0bcead3eb58c0058e7e32555ec22679c42b4d9cfc1dc5180ea98aff54afd9daf919026fbe72a7eda812db1075de1bafb3ba7bc80d3b6e75c55990e53a89db15bbb4deb53bd42646bb36c759588b889f6eefe67d09788ff509b529e5efd56153c0514180e1f75a8c3b2d1eeec161c462f26160910dcea661f7d1f1a6e736ec1e7013649e617eaaf637d597fa4fe25db112ba676e7a709c70de397021ed46b6b60f50bf9563b1e9bd0979cb9461235b7e4ca88f033dfc8ffe64715eaf382f2eb8aac3da7d43fbd894d7d496b2ef1b6a67f361b909e753ae5b27f53e6df69cf97abc9b5b95b615ba9b49639c7e399fd87ee04ced82d3167c736c2e10a383c6e289e5fd8b7148aa771ef4874b6f8d3a34e803927d600f0ab9a2dab874c8de53117baabcc3f0ef7f6f7cb39c4dfece5a7d1c70ea4ad0823376d7f19c8739996bc697f222ef5c2399719ffea2a673c3eef7ed6b5d7ae4db785de30bf7cd334d853ecef02c66c0f90c288f826a5ce8aeb64ffcb954ab9e7271a076c80ca15787da0970eb0dc26affe2628ceec70ead36e582a4da1bd32bde82ca7d557847a5af546c831999534320efb3f06e9d798d5d64e3ceebb00bcf7e238fd4620f11bf0d81b998af6bb990e8fcbbfde1deeffeec0cbbacd1d7fb43d15795cd233e69368ee0887ad94e24ca2f5839de5657ec5f9d3fb095d23602ffd3a0077ea92c46e4a7ff060fe7f9d915f1c18bb3541e18dda66b8af6f55b38aa2bff87b40b732943170d3e3bb067f771ae8fda2fe93c1e99c6590dc762ac246edf58304d2ce8d89f06ddd53af6fe8fecfe78eb5dfb9fafbf7f7ac94f75bf0a76bceb58240a285f6a7b3d8fa33b1a68e08c1071e70ee9ef729e8dce97d794f720bb870afc7d4ed6e75b367d07bfa6710c777a68a45d52c05477e17b055d59f4f35a9e4aff3e041ffd5c35c1f3f3e5d37a42cc63c71facda9e33157fd9d3cdf348eafd0ff78520bcbf8feae784bde7f1bdd3d8ff3c8fab4cef10f933fac29d387c01179d3f433cccfb2ff536d3d67e9a1faa3e8b6d2d8fabd5681fa3ce4a404ccd83aeabaa80a71bdc802d3f95c30c87071f1efc23ed9cffca47bd46df3cb05c21e73a635ac8bc0eccdc785d3cd34ca05bc96b5f0adc37b7cee053e31c5484fa2c63079eaa518cbc65fbd38b15e97bf524f4595b8a53b08c220614d880bf7576eda25e0c7d0e1d5ba379abb9f5b46f8ebdd1bf5caad16620275ee927fb923930157df2539ee4e65cc7e777daca61ffcece3ff4ed07bcc7746d66f980fb77f80fe043a4a7bb6388fc17ea44fdb47d60bfa17b6e741aeccffa5f1d3a37f47e986b10d46221ba73cb5f4c24d4cd9b9b1feed1f3f91f0f135702df904ebc8afe3f10a348dc547e05df836b1d1eb051c55c61a92d7577e3c415687d1a6aebfe135b35b782d7825d629885e5de6fe9882915b99caaf819739e3e670ded0e1abf5c006b5ce05c23f40cc377c302ff16c5df31ec435f239e7ac033c9dc1ed05a43b79fd51a42fb7a6eb5c9bd7ce345bd5196cf55277fb15f9c98accffd386302ebed20594fcefdae8879b27164be47a661c930ffd91acbe7ac268f2cfa7176a7f76c12ceade07c36547384dd39ef8afdabcd3d77e756d00b2cf1d0701d9d6ad65161ec0fcbd15458bcab8be33b5dabbae8d92fbfc0a5e058c19ac525b50f53d3675d397b770f18900ef6753ae8bbc4c75de85cc315d6d583d7daa9729bc3994d668671287e7fc1e39135fdb939b65e2b217edc91a015339230b7b48f3b5f5d1e6ac69862747eb8576fd54461bdd8134b077e2daf89bae86fc8db31696989fb70ddd430715b14abfe0b7b87d693999dd35ced1cea30b8f02d75f0a848c6e2ba26873f8feebdc8b50f053f368bc7235e38b7da9f85f7e5fb17637d2326cddbfab87e74ae05427151d0e17d1e86a43cbeaf42a3f32f6f775d7c392642d053223f6d785fa8e5d92dd929f6cc8ab3bcca6743dd79ff815c7d7718ce6d5d702c3de7cb1a49c909fb20e8d31ce71f3f9d341d1f2150d1ffda3870f2817b59530ed23affeaeceb84733b41dd6cd35860977dffe6f8dc8b05f2770671a51fb9c1ddde015ebc2b8f034b4c3cf0e74ce577762e368c0d4e8e85368113e8a158a107ed0b69b8041fcdac6fc28e2c03d558ccd8595bc60fe7f78c38b0dad001639cca8cc3c25252d4626731f21cff77e6b0809ecb1a981437096ee8e14fe223621121b0fb23e32802dfe1d83a1919fcfd4dd7b8bef708f8ef33cca52d894bbffb003e28c6fad6f6deda057e77eb49e008354f95587e7bb86198227a7e8c047d8ff9449e7ebc7adebdc57a6c4b247abf7b9697fcc2c21a61b543677f68ef14c7c92a8c93c5c7a9caf61c97aeda38eff8347a69c40976a796e75a5f076d7755634b31df7dff47f7184f5522777af70e43f3330d633fc0cb9d46d670e35837308bf171f98175ddec7caf8ff394fbf2cb6b0dee9e5f455ccca0a7cc8c6efbb76e4ec25afb5d6e4be8b0b6632d735c40c5bac6fc85599b86b0f15fe0e32f6bd6dffc3efc29d4d8812b72f3c1e36f8bea71a8b5a792be0f54e066abf00394a779efd8da01bceca316ce0dd4a8c8389b368e9d8073a86aee086a7991eb466d5963beeb4375dbab738e97efe0b573f226b0860c7f8978cf309d5b6d60f1dbe08a1ba89ae8b7f4bd37954f7389ec1007821dc8f228e063a3e7c36246f984b4d4b15ccea18ef8d02dbb7ad19ebb7b660cce58e4821a9c99949b3f3b0ffe43fd8c4b6c1ec559b17a435b6f63ce8121c7788e6cedef9c6aecb3584a858f8e365fc7a11ee640de7bd296edcde273f037da1ec181cf7c876fad6c0768bbaeed4095cd7a3dfb1cfc7775f73e4f2587d15a971adefb1ee3b88a8f89df6d0b015fe7aa485cd4a6f565e46ea153b4e5b97470c3c2eed07b0db414d6ac7614f94655d9f8d233f2f491636b5f4e0cfe648e5585ffa08b8e84b819e51fdcfb3159332eacf27369eda0cef4b28722fc9aecf922f4e3853962b37dc4fbc8f9b9ad4a9edae23d17f78303f685671f61d788db3f26aca630e171788c33ec63d077f6be7a5c7ed4df593673fb6517f49de6732de5bfa9f42b5157d29ce7b3f7081eaeeba99db54b31c20c2b1a1ff76e2a1e02e4bd6dedeb122b7a191bf26c7313a8d116f5e87e2887789e9fca2d60a6075c5fb7a52f3d5ba631276f9d699d8fc2faf163fdbd7b661ac857217f123689435770d6a1af5240fcb080e5ec0b95b11cbb580b22523deabf5c3f24e304d40e5e4b7f76f267121e8b0aefd9c1a27fc8fb5ebfdf3b7bcf86d6b2f6d574cfd7ac87c21e60759e29343edaacd68ad6467b76d809ded2e7e42dcef7f4decd743ed28f696ddd4ba99d15f9d846da033eb57f85e7d4fb6da26b81c365d1708f0c0e356352bd47fa26a1bc2a9c3b9bd67d19257d9b40359f82ae0cfb887c17e759580efa2e1dcfa01f659c0c1897feb860fb82d4b144dcd3d6993fc0d6a9c53531901b9423d77689a7760e4cef0c76321f433fadc5cc14dafea39838b8b528c70e6241b6723c156b3b6b384ff2677e4e1bd8c016c36b59e0ef0c48565785bb5e8772b8fa69273cf7a56a0d74960df7c3233a97a1077ebfb8b36c8e91e0f90f36fe12f80db9ae258dabf07ad547700e37e398384febf3fb9dd0c93034cdfce1da7a94b2edd5a5cede958e64d0830edfb18d9abd0b9ccec5dd7bb877e25ea5d6fe206cb03e24fafb1db8141cc9386ab3977a1b296dc9bc29566665d4f537f7b39db8f3d7b516df3c8faae351791bafe3bbec6f3ff7b92fe37dd9739ffe54dcf36ef8e7c3fd5c32bf6eacaf8ddb955fe76a633d6471bc78c407ed6cdc9ef695eb1a4fe50de58156b3cf0a79ab1bf7a4fc1977b126a573bee56efd9686f51a7ad23182ae64c0399da79de5dc9aec911bfa38f59e3ea79dd0c17a5ebb5bff34d907b1787a689cb9bfcfdfc3f35895ebfb18e9ad61e2365de3c575dd7d398e278f8e7f90cead7cfc33ac6d3fdfa78b8c375387ee3ce55c2ed5fee6df336b7df0d2d9dc1d3c2326384fe5d4b50e1d4b3c8443513c057de86842d7c714b2cf04a6d7b2a563e666e35178fecb397fd81f12ef55f4ecee606ddb9af001ed4c60486c3dfb6c2a67fe4f0bfa8713d43645d0f27225837182a26fd5cf666d381572847b4fd5d77ecc349f0a36ea72bc2feee6b6d71a46467f883c6b58bf8f07fba0f5823bf22e50e10b74181f52fb346adcfefa38a5137736ae4ae7fb867d3d0a3ff43dabf2527baf3fc159bb09bab577037ce7f15c50f33b41d6b71a7b5ab577f998d1fd5ae99b82fb92a48db164b3c5776212b18d7a9b9696efdbba3edce2f5646b39c7a0ddd03f5acd2d6069dd84e5fd90e3cef6d0aadd0537e51c7952ca95fbf4dc2cef5bf059d9efefe23823e8179b43576ac3bea4cdfc08703af239a9cd5721fed39b5bed5d450cbcf65c2bf4673178f51fcbdb489dbfba4aa0878738088b2bc216e8e09c59229612801716f636a675a5a8bd44dd196a746f9d7dfbb9a0275e4cb166f7f10ef8bec4bf4fcfbe9dd7edb0f7f0bc60335bc2eeb418cb888fe5286e5762829013076733e5dbfc7f34def9fb189e0fb53581aa88e0ee87a634b0ffa89341dbe8fdac1f24e04e08ba37ee5b85e756d4845cfac49163b23343da2eb3f93d63585cd53c786aa73d928ea8d5c1e711f695d3d2cb1c378fcf077b17f024cfbbc0dad4ed81c48b83bff3c63af6c6e6fb7a7ea5f7e5badf1c233450f9dffd4bedef93271dc5ac1e053ce26c6f48db24e8ca5fae753c603f05fdb3dee8f97e6b50bd85814a7641dca1b5caa3bcb6d9404d727b64ebfb91a52f3fa6f209daff6edf441e753d503b87bc56683de675d139ff0aeaa13df5d81ef4b5b6178f17c855cdade3d289c986eaa3500d9527d49722ce29cc2d91dc888915c7e67a0fd7e4bcf9ba9d4a440096f3267eb3ca5e66b8e97cfdcf252270be939af572fa6878ce69c577775f5a0fde09f6f396d63ec72c737bc8b9dc797c85782d79e34ee50def03c3adade62aea7ab1f7736d45ae3b4b7d586039bdbefec5e635742d45440ca7a08173dbe6b6b476d39a3087f7e7e6fc54d8e996d6ceb11b2d6e9bc00dad3fc64f27f1b9bb75e7e01c6f2f0d6d812335f7fd0212607fb7f4136ac3fcd7a4c029f71236590f016a9a62e5c0d7c42d2d8e89a8cd066aa6f719489d749ecadcae22460dec23788b0ed0b7c35d73d495b79e344c3cd5d8c26f00bf2a74a1bcf5046b26a2779cf03246f7b498a20e8bfae94ae2adc7ecbd743d956b0b9153852e12fc74aad70b3e16dc0d325e45c6e9d1f6737df968c3746e50871e06b68eda06fc7be7d86e517b89f55fff726d6826506e0568a6119aa381b67f6ceeaeb11b1cfb55deb383d531bb87cd32bee49178f4df4285fedb560e7f06bdc21c7665e94d39faaec2b892f97fbb95f5be37ee56391f13f32f3bc5b5817812fbfcf9cb3abd6c7f5bbdad1bf5b63a11ff4f4b0fc2b82f3ed3da1a45f80b1f7644b6beadeac11bd5af6b67b86b6015d2e89f37fbc0f23d5f54f38ee7c51917c98ac6026ec439ed69bd3de0ebb3c226947cdad21eea0eff18e0935fd3310387664c3959638dfc5e1987418dcdacc6ce14e7a62da30e36e8b62ddd1ccea646e7f7dd1853717de01d045a02c3f6287677a33538898f027258d01c809d405d02700fff6ead9decd5f729d715f8033cfdb82bb49da9184fa6a26adb9bd6e87572f8b48f7cbe29cf4cf5ba88fe018ff76797f184af9e2b63963763b78ad0c81f732553708a6ba336bf71fece4079cc47fe3db9578bc4e22d221dbbbf6caff9033696a5f631bfb67a6f8c6ff9c47cbf2e4ce6775efb30c53bfcc53c47c5792eee1dfc2ed103cb4c6b75d72bee370dfad3c0a77ee1be11efdb96fbd48164ee3ee265f2a1925de62fb55735fc17fb40a5770cd402363c577b9b2658c95bdc434edc11d0efc631831b752d13419c8dccdc56d031b6c3c5ee2d8da2eb3da254d8b1da3ab092ff9b8d938871ba1bff99a94a0a6e16af7b6f0ef398171d8f7a5c5847f4628d0c7ae61362c05edc98a7fbf4a05f7bfd1ee6cf14738d8caf89cc55459a5b1ddc57a9569d1f6f09d381125c4bfbf262f065061897cd40d5a0a548f51c51830fac9e6301bf49b6196e82c64a160172858887b13b0d723be01be3f51bae4ac087863b4be85acb651067f5fb8899e6ef9822767b043734ee67d1dc02c647d90c54f3099af273aac3025ea82171813f5781ab423d88b3c8c6b74d98ce147c2af068def2b5afc6ec01bf9bfef6e3110c69995b5070ac0077f1d06bd13e910fe425a0a569f23cc1f35d1fbc78370fa0916315fa52b726d7c325fc391a8b6fb6168587e2fe85e78f90c756f584ae0ddcd7d54e0c1de00f535e7add03e53b72a05fa0925ffc5eeb59c0121c392707d7556cf33b3bd70863f8a1522d12fe0dfd2fc4f946a1dc052e19b811d73268fd50a6653ace390b72bc89da5b6475324b1a2f732c6d857909981fec4ce5836313a61349792f5b8e1dd5afade218345c5397f33228dde9a9cd4f1d8bec029cb3d37f3dc7c774b30befb0783f9be3518af9a8f3d89bf9d8ddc0f9a7732bf80afac0c24c7eca85749387e0cc8d94f3ed7c4cd6c3c4959682df92f74177d2e45c29b6f78e4f52a90b752fd6567a7e5d3e85f334e22cfd615ea5d4b78c9763d8e8cc34d6667881696b5ed7bd1eee03f81211381dfe756e27de8f9567d77364041670ca8d71f09b6639971b388ff502b935e0aec9c8d2963eea2e8057b58e896711aebd577a4fed6f6c13da6f953c1a1f5d31647ddbe2ec74c39b67d579fee3a1e8c4f099ee62827f3fc00bfac9db6f671a199f547746e950bdc5ca3ef376981d82ba0b3feeecb233a0bab62debabf999d5d6437bd0fcf4ac4e34b7dc24c3405e8fd1b94d653fb4ce66d69dc1fc39a3989caad75830035f65d33d3a7e79e4bc35d7bed45eba39b738c5c59f4676116b6ba63ef5fd807725d0bd07f69beb81420f9f6a62535f0c9f1578970afee38df3ce5c1bb40d156ba66a0f4443d16d693ba7869fd0c9fb5437d7c109e339e1dc430fc43f4752feee473977f2dfd5d9e3c00ac051da6a8caf98398fe4394457c2dc06b966bbd31aee034967f149c401b536f447fdb32efa0d9c8bbeabf2434c65695cd93db61ff9fba9c6574ce2b3aecf79ecb166efda98425f2afca37b67e3f9b7b53eaeb9fe88c9c6537b4dedfae91b35b7d8d7bfe6d671e398d97dc9239d946b65da12cbbb331ed59a31a988698b7bd6f65f4ea11f953198b2dd08f3180c62b3aa99da58ebd4afd3d673f310be5fdad8d5710b7f6fc0746e0befdb3a7127fa300f577bb350c75be6b9070e89be0bfb2b493cf5b8a4b111703e48d0591fefdf94cd61348b688dd788c58ddea325f1fa2ff7f470a91e0c307ca89d715fd147b2e39ae8d823767700bf7ee9b7c61dfb5c87f6e9587ae429d0d970f77677f0bffc1de7faf25a3dde9a35515ec7acaf7731074426a8ef6f601b4d5d4c86b3de51be69d71ec0a6e4eb74fa52d6a37ca0ceaeeeeccbf7241f873abcdfdadc7856efa739a022f6bc10ebdffc93af49b2fdb0bbc3e04d1a8a9e2a2e1f8d3906d671eb54f83e37ce7ef4ab8e4b51f4398f6a56bbd2b0ff839a73a174e6a65ea6eddef5100bb9c7dd72612baeb04e5daabdb181169e1382e758dc0619c7f842a7352d66cb6b0d8bbc3b4193f1a1eb631decbdbebe71acfafa8402bfd12b7c42af4f4e4d35d7c70d62d583a9b01df486afb6d0e9ce88bf184d857a2db6026fc94452041acbaabae75df816e7efea33e6a735f62d68bd9cad9d9cc2fbeac78acc5cebb89958faa9a14f796a501b5f7a6ed3b8852d0d4f4e3557f8a7b3d69686327c6776acf02ce0dd3ba12f29875b7fb31917b417079f0ead1d192e1d6973d3472fc5352ed67c19a3ab715c7d18d8e484f12c711a94fa0ebf90b685b8eab03db286e2870d7e9ae3d2ed6b39eec6b33a3bd7d673ed2c6e7b70af0d78cee89c27a8dee337d6c8f99de3bab5f13a119d85212d1b733e3658172ba7e5529e8f8a33ee524723f7dd4602f46615b60f267b3336d340cdf8421ec31868ab60ad7d79ad979d131b153656ebcd567eabe93e184f1eb85b09fa6fede473fc32f20f9916a15ae07e57afb8df77dfacf9f860efdb9a7d3375e2e7a86e8d17e6067cf72d2d6d765e15e2732b577d6a69b5f1384d9d1987a66755eb01fcdc2b30d73ab5af9ddda0272efd782bfa5d790c5da0c08256e7cbd74470b55937da9c7318fc2e043e892362f522d53c2dd4909eebbf44d49af17ac334b0daa741ce9528bf9991b061bc89bb59641a653c07bb03f782bd2705c8afc97eff65a103c374cd3755f003e9785dc7ca6af7b21279d290c5e83b053e3e60708e91cbc66764b94bcf229b8f693d279ad70237c1f034e815ee1b4df6fdea11ade1e1de07c704b0282c3fc8e74617f48911caec9e043e60e816204e2373bddd0c53a51479c86fe020affad3907b30e66dd45ed99ded35c33db01843cd5e72d5cef6c276e0aeb19ae06ea12aa12fb07618c3fdc7a54da5cf180aaebdbc8a4160cfd6dcf90e7e4c565cbbb6b497bac38dd72af515cf07d686e2fc068b6adbd5b41ea0dec6e6f30b3f76eff0bea7229fd72a6cd7ebd4748793f4bf586f1a7246ab32e70c5f0f586bc89374362c072422064effbed6ceb5e3748fcb227290597e90e7b56bd7de6a8e9c61852de5ed63e3bba2f53ab11679347e94b5b5a891c37d88bbb6a0a0d9eb48449a5b9a384f199f016b2fd5b05eeba9271daaecf448b7f484d75b3638ff52eda5710de36a2e99d11cf7c855e1dfe1e0ae8d9f0afcbb2c26a904ae2110db32fc055dfb5d39db336afebdd65ca59f132f06160239de2005de15b8dd73cd4a8eb7838670e2ad33ce71ae593ee8d3d832ec7d5ae02802074851676837e811e035575e0b7ac2a80530e8191fb4288715418ce192c79fe523810d469f72cc1ef2e2ce3a5a4c84ed8ce10477835e615ea89d5424c7221b860b17a1f9e987577a0359ae31cf6712e4cad16ee2adb55bebb630ded76bb73ac6a08d74431f9abdceb4d2ff2efc7db46e8ad1b88cd7d076f5a6a6fe6e904e77626a8ad1538ce9ed9c7945ec8fe2650aebafd667d18c54d6907719281ac5c6b9d39fdd1fcf36a31abf6208e6cc1048efd2f69f6d247de781d95bc4fa7e8da4de2f47124f1ee54962ed9c252bcfd63ee967941f04f6dca89def99395eccf0dda93c63dfbd9fa7fb7e6d53d5fb1e9e3fcfd6b6981b5fa2782d521b2712c4fc1dcdfccfba3c70f5b8b36717ff1dde384b7fa059a36906ebeb5b573ef36bff2b35ef990fefd97c9db7f77eecefdd4a9e62edb745be9a8ee5e16ecc33d6761febaf8af55699f75b79ebe497ddc239aee34cdb3c94ab493b9e1d3eedede91677e6ec59f9fd7cf1cfef75f249eb9cbaedc3bc2f3f67ba0fc81d3f37bd0b11276c8bc82bbaecdcadf2738c9ebfd0fbc3a52ba1feebd8fe61aefd618e343bd253c70a503787730ffbb16eff15ef24133d957b7eacace1077bfde83fb5115763c4c6b1b4166be6027154b7f0db9bba0322ee204a1afc34be51934319c6edd06fbdfcdf85861670bf57fca44e0b9c534a9ad776dbc7572315290774999b946b0b6adb91d5de737f92c5ae9f47adacb6f83d1cd4d8b2426c32ef7fc5597fc77655df9bdc1dcd55d9f2d28f4dec27c641f6ad733af365f9b8746bcfea256aa2f4e67be9b1b80dd5173155af1f3d1abb5904d23271a4c5c293dc18f89301fd5c3eb9f610fe39c9f56c62d473c14fd5f69e6a625f42fb69680033b6262bc7d604763f49bd96c9353171aed29a0ac427bc50eecdc838d76475a5e3dea198cce3de815f192b1bc76ad3bbe245ece1960d99a1ad1cf360479a6aa4a575c034c035755c1db7cdfed6142f5298cb66b9dcc23ac9e689daa6ba7826f2774d6bc3c6abc1e3789ccb189ca4446e3f6a127b5b656dab884f94f761df6b3626d9f36cde9efabde3c646aafdd4fe15fc49c772c97835697aa62b13b1d39ff53add8971fcd47b8aa19bfa70661895580eddd03e75e1f83e8994b12d90df7af45cfb3d23eabc9b4aa7371344796a3c8ec5a167ba64ae46311fa3fa33ddb1b6849e77cdec4f2d67e7ad3b8223297f5de8af741fc50068cbb96442a7e457e119b5fb6f2e99b0f5ffe2f98edac663e2f07a3070ea5906b7ad7f5d93ea20e5cf69e4b34a799feab078af8e75245eac6f1ad7823e54278cba5a73e7a2b636bb93ef680d6737abdbf425708480d737d726de038f0bdf30e803d32e82f703b5b4275e1f4763006bfd0627ddf99d4df3691349d9a036d0e3dace35eb9ff7cf4f2fd7bf7282264c86e73dbf3ff79bf9dfd30ef9e8ebc48fdbcbefd6e93be7b6f29cd9b5bf26298740d5be063db129defca88587477397af8e2d0b38b76756851e6db51d63bf59e65ac735fbf3168e7fe9ab193e3a7f16c5ef67b5cef7ec151b433a3e9e65d49f813638989e16ff4abeb79013e73aea834cfff6cfdc9a003bb3caded75ebafd31e38b51a03d94da655fe162fccf6dacc813d6e48eb5a50f5e819871e0379d83b8f03b91d74b343c63cf7d0367865033de6b2f6d66839a600f182f51561728a0edc17ea0bad07abe359e6b2fbdeb5b348e4fe09ee24a9dcf003c83cc3637b32d833dfbad6f779f9aaee9d495682e6b030e3e5a0b5d87d5a3f7045945fd0a8dcfc6ca666eeb4def05d203b9479baf093fe57ccba84332703fa079699fe26065ac7f218b21e34cd0fec27fcf62cb066a9112d434657907fafde540ddeea06f7343cf0b798a6591cfb9c03d1156ae9b723fc37755c9d64f5cd4b4f9dab3bb70a1efb4de9a71f224c5fbea9f91807af12d69fc7d117987e3c6b5a3621b911f7b76d87718ee2a6b5bd8e902d3dbf0bbb37ccc85e1d24d1bb64928ccc9a266ef95f7913002e7478cfab1710586e7765e2690e00b299b0f3be13ef93e50cdd51c1a066a678d3be0482ace3bb531c2c872512346fd0fc73a0247c56c5b61af45c8490c89bf463d74726ab8e68fe3f0d0c837bda52b6675c59cc769640cdbdc5fe1eda9b30de5b1d543d71ea61f53f80cff6abdc0eafcecca3b6ae1ef3faf85ba813d29cc4fce379e786915d64f238e44360de750d40ed573561dcf3057f3b366f5ebac175de8ec3e6d06fd3c6e48f351b8db8cbadcce8904dca8e09ba778dfbe9ee956c7e0c61b2fdeb25c9be05a4f945b6646f53bf47d203d25a3ae9c7896b2c6f7283642d15b73cb5f04aa89bb056ac418bf761e1324c005d0b8ac4a5605bebf5d2dde2eeb5f85dfd638fef8c19e8138dda288337e9b3d57e6aa6afc8c1bbe2e7dfe0ee35ee53300173413c70de3ec83a79a73ebf22eb8ff1d23a7adeffd53b2c23bbc8b9c7ecd7ad905bd4eaef93c034f91bd58b82a412d7ce2ac118fca30318e3d29d746329ec651575e1530a05bbf651e984657c438404456335c3ce7e2e2398ef81babc914e659ee93d55bd2fcabe8d37a7a7349cfc32c77ba192834e63a40bbf48c77047889933b95c773ab2d7e745fbe8c68a9ccbadfc659e573357ab91f7bcfc7b2813d64cfbdbe87d7df89b3b94d69fea6d2a6805f686eb5b79ed4183b9cfe5b7907077d57c5c48bc5bd5b77df2fece3f2f7ef9c07657b781ee7e2b841bb2d0e126fbdf8a1765bbe878bedab8bafe14c3c35b4e3d237c71a777ce1632a521f889dbdd97b9bac337b48fc8abb41ed3986da0ce05a54c432c8d6b1877fa9ae0dab33af18832fc712134f7d6ebae68e0ded19625bfcd9bf68bdb545369ed8d9787613bbc6becf6addbc6b0cc5c6b5148e8b677c6c26abdba6f80d7a27712ce09d146603b5b6dfd289377d0a7fe83bf17e2d8a35160f9c3de7da067208df6b9ef7deed2cdf49856f1356efc9f3983d9e2f7a20d7fdd3bedfc913e47da8b3935fd4e76eee43a7da43f9a4e5d25f4fc08b76e617bce006a335de26bb7352dcce307590636267ac631d37a8471ef4c93e98ca8297ca911f2bad81aa276e8c7a3a8a6f123e6c99303d93d55c5552f00fc29fcbea23e0772dc9a0b73466c6411ca8dad25bbb4b3f9589a766ebfc462c321fa3e6b148da6f3a0658c778960b3f015c13b32f717c798fccdfa113375644af0f1ba3ef032bf8f2d626c748967f13f3df68c247b71dba7692b8f6f035400ecbd4f7cdf102e5b8cc20ec805b3c0d28bf13624443e0ae36ee34aae2e8a2fc07bf250d39f6c455c1c961fcd24e256c08d66936276a722ad4b6257e6bb22fac7bf8a05b4b046e6193bcb78e5be4025d7bf84cfd245b13dea6bc6d9c53bd921fec729c222f4e587dc2711f587aeca9d0c4e4f1afce721e2b4f23e99838ebf13e9b3b1ecf7d2ed4ba81bf4c64fa1cd13fdf6e0bab9d755513bcea297e8b78da08fc6871fbe0d9c7f5bcdbc9c7eb6dcafe4d73e5c969d22d68ce835fcdccb451de669de23845735b1346025b2361729eabfabb5df0c09998b7af166f908d23781c859fd66f17cff0ecbd4352c92d7f6dc7b0f63e7dc9fd746cf0826a9f6c2fae2bf714e99c5cd2412ee5c0ebe56becee655c958eb3139b8cafbe696d74e177f57e158ddb30fec3867ec5052f6cf803ee4f69491c691b8347806138118fdb782d333af328d672c59631a421cbff58674de7a2b6d52d1b5c18838a5854d19ebd84d8534eb1ddb986c073f8d81a375773c94d819baead999696c4a77a154d717783f4119fcfb5a91e0419d972ea4909715a935f4ee19d4d7daf921e3e69bfce5533853e853b855d2bdab9e8bb3a9285675efb4c37eb1cabfb599bd7c17bfc3599fc1cdf5099ef445bd20f532ee43b3be13c36574157dc435bc85b533d775a3f73114f5ab8c59acf3eea5128072e30f513a6e74f3995dc16be873b9fbff080916e99e040fef6ddfc3c26ffee7c66cf7ce0ce847c30c6cf96dbd9d9507d4f420ed88f4da169bee832ee77cf6661adbbb6be3fcf21d9c22e313d7e3aee458eb74b9db50fab2366f1b99c630d3a54a2a71eb62eec7c9fc63b16734bc4bc2d32fe141ad739fbe4b47e2ae36ba3790eae315997bba8d008e05afc763ec6cff0ef81ff41fc96046a87d5518afe60f514d6cc65e1fbcfff22dea12a9e5818fb57c6113ba5ebf09feff36c2197767eee83759085b5568f6b99ab64339fca9339ee11e17f82a3f89a5bc764a0c8097885788cd16f99886f655c7cb629789288d87054e0cc66f7974cc78cad53cab30e1e9a009c1516d6afb9f470c742cd076a46bbf44e1fddaa7f28f7f97a8fd7d440d07e182a3970ac5cb339e45c69d9ef7dfafb61a106f8ac7dfdd0fce6673578deb3b1e5677d95dd416dcc5cea353cabc77571aa926fc29ed9d41f5dcd95ce0af1038fdd114bbfa1fef1127eeac66b0d3f699fcd0ee56c687ea72b8c3d1f73da4668a3b577ae3dd937f54f79dfeacfe24e1ad8e386e359cb3dc7f8af5fc27795e62ea00520385371c59ebf3dc743841beb997ef77a1eaafa97d590416383bf039a044f9e654673ab770397b35d7ae10f310e37fcb489a5ada0e33432f2fab5ed84c640fcedb4f83bc4430d6d34319eb613531e8e8cad625dde3723e81c6fd8b89eb11c7349d9e5f7f0d3d3ffc18e78af2272f969a066bfc99fd3af5df77f1debe9ce59521e8f0191db81ba84bf586e27d1f65eff2a5779ad370efe0d7ace06a96b6afaac3ba8594fcdcf2b87cee7cb3fc5dcd74f73a0f06bb3e7d2fc44e5798318c423788dbbb8a4f2f7c37755dbf8a148798a582d24b8356b75b5722cd3251f4c4c9f23cea73c1e24265e48b514774e4b3b0deada75de972bded77b5cec8388fac11bc71e343987360ee5ea2243d862e627d4addbcbfb3afa44ebe1d1361ed70a2c317a9b55f38f37b427e7be521f00da0295e7d0c6b1b5e6da1db3de237e067bf6f9ae8eff1fa8886f0d97fe3acaeaca7b259e898bbcf8957ef379dfc7fcf91571f1da79627ded0e1e886317b9cbf277ded84fe6d227daf281fab74734698bcf3fd7388003c146fc435c8257cfb5c48206378dedaca1dfef481b7ae703fe85c541f2ba885bfe59e19d1539fe4adfa235b7da9187d87f4b8b3cacafeef09e9641c99ee57ec3b50d427c88b699c55289630f4fc01e738c2cfdef7a10d68cebdd39460e6ab4a6fdad9fe378885ae2297c7ac73afcb43eaf7eaecbef59d0b15b23e780bc0fe2589ddd8725ee037b5ce4f0a65cdff999608f77f5f9253a7ee1fcae46e6c519baa63effd2cb625af0f1532726428e536eb9dedb2bee5c2418a535e7e34dfbc5fb9ef9e57e0c7e10a58a4303e3843b6508ed45576abaef1ee16ed43698679ff2faea6b37b769cac6ebe21c022fa7518e272296d2954f8195db7cc4b016d0816218f502869063d8f338c066d05dce1c4b99bdb1baf202fffada933a37ef5317e3d174cfae9c4843ac7cc2f94c0cc9ccf09f7c1cbe93c7596b0262524e2aae5cc2d64a712c81b726473f4837fbf97abca7dc473c765d73a6dfbb835df6bfeece8078d95c7045ca3bdf1f375c37e3f4b13d4c10bbe5efb8d637ebc3ce801f837d07ba3e16d9207eca738f73d5dcf8348fc879cdc02720331cc06433e88357eac0b5c8221778c538fbbd6b11a637ae8b4e4b3f9d79159ec24aff0d3aac346f2c83d7067763e16306be462dfe1d2b075fe944880d40836e109eebf228ffa42d1f984d4e10f30ea0fd671d734eae5bf8f38bb9a8b045b5b682e598cbefac8f93d3ef0dfeed7c50d6fe654d3ee85e5cab7a3f9e9fa9976a021b9d99fcf7c83fd893c7b872b35806f82e4ea57ba8a46de696f617f1fda00b4e4e9d7c746bb98db2b52feaff862f54bcefd13698aab2719bfa7e71e13726ecd150d0b3da9fd9dc0a768e75b839ae55f6a6e82fe0d9d0b9a8d715cdbee3c4ca6ede34ce7caaf5b5f7dea5bf8e588db9a558f1b795b0f6a15fc8da95bd93c65afe71a4613cb7266b7bfa180f52612cf0bdc4b3022f904814a88bcef0d45bdbe6f6e4c7e33fefd320e379430e5bda868ed5fe4b75705e3b4b3776134f25cb40351e7e7f8daf7eb526585f9bc50f632d0cd487f6530a3b16487acc7c9efd37ec131b93dab820ed47e37cd2eca5668d94fc3bbcb7ddb4a687b5b37d390ff5e7fd4bc8b1c2181faa6dc59ec1ed7e239b23410b90f7ffd63e0246d17c72ad71439b52e7e771adbbaccf38c35c7b49283714d3c0b295e3e9fd2442e7ee1f56aff17f37fce8f3332ab05af76d48deaf5bfecac1c9786877f375d3fef7da8d6caa0dbd5bca43fba70a9b5871b6f0b650bc4c11375617bba8c29335cc9d9cdb47b11ef5fb0778808172cee5373ce3a5c7b008c0ef4c16c6999b7df146ff2b2e3df5d81ef494956f9b8933955f3db523badd9cc7a0e7afcd83631db641dfdcb9d665bce369e1a924762dad8d7bede57d9ee76802890873d4b082e72ac6dd5f13fcb5b9a2b9c00c9b760a1047690d85414f4f035b061755e4daf2c66b311d1cca9bb08d3db5d3cafc438df9954f61d318b1d515e5695754aef51d861fb6726cbda50db458623e56e22a1bab3b38c7f2bc857a7f28a2bea702dfd6d6aee2d0cac1b1b42ff7f273caedadedbdf88a034bf62ce517e64537c6e9a0668dd4aedbcc8f5a3a2d45bc1dcbc77ad269ad53d3f57a774fc7d95e603c1e57fbb9b6ced0368539c7859276b67e7bb8174e16b3def175d86d76268ca4e2fb05c43297585f415f7b460d9d8f38d5346a9aff29f6a5f66cc0778c6c8ffcf06cb8a5b9af9dec96469c9699ba66e7841cc9ddfb71f99cc05ccf020bebe5dacedeb281e81fb30137ce888e88fdea34f5214e939a31a88e83a00dc00ee779e30276a1a055c1b4eae017f4162ee35f756da65bc23e0f60cfade59e616351a30f2e9204b1141a7364dcebb46e8969d2c1cfc8eb3ffb4311faba73eb29c3d5323c18e70f0456c9b5c4c497cccb387263fe1586d5247edcbb6fcb0ae353e16fddc148e7efb933b72ef20de4a7bc3bb7782f6cb1b372635d9c736d22d696d27ebb7936326c2b8f93a1862c14abce2a8ac33baf79f1e45a9ae8c744f8a09c3fe2c1b1daed5197c622a4b975c92729e6b18e8fa908ee6e8677a2f816aeebded355d4528949a092353b6737831e81bf9502f38af809f633cd0d716e8842ad15ce69f0cc66392ccaa5b1f5398eadf8ae6fae319bd96a373e621f45259b48e7cad9665c3bc696fada7d96a72abd8feef178640f9777721c57eb55cfdedb344ec8ce84f33aacbf27d4d8f33c4e775ecf37e235584f89172b69e37bd1a977c7e72f7f7fb082765790d540c3ff67f331b7da4b7fcdf5f3c48afcc5e250e713946343e827d9a19ea022f7589b8f704a7daf3df7f6f0ffc049f52fe8c85d633f71268762ec5aa6f03be6ef1a7e82e36d10569f718ed459fa2d70a48a54bbed9bbaad8d75e8eccb31e83b45dce1bf523fcaee69bcffb734e95e3d09796e5330ce67e3f7f0d2a7c177fc933df67760eb9f9cdfc56176e6313b0efe87b6588fb5465d7d27417d63769f28e3c48a1a64783ee26cd48e52bf80de7553bf3f4cc0efecc66497f1a4299ba2edc639ee4aca6e6e279cfb77e1b74cf00ca3666685faadb93d59a0c695e9e0a7583373ebb0a0b1a016ab79568197bda125cbfbaa687b77adb7a83d7a609fe66b231bf79327b902f56da6f5383fd4d24fad6047f92c9ae278668befac87c496b4931fbb9f810a7cf85d1ee07fc6e1e65769ace819443ee147d1ef316eb9c21a429fc8475ffef454e514289da5d3a2bab1c2c7b4febbd06f77a42dc30ed79e575578f4bdab9a892bb513aca3cbff1f8455361fd823b203c77716cbd9eea01b40f31612f9745a5aea928e002cba470e61795cbed2b774507786a22d43feee063a52e0debab3ae18e7aea49379be46eaedbfab9adadc1ea65e6b7031ff0fc784aa7358924e6616611a8499df7f512f87f3f1e049eda27603f7d908b31f94a7db3df36a734d8790eba08ec29b63ccfb58915fa9f1538aedaef2972e9e9bcf37c364bd759ffebe4df5aa7aaafcdd8dfc1d89be67cbdf53c75be449643705b765433f477b487f50031fda17b87cf81c3aad61dbef43dbcd4c51bfe8b4a20574b6807d3ddbe19b7633f1a265123ce23b16fb58efa3f2f3e87aed0247d2a5f1c515c66b8e67d987af2c6e614668bf3b8d92da1ac10b3cddbbd43904c8e1afbe2e6dd4f9f9e71abdcddc1a84357b47843e5c3eeffd31ea1ff68ed4fe0a68ee4ddb8fd27bfb9efb7dc8ad65e35a7b17ccc751e63c68cba6bedf053f9bd8806b0c31de67572512b39dc0979dfc13cd0be7eb8aeae1f675d4e465beb444eff9dbb964b63f5f8fd1dc76af3e6ff6bc5ef2ad357811932acd4b71bde4f34bd715e638e77573ec23e7865bbe4dffddf9a3bcb18811e7dc430df7fd6befd17d1f3ad0faef15f1292c7e5388d930bb7dd670a0bc33a8636f83ef0371e5a52369c4ef4f2a6d47aef3debfc41bddce59da2b61cde6869d7b7c3cdaabf338715d0d5a4fb70fecc97e280ad7fe0ed6bdad0b7e4cc2c0d6abe21bac4db49e85ddbf4b73c7f41b4c76ef63ef3fdb00568fd711836ec773a6e2af7157688f4ce16f3136ca6cdaa379cc7cae1ebb27e61afa881d255e0c1fddb8116fa6eb13dc690dfd05437acc5f806d52a4334e4a431d96e0499ae8af87b83bb7118f613c0f3906b1f6fc67360177c40affaa7456f071a11a71127ef7b49dab4ad186af462230d66439b213796426c1c749ef3c58cb737eee4d1f1f67a9786868976bed70cdfd2cf122b2f5bbffea18279efdd2dcbfca6a477613abbdf7d664ebb5b88f5b518b3f7b096bd67ffdfce59ab4cba54fe3c201f6646b6e3de51cec7edad9028bf408ef29bfaff1fed6dbe8e3de8f44423169ff1e5f33384c902b243e7d6e45beab0aaf1081a71c7ab9b7b91f6bd76c8b6af06d81ff1dc5c9a1babfc837ea8933fd615f8b5832706772ad6073b386b62b6cbcabd239db7e605c2c65e7aa64598917289ff5e084a17531cdfdcd429feafdcd1b7730fefbfafb97670d896bb5596df9bf3776732bd3b6633e123036e077cd7ca896bcf7d73ac7df96db402a3955cf7bfdaacdd76bf02ec6521243d732772c9e7e630ff1b35c2ec4bc7f949bbbd285f12c02dd761a77f91d9f7d1ca63f4afce671f9d799206aba397cd5a7e24c3787966e0eb686d0514d25908de8f6de2bc57caffb7d3f0e5ae8c7a0a7cc8c6efbb76e4eeece4bd907304ffc7df5f74cdeb6f63506e8c1f55a7336e5fe48917387d687596ee2afa1ad60bed670ad522cf4590fd24cfdf4251ea8678d5dd71e37af4fa79c0de3ad59badb0f766fa535819ab0c5d6e01c79d4760d730cc5443daf29caaf52ca415fd992d2997afe5d451cbc743f298f77f11c74247d977349d0f390ec472de70bfc2b6f69549cfb7a7e95e27be12303175af4b5cd3b986b7bb8b9e0cfde8fd2a7bf681774ddb1ee287f8245c727f11e3c8b8be374c3961c1c5b19988a3e29f24834f0add2f1a2b16ede97ff9aacd8bbb2bef277355d73179c0855edb695c3fe3dcd387430868feab9176ccdc0a8c077d4d9896cbc9787fa7c5b67e7b48624500df1a7b924a6e3596367dbaf46641afaecb64dadd235f4a43662ff823b337e39c5f6d6fb0df977be119fadc4494e044db305a24d8c40b14559367b8b9bfd68ccc546dac5fe2d46d397efe6b2ca7e75d8393f77daf9e3a70d3968a81faf21af42b4692dcf256a84973fe55caf996bc1b19e7f311efbbd2d69db0b3f3fe7dd2bf90f4c339ef94e87129f4577f1cf6fa2bb460ff5ecc157d03fbeb2f7240ce7b91dcd4a38b8c5a52f8bf862d027074f3a26aeb4143e7146d031c8f2c5d06eadd917653f8cfee67afffe6bdc7ec070657d5bcc28e79dcc752e69ae74d87d3c96cdda7c6b2d102f26d17fb51e5cab9db82a5f13d0c720fb5b7b1f5891b9a5efbfb1f71be7a08bef1914b9d1cce636bdce66f37ee2d919974d877861b5edc6f77c899c7e68bb2fd728344c93bb1ce1e56787344754ba839cb5c96bdefbf078d3bef6a876a8c57334ffe6f8531bd84a881707a81749580c09dc916b27bcd4fea66bf334b7f9deafd8176bbdebb5160def40bdd2d88d6bebafafd77bd01bee3fee6169bb43d9553ba13b95cbbaeee1b7380c5e59ff8549dc79bae4339f489d8d4bdbf4a0bd410c286daf334c051dbfd06b2d6e602fe81c08eedadcfc700fd4c4360bcfe79c4e3d65329d8af1dc1e9e826e51abee02bf5d8ab53f23864ec0b73eea72ae5b83695fcba2131f135f5a14b5935127baf4d651b11614b916e8a06c4661f59938581db37801e575ccef14c97bfabcc719867a7670097faae08b6f6f3c4989fc16f050e08cd79e03d4d45a9d13f6d128c43334e15d72399697f1b8e8cf793bc14dc56312d328acf1594be3d82cd65dc44a16de558f07de7bb9f6717bec58837fb306edf2d977ee76f09d3bbbdc6f83163f62346b3d752ced2ff49e78ec6bd4ca3e1bb5f413b03b4de3d2b96f5f78e62826c2482ab575e35883eab1027e642a731e868663e5b46ac6aa66efe03e606c0b9adc62005c25ea83a58c6fde97c0890bbd2e686b986baf354c3ec0ab9dd5b62e5d1535ac17792eca8f865a6cf97ce750dd7d80bd54e03e3b8fdbd38d75497fcfc7e1eebc2296c9fbc5db749ecb71d92fb955ef7ef18c1b352e19d6c78096c54fb5fb4be706d5ab9ff6cdb0c24f2add254a6be07ccffb0aac61ceeb9473bda683ea77d78f05dfe3b43d73b4a7feae4abf6331ffad81ad97eaf8fb0a6b80f7a7eddae3bbf37fae79cef0d41f9699d73b03b34febba944ee4d8fa72f4684c04f36cc3b7ba9563c2ba3143aff55fee59fa7c5ec3c4ea74c4c88f8904cd9122d68fe5909781ad23ae2f40b79fd644a99c47ea78a2fa13b46f0c9fd7cf6c16fcb7519e7fa6b9c138b08e9b412fe819ca60ef9a723ab7dd25702af4773dedd5e8f59846c5310186d065f1c37caf5fc50aafd7bc1377848a33a87c7fc61a5c532d0f16ffe3e3a2e7f1c0e27aba69a3f9dc5ac3fd8d7a96bddf475d92887a888673eb3fa0a50eed2633053ec69d8a6bc73a6e5c7bd8fe1d17df6b7efaea721fa89d4f17f6593581c1bebf665a3278039097633803f05e760eaef5b4f5a58ec8f0078c073340cd1cf1d7579a38bb5a1c5fb7b71d19c5761a0b83b6f379a1e7ed2cc64d481586abf66c2f3cf76eccfeaa96ffc6bdb9f0dced793ca9bdac9e7f49dcfbff42eeb1f21eddd2b65e9613c43dfae4591df0ae1d6eec95fc3bf7ce87415c68bbd2396bcaacb586795a967b3bb771e7aa46e518052a7cc1a8e1fee83db23f04f8a8733a563a6c5762b7b40479be1b6394fd667af7def513ad86bccf797ffb4293f1e4e72a6f63addd092ced4bef0dfe031e69567f49c7141a6c7af8ed983a70e03dbd3b4abf77d72ff97cfc59d7ebfac6daccda3fe27dba111b0da0fbd48ffed5fb077b6673ac460bf7a563fbe17a1dee9fe2f7ddf6e6a3da0f935c7ba831bd96a63919b1301f38bb1ee3d5c13c47ec7e6d0fb86f92ba36f83dcc1498b5415f5f3af1911479e1c15b018e821bf7e584716733bd466d8f7b1be793f7f3dc7a07efc29ae479fffab98acd74c67f5fc16f7b37d78ebec6795febf363ad20f45ad14ff76df3ba35f44d1aee3fa60deabcf3ef5edbc63a5f89e2c380d189915b34c5aa3efb7df975668e1bf6b9cefedfb23b8bada1765ae0b876bb6237ab67187e4c5af201f1f392fd79042b5e3c37f8b31eb03f7e5fa63548756bc18f9538e8fe1b6393fbead7631369a963b982c1eb422212ddb42f55e76c6bb874d73afac2ff5d515b56896ba5ef66b15f8e816331c2c9a5fec3e903755b96c0ea8f86e2dc3a4614e7ae0e89df92f773c9b8c197f7f0faf06db538079a6fab83267ba4d0a7bb3921776e95e36ba8a77224dc9bae6c6d48791e8a7d826fb1066e494c02ab7477c1ba4b691dee9ad66b5ffe6d0f5cc9dc12c9a0c6dfbc914ba4fdc3fcd6dee9e91a982c0a189286ebd7f9b7f67669ee8af808276cb29759fbe97ed6a49bf9a6d27c37c4faf2fdc2db55efc7a58e9db4073d73eda6ff229f506bb8fc58470bdd0ad615f6aa26576ef0fa9beaf1bb88a9f0bd4ee328f47d84b852c0efd97b5fc539382e6240a159f67744f3e4db87ee187eacfcfab03046f5e338b7b5c3a0074e3d63015da6a0e97d6c36a819cf6a7b76fd1e6ed7b2cf9cecb3226f24d3e37453d7ca7307cbb93581be19ad01b8c513095d4ebf2b8f516b31e8812776d2b08e6710d1fb5eeba592377e220e8786287f9a82d9350462e86667a8dfd631bab5de139f728aa1ef95f150f01c93c6b9e693f3e09ce8c44b4bb56c886d9c3ca9bdf454f20bf92d602a28c72eb0905423016d952917819fb2392af10d00afa224b8ffb3ba8a0cb337bd7e76ce13a9ea89df02af11625f3a7c0c616e6b84d55b64f924f04fb74cd885d3a01f7c79a94c31f3e0f7716d37013e1b3176c6130bed1861ae1aa5e7f92134eef27bc0c53958b203a8bddc57d8b86a1bc0becfec40e1fb187b36ce62e7105843011ae4957a04ec1976ab77136bc3e7b1e2ce118e525ae3b3726c1a8b80dd115c2bd72e79464e8c6abfd17c57f44ffe4ea68ff6362de166b017f6a316e338a5d83cde17fcbb4d822eb0369d8ddbed6cdc6ff09af23d8036dc3833f77e6bbc3054f2e4fe9c8bb8e48f78e05657e4e44335374dedbdd3923f2727bd35b68ed16c358c7e9bf2d65c0fffce6ed7f99663486b7de9b2f1f45bdae1433d9eedff4979c4c6d3d83b70aaf3b4bdf2d7dac9ef2f37b7c7527b20473f3e3c726f054ed91786fb0f6edbc18d12834ff5b0e03c74a3e678658ef5a5fb612240033968a4757c6e47b3fb18f7dfb01e9cb8f3348a49653e02b5bcde5a268eb5f94ff211f4f9843eff321f41eba6dcaedc9b5bd07a5736839ef984183772308e642cf81d9cdacceecb97de33b5b732f698e9ad139663246b2f36a34c7f29af57bed0a5edc45403542282631d16aebd840d4f280719da67b13da48ad0d34c7cf548f03cc4d846b68c9a1bc14ff13bede05a5a8238fc07cb6114b56878fe333bebcd74d023c6405552f025513e77caa773ebaccfe7a571cce83cd65b19f91c9667213c3e74699fb17702a93d712de5af2181bf49fb6aeef795cfe9414ced3301fec093da7cef17fbc7ec4f6775ee1b7cbfa7b0663dd5d9872c2e9ab5fd80b6dfb00db4e671641cf7cdf36d8fd5cef96bfcfff376a676f667ff0f75e3c1091c2fb416d1ca3893b21a3a331d0053128213cb5804d0a6b697cb416fb8f124ed2f7cc6b9f574eb1c2ff6a9f1da30a2a3318ab4f759e40ef5b42d1b82f866889ddf1343542acff888d5a7097cecbe777edb6167c5c7c8c318e55813603907e57c7bf67dcc3bcd45e13e41eb9f4ae706ee814f7b5ecf9a3f732da75e19234bcf7aa735dc04ec1cafe01c6c69d341b57f7923b65aea0f3be3aa6c6b600dc98472e035f3751fc907e1d90e9efd5af877b726460a1c1029e3bbded5de8269b6b01a6f4d746273054e439e0fff7c3d1e327cede67fe77bb71c7e4ce542dfea3143e7effc87f995f8dceebaf57927b79bffbede8e9871e64737b11fbd87cec7c072712f64be85bef7a4c322c8ce45c14b651a8bf654e500ad259c57e09cc55d85de510afa84173e48d12714ddb8b36b7cf7cffbda70bf7787abac0f623bb0c84377f9ec776de4288f9e04ac9d511b2bffb086c81331ddf986fbe9b1bafbb643f15e79be9be5c1cd42ece6cc6184fde676e58363737e56ae6b5fbcefd79eefab727faef7c7e51897be4fef3a4f7f9b62cd8ab9898bf7d6d9aede386d36c6e3955133c6d7b163f775bc9bafb54fd874aa8bfa9a44e3f46abd54ed935ef63db64f2e34883c95843ee59b63ba1cd049ed9babcc0fd3db5eac6ce716306ad99dde814606eed99cd73226a7c05236739be90d738e4ac90ccf67f9f054bfc726a7a6fb0b63f073de1a363e3977ebe4c4f953067d97c6d6bead4745b10f93d37c5dcb97d6d3cda13c4ddbafb35ec7d4bb3fe5f8bb91af209d4fca8d6a6d3f295f0c8b4915d63be6e1d396c0c3a9fcb55ba8a5bccd9d768b1f59a7efda6c4d4bc47a5abbd3caf75de4d474c5889411c67e221cdf4d6312d5befbbcff7b38c3e602e3686bba7668fbb68ca31a793672c5bfff39bd33bf990f837ce112f7ebec59d7679ede5b1a33e3e834cd0dffaea90929d8bcde54d1b599b2b8f6592fd6b3ab8203ecf884b3c0b33abb4730b5fcb77567b9de131686dad9782d79ff408ebf66ed16314ec05a530dc445e6332554dfe153255b3795331f6bada54e1a75ecee60efe76d209fee7abcaff3f72fda7b17df745dc371e71e65534d107a0e70bb19f4c77b5715b6e73672ec4a613d47faef59f8c37a2186e7abde8b1d61169bc26c6dfe750d65e749edb66775c43b7a7d1518167de7adcf3c81bf637d1fa86d12a86d712e35d741e7d8c36c0cf59d473a435f10f7d087726757f9b8de2c6abf07b67e086cfd95719857d9126871ee3df01e03d76f4db69335d6e445ffebce811c63571eefc1eab01c4d05eae7979f23bf7aad216aa42b3010e7dfe9bdf6ef1227ffa27afe8a7b7b163dfd2bf5f295da1f91be0f7a6d12f4b239ab8a65b86a7b076ccfdccef144f77813ebd650e39aa6467354b26d17f314d25873e4c5ed95d71a920a3b47efb0d0d942fff8df6bee1775fb1ccfbfe4b8acdacf869efe6c3fe75c1817318077ce2f26d2b368717197ec993dbfae4f051f4b37f47bf56cdda1e649ed7d16c77f04bbad0b6edade7955e72078cc9b63a48ebf5f6ac6e6866d29d6c667da068745fede7bb57117fbc0ea8ab916fcc818b6bff5cc06fe0bc56e37e0c8309043bb3b17f43c421c76e3b236667593d5f3e1c5e69ee5e07fb65e6fcc49e5384678a7dc73a84edaf3a2a8a1f2807d29aee925d54f4b813919df194bd96ba88d01bed21a7fa5716d60de4760a6fecdfa4cec3997cd61ad8fa6368f998d6b6b65cc27473a425be1da9feb0e65aee15b1a6fe43dadf63ab08e4b3f4a8a79996a3bd71dd09abcf73eb3f15d72aedd5beb6d5f35c0ffb3f4fbc090b0bb6588da92a1607707c9457d49695df8ebaadc7e796f9ddbaa43efe3fe394a3ab82b4133f2d34ef5ac1dd9ffbf3b71429c96fe69ad97ee87b2fc1c2bcf95e7ac2de24ea6a481d289807f70a083ae74803fdafb71c5ef6bd64629be71715e8c52a6191d46ff607ce9fbba11bfcffef1d3e89ff2d8e19c05a7c916799ed348cae3ef7bfa5b16ffad9bcf3b31133a4e1ef239b535f418c32069acefbe5a3c1097e2cfa6b10f9a33f0282f8078e2bc00f87f3f5604c603cbe350b11f77b6831e78bf690eebcbb1333e6f0fdab3547f03bc83c89dd17cc4d6b1b477e3127fd2f4dccadb39d834c06cf51c7b085eb80636326b7f056ea1f64cc77878e71817dd7f37fc9e65a02ae3b9054c8db97381c79b369cc749e3787de4510d6470f223e6a46cf03e5bcadf57d3f7aab6ddc1195eeca5ab7a17d8381b7b65598b07acb41db1be71cd4eea499ddb3187e6f5e73de4f967d0cf9aca8a1707b46eaa663dd4ceb5ab1ec9c86a93a035def9ebaa9a133a8686630d37d731a6bafbbed14083adf4dcbb7ed09c2822eab50661dddd0077184570cc0c03efa74f7f2aee03b7ce54605ad86feb6a94689ba107db548fe6589b9362f7e12abb40fb61e9fb9cfb303cd4fd7d6bb7928ddb7f69f8bddb79c8c6b68ab4e9dad3d5ced69d4e2aeee4749c7a7c9cae72a364b1d5d107236bdb4420bddb7be21ccf29f4e97acd5cac8712cf6d56b7c8b0319d2de7471d895bf0a2066cad4477e3bb2cfe973f077631adc2e9610c3aff0656ff1c2353325ec9423b5a412be0fdda539cb1a59c505f0fee6fe61f56efbf98b60f38f46b3fa9bedf143b8039f0282f51fd99f0f108d7de2caae97b218e47355e935353fc9dab2a6decfd5bebbdd66f9180a741bd39eddf7e6e29db79cbdc8d247df9619dfda0f31a1df65c6b983856d31ce7e0a9c63e5ec6dff6bf639d3831597aab24caded1fe849e7a602fc27bbc84ba6a86738be63829f7f52cfb5d49c32c909689232d38b628f663935cd5aef6cff1bf07b05aa5387fde96f070ff9e887c7bc4fabd3e465e4b47df9fdfc0b182b5d73ab63ca9137dd8c75386433d424bf5eb533d122f6eb73ffbda31b04cf2119bcf81b424ae9db43fd5047a8f896b1fa173c5be3f14e1ebf9ebe3696e4d92efdee5d9dc57d8a37afbc17f539723722d9dfc27fa46b14e5cd5c4beffe5aa66e45a57fc62c5331adf6d70b7aef093aa713459bf28778a823aa78770f2597b953f8ebd6878a6f3fed5da2942b1642a899b6b103f82a72e3e9fde3fa0619a7305004b42f93fe236f1438ec5a39c927ffd546ee37e42b1c9a17c71076635ff6b73833174ac21b4a605d4595ceccfc8abbcc7ea8e791d6764b9631df7d8c45d475b9cf7730b9a18b81b7576e7bd40d759f59c15732faa29b8c8155b6efb91fb875bfc5d2d2f889ee0fc9d4f7fca0952396fecd92c5fae722e84ab3aab2296f2cbb58e075663857acc08bf85de9f4379a6da4c6f169c2dc70dc665d4954fc0e1705d40dc719057042f12f41de7c0bfb54c7c674bb1705267e74b557a03e7317755e007c5a4229f7e8137d6a2397c8475ce31b175f06c95727552bea951faf467241df7b49ea8a99e7ec94f606d89c97e24e1fcccc711bcdcc48d81a702878558c69856d843605c2792d91c0b76aaabed0157a93ef45b9a783e9bc87a6e68e42a0654b0f1e7f75fdf1fefc53ff05b27fb6d2d5e8463788d98fc0b9cd1356b9a6176d93b16c857026feadae3858b798e954d19af73286a5c706db1a51f6f49a09679f66fd4dd5cf6ad222e770bc3b0d8ea0a38a997ef86222b4628127e1e949e91f9123d23148d69cfd47456b78ab8eb0d5de6f29e083b911f2fe14f431f11ff8d78db29f78b4df97e7cbbefff1d09fa74da13f60fee878cc7ac3fa41a9ef5f15b8278a030b1f4edbcb14f1935c711b1e73bd9f32fffbf815f596a1ff71dd73ef2872a59519c9d6486b9765e76867d792d8d61bb39370e5d5f3bd73a9434f350dbe359ca5fd84597627ea936293ffbf66e28e767879fcac4b1b50475e283b0691d64895ba137b78738f364faae10f909734bb97dc26fe679cef1ed67c7645a00919ef8b1b90a7a0ad5e079ef7696efa16cbbd600b59fbba03fdc7b5d79e2586de2756bfdb1d233eef17b96d6c73546147645f655aab31ad6f88ff7f27e597b54d69efaf57c72c083b3d69ac64d1ee146e8cd6d0d7c0d29b41539f7fa658c177507ae3d445c87c670034bc8edd99c69387a2a019f61f92c2adc7d320dc863ee0f8dc21bf324d1f8e9c99ddecdb94e036bd8a6bc6dca7039b78ea7267e74a1cf37efb8cd79568a6d5efcf33b7cf967747a3e730ad1ff460f7176d79e8b52697cea7202f88ef811f61656d35c564dacadc6869d9c96f96b30959d3356b550b3881a83b5b92dcd7f3f40ad9b000e30d83d7ae7415ea32be7dcf97e2a6b1fa6a6cf98aeb2a1764e811aa46ef6198d3fd19a861b3c5d79db94c5bf76ef9a9adaab6926af3341afae5788d97893e6f88fc218e31dc867ad023bf90c2c12fd5b31eeec995fcb81b2c8d6227251c8bbcf3a0fe03d2bcee5d7827f96bda34ea710f6e518348e7df7c41adb5575174ffc9622faa7046d3806b6f6c9b40d7681d2e11c0e6193b5ecdbe080c43a86ce7c27f554bd85fadd818aba18aa21be657796f3397cd63ca43691dd35f9b94ad7efb91e976a1688c04cd2f385e5c380b5c73b317678ef9c9ef767ecd328aca9a95815f5f148045d5297f5c36ee9c0fe2d5de510deda23e873d378a0de2333c33467b56b1ff3207680a54f03956a9ed7c4d4791b292ea3797de7f91d5437b1b19dc46feae3177f1cabbdf6d21febe956f888c9d28b837d116b3e8f959d2b3d37588fd96ff97a34fa4498ab9d5dee0f16ebf7af628dd5f10e3f56b6ae1decfd7873e9e7e5f171e4bafd1236eaa5e87fb1b17af9a7883b793fa1ceb6b37c5f1f9ee7561b7843c68344d740c7120fe15014577e6b187b1689902fa35ac7b6be9f4be68efd3dfbcdeaa912c7527b2fcac6f3be8d67e3d7c0ce7fda52e68bd99248a8c6544b4e5dcb4d3e94ce67cdda3fafa1fab55ceb075ead935a7e477d9f6b1f3758abdaeae581b5da59cd25e82257e6832b7d46d40a1678b4044fea6cae6b60c525787250afe25aca696e211e87cfb5d445fc6e4aef2d074fedb447ecbcf7d7e6eed24ff4535af395e9f9a432d37820bb8a9a88827dccb0a4a6ba440c2aaba1813f4af714adf35d052ad377c8748523dea769cf94678a3e98084b65226c1683aeb07daff755d9bc3c1ed760efabd55dd4d5ce2a404d883d14fdb4e1bcbf3ce2c3159fcf6275a869940eec3cd3896389273a67058e71ac97807294ebdcbee4ebfb461c03e32bfa9222fa6bf3748febe6e2ee35752d6555c65472bf0458824d58e333549e653eab351e812f4c359f66b08d0c1f5db37f1b63726dac87967902a75c608f6b6b316e9c59c5dfefe6d67365bccb93927dc3bba0f8fb119c8894802f72efa9db0467167d4fad0fd1f9e3556162ab6206b1be9fc70c93557c1e5d9bf439b92f3a02bf01d7e0264f5fa370930c95e6f7261ebb6358fd02af6bb4cfded5a1fde4354b1fea24af4fba77fedc9ab7790c3df8ce662e11a11e3f0a4dfdcea9e1dca5e3c943fb397b767e1f33b69e8ab58e5a1d9ff90bfcde78794faff21b9ec2a6f8866abc2a3de3f7454eefcf69bdef6150df51d90c5e8f4b5ef7cbfbf4d99313cf8686e16441350dc921b4a7db88eaefb7b44f273e8a6ebd7e402ffbde8318d72b7d177cb65cce63e5a9f48c6e0dbe06582761abb82aa1f6a1c6463d82f7cfe6f71b36259b877adf62de32edb9b54d1aaecbdafc3be3b9aef2ad3007a22991bff3dbb6b6ea9eb79fb7cc16da57fcf720bc9b3f3cf78bf9d23a38eca6f2de57cdddc8e6183d993ebb18aba8f32bcae7d2a0a8719eb8afd0233fb7cf169fff77b91ef25a0489ea49a3166be5aa9dbf811d2473897cdae9a0c66f2bd9ddbf8189ef47df886f348e1d7c14def335508684da12c95864f654843dfda7ca5ed6ccebbd58683e6e376ca7e04847e2d8831f62822a7d60feece2bf1fb17f3dafa5afe81885e22c50e1bb8eb713298b0fdf8a3196ec54fe8cc7390baaf67f960fcefb538b97f05a2634dc5fc1a73e4f65d9edcaca874ab5dcd7eef4e56b62e9ce5bc35cf1639c69e0b6196e5c03da07dadf414f89c0c3031e122f0e581d7c6f817886d715331e499e43ebcb1bc702be06778aa1f8c1f2c0be646ebcae8c9810f2cbed81aa4458a3814a4e2c6e73706e695b5fb4a9c257e5676f95afbaf6a4ceda53cdd387d58ef2b3f4cc037c70ac8038d68472b5076267e9f6ab78cee1dfea0787fa25c1de93828d6b6b6daa2dccee459c2763d4d2966e6c6677fcd2dd8deeebdbbf0d93a51307fcfdd5f37627fec7d6ce8aae1d69d876a5cede958e84f5f7cc4740ebc8b81691b875ece15fc681b777acc9d6b597549fc8958c7d8335faea58e2c1b1c8da0be533977477d99b0842d373ec54872f2defc9e27bafef7917fe66ea5999fe2a627a83f0fff1384acad65795c4959eb61c6be18795f88f8b7de76e3ca913792a59cf1bebf98cebb88abebd97f8b9001ddb91e46e321c267b8695d988c7c65613fd3ef51b7717e319f96967e9adb516ea34b4d54bfa7be61fc7a7ce97f72a56eae5e96a0777603291109bd864776485df8b97e0a15fbcf574d5e8463f1dbfea984b79fd530c93d1c767e434a7e71238cadcc95b8ebb59eefd968e58ded2ed72ac417b5d8c2ff3ba0cbfa52326b305361278860fd43a87f2d2bdc8e7fd5b36f36c97f4a5dbe271f482164e4b5fba29ad4f5dfbfd096a6f3e3dab13cead23799b752aece5ed3af3cbb977d72fd57b02dc9c16d998e270f243ec60211675dcccadf65fa615ca798ffc01ce04a1fc4ee8fcdeb86f4b18afb975b40df2f2afd43b976d17f0199d9ed77233be5125981ac8173d32cee73626e0d6f6692d6cfdbde3a34fd66e638cc6a4fa7caa8e693e7d80cf7b95445e6bd9762573d7e4bec0dac3e39a346645f33e2ac3f0a9453ff950887dd21aa616f841998f51c87b16b15ad7cfacbb6b501c2faba5d7f37c338b59f6ce6d2dc6e33f5f8b6b6d9bf79deeab9676aac461d4afb76c0c2b7cd107f2e295edfcd9bda1b4de781b775e25df6f66d74cc95cf2fa8b067b3a1dbf54fb05153133d44384ee2c89a88f6a07041cba7e38b86bcfcbdfe7365b27416c829b83c5650af1581637c7fa1a756570df6ee6b6f6c5fe3fcb3766673ed3ae60f701ae9ba532be88e95973b276edd175c7b8d9b00e23b2f35bfad28b353289cdf5dc6ad3b846567f74bce8cb92d5a112ce73fcac2bc1d8b1c93eb0f59e6bb9cbc03a0aa6aa849e6a3c94f3a1ef31f97b1ac61e2351cbf238148b39ccb42aab31703343fca47eb9b04c5cd5449b1906ead677110bbf7dc7bb930bc8d6d0b4820785f2ae892dd75228f68fd5fe5efb26fd61e2ae1b734c3e840ff263129fb925737dc82cf64f318fec3e06df212df2a3d6e602d833af7de99ad8713a07ef7d75fe8dfe2db89d4bbe177ba0cfb881954e035b5e7a4d71340fc4dbfdb57970acc32fb73f147d55d93438a3585bf87cdccd05d7eacb32dd1aca3bfe405d8af2d197815ba3e7f360d56b0f7aed7d10be1c7fcffcabb8a8d5157bac8fe558c8f5f7fa15b914d6d7ac1edb2f69a73c147b2ada35f892f48cfcec6bc207fc4996e3a6bc48889fda635a57efaa66ecd8e626e81f979e7a4846595dfd17f479fdd671eb49ba887bfe05c777799d677dafc0ec7e0f87c49ef7ef60d6489b3f0f63e2dbfd713157f21056edc28f8cb2e71e1bfae92c17cef6406dfc8fef911ec5d210bac69b9de752734cd1f9d96ecb6cfba7a480e71ed4aca9a2667286c571e2e3de91b69e9d8a01c30ea58e64b2ff0727c0f673648a2bd4f67faada06bacaf47717dfcdcf82ecfbf1dc32376eb73ecfc1e6b3a88772f79e908f6b93fbb4c4f6a47a1ea7faf9322367fa339b59e0ba28c4da932cd64e5ea4b93d6c4f887f305ba63fa8bb9bc6bae8aabd8a71a8dc63b30c3726320d8ae1c7284c7a5373a84cc4c9ee2d2cdd9fb086f2bce5395ff95599aface0ff3d7cd35f88024b5fe2bc1ab5f279da3a6b33f180c1a88a5df4cd3db0e28de7a378bfeabe1c2ffe5fbcfaff43f5fc55e629f3b6b033ac188f006e34a43ca74b562301fd6bd4e667183cdbdccd6d1d6b779361f302aef5cce2141c2372dc38d6708ff3049c5c05ada79bf827aa153a7dd9bf4543e4de5e3d4927839c5fa57edf0556e780f7df8d7b90a3ff16d367fff1d37688bb922375a2ccf7137dbbfb15365a07387b5a8877f7eaf1237db2732c317152d9cdec46b3b9d74e93ef7065461f6667ed5ad5397e5bd2db36e23c62876ac6d37fdfce0356faa21f16706564355048e25ac728cf0fe4b538838527390bc736054f12d97d39f759b11799d68d987cc4ec4e4f312fc7330f49169786dfcf34728afa2bf53e2d6f9b6511e2a94db57186a805a1b6d48b199e26427b75f271876fe0e2cc5db92ae5bd8f783b3e683bf473cd4d3a78c42766bcb55a3b5b3bb5311cfebd96db5c33bfd08e9785565e2bc7f1cbe39c0ff95a873dbcad1b528c5916db7ed7476b124f7b202e527cf77f131b298d493b9ad7d9089bea6937f5a5be353f6e5f67dae0c691dde3f2bf7f4e6be24f85b655d4ea5462bbdcbe9e429feed6beb977b662ffa05eca916af9c095492abe9ac650f9f7fd4fea3762de89938a2b70d80fc2eaf171adf1d66999a13b7d3c575cb21de5b686aea5d1f756f0bfa0cdadb9d5fef45a32f7edabcf8ada7c0c1de3bc6f8c2bf17a5d1a5beda7637b038b42e69278a078a535d5b4beb95e0a365e990a42533ff2d3b5b65b5f253b5fed24de7af2933549e69250bb1667bd4ebfe1581d7f37f0b303e09554e37fba717cd75379ec494188b11c281a3020a8c7df7babc39e7faf6e7d66bfbf5e9b557dc5fd0ff870077c2a7d2d19019f893cbec4b9390be31599e399e02fa68a6e184d6332abc137f41e4b758ed938f79686a9bc2cde792c227c180753387f683feedfcfe838de3bbb694c7c3bb7f4a59fb60fbe14ed1c69b177ade3bef26e66cac8e99d1aae9bba3d762b4ee48c2cdc4503e2a7e2d4b515d1b535616a4d9aee35c2741b9bee37fefd87f7d9d9e7e1edadad33570c951478591af93a35ebee862f1d9993b9595c7b57bccd6887e1c7caea0e6eb9e27c310f54b70fdae8ab84f82ac935c16ae6a1d8e77f25e7d8dc472a8d43feddcf26e78c54e86758e7bb9a133f26bba07f3907b573d980972d7fe677b8692b7d1add94a195847c9068b774029cbcc3f77e29e671d9a7fa7df0c81cb0e7fd373eaa2da7a82df66bb92dcda5df22390782ae765a1e626b96fe53db5575bf541c5b6b0f7a67ae87d2fbd85dd3943aa9ab649ce917fa2c07af8fdcd813d7f48c91ebf56167fbb2e8c7e6e6632aa3063fe74580d626e2c84e4bbfac6529aea9da31a888373c50ab80b105473bc712c93daf15ec3c1598b4f12200777918453f9b6b16dbb594d387c5c7f51815de53ebd7602e74ab8d5c63d3bb89a0a585fdd67d693f3af7f9fbd85ca36dc051b95d11390352cac7667543bf5d89ec3ebae054182c7cc6f3c7745a594cca28af993eecd5b906946104448fe1f411a3007fcda0775466e6cbc268e9a87169cecfb05e6ccf757c62c8384f3f74cc81c16bbc5e6ae3940dcee6d258dde35138d7480d1e582bbc9dedc4abf25f2cad35b7263fe47929dea5e8f39ac66c14a715242ecb398e5e2afed6d3282772e3f84d791d17b94fc05d85bbd6163973d4738363ce5b6b02e7ec6718d06c3d73aee1f070ef7984b6f3ac87c7e3948fdfeb8a1c2d6c1c6b639296be760d6de937d6431c1f1fd9c3ae1a4017ee54ce57d3b82f6a0d378805fbebe85c6f9dbe7c4d04fdf75baee5a9ac1cc9dc9cb136d8fb72e45ad0d446fc0f9c53881b8e118f06df016ac2052f9545ec7dc4b3fdb823028b41f7701f35b7c7e881fc6ac1bf440ed47cd595a30f1ce49b72f48d149a955c0fa534b6976746e44ae6ab636b42c5fea435d485f9fb07fb2ed7329ee21d4fc9bb449f01ed63c191ce98d687f060986f8bce77ad9df7546defaad10ff7f2ad7bdc62ab5bc79527e949e3d813fbfe3ddb566adb759c05fd9b7d58620cfd98c1a2faee5d772771791baab57315d41a783887fe052d820a6c02e1cf1f81e3f074fe7f5bbac579686e5c4bd9019b3a11c4d9bdf1637a01dff25f74eb481cabbdffc6dd80b771eb48e269b41e8a35e30b9f5b1828e6b0a1ff711c2feec4572eec7ca6dba427c1fa1806b62e78d2533228e4835debb0c5be0c32acd4d6913a9bc012779ffd8c6b7964b989d7c7ba3f1ebc16d5837fc63d788e1826ece2fa98dd69d7c7938bf16a4db62eb46b6df9996274ec23cdbb8d2c22b896f88ce77bad41c62fa79a317c9e4fd850b5938eaca5e84ec593a782f36c78fe6ecb3d50dea53ed6eb71ef87e2d2ebcb5f4ec577ddfe70efab3ab473cfbf079e5932d34f75b884fdfeec03b3673e8367d49d8a3be4e03efb2cf6d43f6ee143a14f9faa0efde3bddb3aaee6aab99a4bc3fd47bff0efaec8c6b4fd0cbe7147da865e6b91f7df29f068020be2daf2e953d5a8fd460dc9a70a5c97f94cb19a53113edbf6b3af894e7cfdf9c80a52af651e3ed5a118c0473ccf67e17d4aeaf68f1c33779e8fc298409b98ceed5a7bf6e28e50e48ffcec637cc8fe93de19027aff28fe9bf243acf1fce0d9573b078ffa75478a4f0d5472a07d93345ac354fcf708b9a7b579aaf8ec39807ed8543c218ec9c6091c8dc0913cfb2d39817e881f8ad97fd747c18f959d2fb9e57f87225f1fe05f279f7d60c4e99a8dfcb87370719f29fe3b14b1efc4cf3e7260fa12b9e04fc451fba6c0ff9b8f37b0fd7df330b271ee8267ef983a969e78a9b8a6f3d83f6ec115f0a922cfa6444e6b287ad690f70b3c936b772a1e8059479f5c5511aa3effb0685d179f3bf01091916546735bdffbeb23ff3e3823e0975d8f1bd57a34ce6b1d75f816fe5fd9b9d691bf973f7f0bccd6bc9bff3f6bc720ff3edd3be04990ccc2bf8d2dce61cf065ec94c837ecd1aa3f64f1346969e7a2d99dec56cb1433c5b163eaad7e5a7dd0a52c796eb7e0b1caaf049f3e468175d87a7007c89d292ae7baf65a22dcf812dd3fcef679ff23e2cddae1851ad433a26f967ec79f9ffafd85a865e44e4dac7c45bcb62d0157781252287fecc6bf3b0677dd44349c616baf39faabbf7328e62be2e729be0e3f3fe7159d62a379fb3dc31d545dbb898675b135d29207e0b1886f6336d13c5268807dcddbcb55efc6cebd8dadf4f95c60c709fdbe6fb647d480675e743e16ec5cf1dd388eee624cdb5491ee01525fcd9a3186b4024d0e5aff6d38f84da3716076a70f689e349730c48398692ddb5ddac367709dff9927faee06b5fd4ade7bab7f0bfa332bf08b025f9582ce0933831e2e14f61a3b359d5932094579eaa9cfc9340317283f561fd666e3fecee606d4f937c3c81592c3c73813c8a2fb15cc90f9e817a9c39d76f8cb39a61f8037677f83c4c896793433814853f6e61be4612e3b02ff95dd0d7cb6a0306190ffe9fac0ea5b24da2afb2f812ddef381f50efd059f9ad5afdb70b1f90ffe66e6ed2f65ac3c8e80f099e5dfa5e952fcfd62d7d76e4a60cdb1a8de28004bdfc6f8c8fb4ce2f64f7461623ad1e3b5a7be4d96127783b7343fc833be0fbb48843add4cdbd733fe2718ec27bebb81fade3b6e1fe4b2f625fc7f17d5b433c55681cf3c07767969278619b7816624bac66a8d8ffd5cb6e3c2bf18b5ffef6ac1b4bcab1a771f7e9f8fb110ebadca609db823e52cd5d49d80656e33b666dbeab80f93c057d686fb6b1164eb6729cd953aa774c3e8067b3c86ef0fab4c09dd917b7be8d1a5f450bde50f315837fe8b8449cf83da479f455d01ff07f976d79e14eea5ac76d45bcb77c9f8acc8dd774fd494498f7cd90c57fb6ae1a00071f43db9de65ea7a238af5e937bdf36970dd7e569bc687e2ee4cf66678271d65ad83af678eb4cc519e74c1e749733c752666fd362cd41c6edee594a3a974cc697ab8881dad93aa83506fe4b72e32c369ac77c924c93ea793157cd8ddf95bbe3e8fc0cec6d1607dafb71b0f46272ca9ed53ee598fa698e1bc399bf38d7e1b6f96f4f8e055d1d1977cfa850478636edeae67cd61a2e51bbdf18ff621d8f939699eae679af949ecdbe3383bf521dbf245e5f9961bd4c8d49edef6d29d878d270c9300375f1a88b9a4f73e31b5aec327b3a08efec6f7657f75a8ae8d4ea679918f7d3873d6eb8b7c78fd4be2858037e8be43e8a231df78135d9520e526bb298ac86afdfa87fc99f5bb19f2be7d590702f5542c33649c0b9156be2ca55f1248a6f92f2b1aac78cc29fb674638efd07edb63ec3d335892dad06cdeb33b3f70878cfef387fcfb762a4339c457dfd6b6e8fb7135b3ea0fff09146dfaf1729fa325b2736a380f2c835c7b214fbc7ec2be2eec81155af61ca679e9c1adad4dab3bd328e67016f9c9c7ec785f7d4d81b1ded469dd9fabe66c91436513d12275652bfc11a66cfaeb429fcbd66644e0d81bccf1807cd5bf7a9cec76aee27bcb2b50f3d8ef5b83e0e1d07c08fef7f8a832ced73f6cc0afb5db28d251b457dc601bde778e0aeb434f89e3bd76aaf696d67ae4d457d543237ab733e589b235a0f7a6c072a59f9f477c8ab213e486be86153f78e2d27a3b466efdecdf7b036ddc0cc786b2d71a5a5f0409df76336fafc7c5eb77de6cac87561cc5d164b192347bb0a90732dd7c36d062a5ddfa45cef4dcf6e5a43e7c7e0b8ecc4c53b25f469e66a1bf1d175857eccd98ec4e60edc98f3be5e594f5dca057607fb91d4261f7d93cf3bb8fdbf70ffcdf37d69331fcf6f99c8679dfcb44d0ae344f7c0485aaea8ae886d56697229dedadd37d5f5d1560fd4e65b6dc96b91831f779e3e660961ef6930cfeebea00374c9e145103b66f395eb6a507c456b097e513c13fe18f830a88fc8cf722faf11b9e41d7d6accff74a1efc7fa54c23da15f4f23acadb8d31ed9e681736e8c323c1678a4b759dcf0a64f553c9bb2675ddb96daf5c0706ef477a82dfbe847751c938ab77ec016966d4faa3de4f3b7db5e5a3baf6f8eed2e0db63f50efea5822a15cd98c03c5690df77e6b8cbdbb0c503f140bac5e9ed7bb539ed9d3bca5effdee61612a0eabeba8e03c5cdfe095a7edbcdebbd535cbe6ee83d7c2d4f869e53d8f3822d95760ae6ff818edb62725ccbf18bc5dac9550b7e5bd232d1adadc411def7a0d8fc9e69f338f09e246c3e04d320f9ea47d715e4df02833ae96d5556ca71c03ea8d6f9c8d83b8f6b9cd6b4b63e514a8c7f628ceb509132fed105fedace6ddeab1f37ecc7756583bf4798dd7cec193c4d7c0d6044f12278e3d5c7af6f8e65a0aece186d91ee6efc3ff7d466cef1cc30aa37f1c8b72e9ecfd6ef40fb80bfcd6e4d8fc4ca1797d8cd9b67ecd29a2631dede6b540ced323673d62039e42f72dce0f89e691d20c7be558da0af1e439d373fb981e16679e2cf0046bd0104b6013e6b6de06478bd73739c7348d3bd3fb7f7fbcf055d42842b71aeb059cc7e454d489633891939f16b07c88ff23a76a55f2ba248ed4f9ff98fbb2ee44b96eeb1f742e4a203e5fb88c46100ba988d2ed3b81448840acb2c55fff8db9d9740a8aa93a639c8b33cefb54a4dbcddaab996bcedc6e9f7c4bda9af0cfeef8854aacaddd2c6744af812d28e62bccf5c81fb2fdc57ddaf90ecc34cba7fdad067ec3d96f4fb6a895fd8af367182989c59e3754eecf7d7c0a905b613e5e8e07d8aaf680f392c1c1e38d2dedf38c35ce4b26cca7239c1b433749dc03cf89338f08e03de098668db42782897ad196f90e8122fb81174ff06fb4de4eebce3238cd279c9bd0badd0a39015af7fd66ccaab371f8391cbc99d20be51b60bdf78359a4ecc0e5f3904647f59c62f7eee61754b1b8ecbab67e6e5b3ba3d6b1b4a4ad32da2c94d7ce18f8547de9a46fddd053cf6a27e9cb8fb7f9cb0f7ba8c07e45aa956b609d9e2daeef2951ef8f221b3ba7d082825effd72aab694cfc9fe12052add3c6894e9ed3e6a327e057d462dfa6f9e8ebbcf89d58c813a2bdc313f0d41fdc5cfb369678275fcb43e453b92296f09ac718f5e388721cd09ab6d1718cbd5ecb9836ef25f842b14179023c594a49ce1957ab975df6c95639ad32ec2acba59e95d1e8300b073d6263af513ea25733824deeaf95d1c930c3c10eb84edf9ed07d4739c66fe830e6e350bedf3dde45d6ff2acffec03e12763dfef7327939749cc7888dcbdee19fe975f97d9ce23d1aed65ae43b958cae2113840bff3bcb5f90e4ddc2f8dcf2934bb1cebb455a16b66897b60d11d3b6235526044b2b3afe401afe1d62bf1727bce321b1bb3faec863dc2d61af59368bc90fa12ec1a3085fd7379ad89fffeed0d9feffaba2c77c9be1d7ae1fddfde50acdcab4977dfec514e44595a778c6152eda57b4e0dd84e2f1904e4b5f2bf3b9c61e56fdbe29d0bed3f9c67f229706211d8990de6bbce85cb3028e191cebf2bf8747f01c3002d1e86433f2f2d8e73c7e0ef320fbeada10f81625590d3f665f3c91f5678e98647161f69593d5e3ed29e7abcbb321e045e020eda08989e9e977a2b62e91bf4872cada7dabba9c381805a12c3c907a5df05be5e68f3a08f65bd82b6c37238000ee3cb159404ef4cec005a1de8cdff2256942a141744791d0efef7b50adf755bff22f6e4e858b3fbf1755caea1bb38c675ff4064e32a766bf4efb16eb2790b9d967c289e893a39b18363f75a76dd2f534231f224b1b23eb92dcefe6fe4fa2af768edd3e1097228ddf61a5719a395f2ea3d94eff363932fcf2be0067468a404c078339ce4ca89295700b840a0e39510bb9df7e34d8e32eee48c07ea37fc309febb1738444cc56e13c6fb58dd93b35f099dde9c3bed7f7cbeedb56fb7a5aca51b8b4677f1be3976bbebc6743febf1917f02e0788a97a6c9d56ee5b7bc77d517bf9766ebfbad65f4262075f88f5fce1d3ef47f2261e1fedc023abdafe063943f4ea81175de58b776dcc87bd8f07d03e9e136bda75bc1fea3faadc3f8f71ae796cb2d8f74c2c2975f8d58ae43d38b0b5c0164247493e1d7c614ab5e5bc04f9ee007ee42ad7bd5150cba26739303883de12dc2996ff45cf02de0ca1bfed0afef95a13b6ea9f05c01af78cd8fcec10c39e8999e31e804b9c444e59c3083c797d5826d383c3474cbf4fa4bd06cb44f3ec5771435e7b97bc4bb7fc84f219ad7a17e693c7f046dde671f4547936726569773e87fc59da6fefbc013684e27695f0aeaf97bf63be16b21c077432a111ccb00c550e3fc65ff30b7869d4f8d421e5bc84be2f72de81974207cecc73e4977956dab34bb5d9e603ce053f2ead9d18ab851119ca087971d4308cbd328a988e5645ef2b7b76e85bd196200f9236bd6f81851818a3e8fc40ececa8e6e0e0f3e0d09ded66c93aef8dc9c7a85567a96aa3f3b1ffee59de664f7046830fd1bb998bcd7f131ddc75dedbd631bf32fb06df8a1d1c6d5e3b7b31f9f0a96ecc53a39f8177b7f953e009fa876369b0311b7fbc6effad40f958fe1475dc96716fac43cbe0493f05ce67b90f968855980dbb656baec7eedf9cb5f5f98b0e6ea181d76e3b7ce43ebaf9395d3078d2fb58ff6974ad2bace1ab37f71dbe8f1f3a63b7d7f7ed17bf41bdf07dac83a3ac81dfee6ff4b826e0b5caf0334d75eb761f34cbb1f0e61eda20adfbecd358cde228999b2f1d6dbbd3fb067f8cac99a2624ae27939463e631791e639c1ef1666fbdf62df3a6d4dae1967b590a5b33fe2e673495ce47ba5d9c7c26f34057df437fe1e79b619b98c33b3f53742a95d7cf93ee628fac5ae6df6cf1acf31879b08d3d56c1d8d55239a15586fca7d75826e775ced8147fe166bf88a433d3bb3be1c7bb2666754b59e5bf45c5e9e8fa81d2ead53117baa451d80f56fd25c6119ef3a82995ed706bad781bd7a1d385c5adeee8d8f8e9361db9855ecdc670357e79dfd403e0dd6ff5be111e0271b92721b6fc81575f0c65ce35a5a98c38164705df7ca43feac3c33354319990b23efc52df59277ae30d92037452cdafffeb994d17fa6f5325cc625077a55afc2ff5a5ada57b68ec0039ee1385d01397bfd5c594b58c785cf4bb11f73f461e8d1bb0c9cc660e20fcb1a12fc2345265b62239639452d75e6c861f84eddd4a7d77881ec7704185a4bdb30bdd13d91cd27ba9ee95ad7f0ced0b9413f5fa5afa1a52761a8647d7a8ca37869cd447b3889d1939b7f9b3d54dae2d2ca1c5c9f2f6de7a15be170613e3ab0fc88ff53caa9c29e9bdbfb723db3e749ec1b19cec2a0f1873973f968ef77e66b5a37e7281a6d0c1918dc6c65e4ef95f9a28ba52ca5c41c1c96b9ee2b7249f3ebd889fd5e5b5a5aea0ab03933e4a02eaeafe7e1e8da2af106d427f6d2c1d1b7274757d0187f4410b963ac39ef620c8a5ef1adcb0377c0f8bbc793c8078f3be2bc71967726c3416decbbf098beb11e3956a75f8167902c50a71f00438afeda7469690b360eb7d7cecdb96cf059aa3e58dd17aa7303491a6c7964306eb4ca3d604faa73b19a99da4c5df72573a834af8924d7129fcc68af6b2ab26f0be6c4d690f73c239e746cedf82e9dbc377e2bdeb5b1c00c0ad93b32feb67de3be0839ce4b45d891b56f693d15385d59824ecdc6a7e320e6ebed6064fc54071d588421978f7ff65ec306fb0c7e276b7270f96d47fb6c348f4ff39e091cdecc78dd0abd50718f7ea3ac1ea06d502f75d1e3688df2f311313ab8e7f7c4d6296f3c3ba773bbde9e2f28bfe56ecd404d45d4c8f6380f306fa4b8163584a72f8b1fedde29a697f5e784a2ebccb9ffec79d77a38ad79c6e0bca479c2d63c41658c2460e79e76cbce1846e7a1b312ef32a3ef3200d66fafa27f4496b6ae2c0a5ec62d71beec6d7343eacb1c7c6a9fa00f82deb60174770ecaa87c77351cbce6e359e019127afeaebdf458f1c5a88f75a0b9de7070249678c419052d2845deed5dc1dbe7fb56190fbe5ce490186e8272ef5ae25985ce14d64ad18769e6313f72b02bdf1ed0ded92b9faa62772af372f63bc5446498f57f9d0e880dc197e38fcdf34d5fb569cf677ed51a796c17bafaf6e4acc6957164da512eaf9d55bef2efe841e7a3bd9a36efe1a575445f6e574d3afed74b73ccd8889dabde1ffbb8e2b7b273ad5cffa8a1203e4c8f055f32eb8fc930a27c94d9adf19af6c254312f99fe3aae2d3416f74b4bbcc5872c3b96b67187e88f5fdfddf3be403510e3f29aa8c027fbb29464e34d7df88d6aeb41d643c26ddce8f1189254c6ac1d0343024798adf44c23bcb36ed0afd96373e70f07f2d2eaaf2b5afad55e2696d33dc24fdc3978177b5af8b4ea70b0776cb221ccff2c3580d0330bfd4c7de321979156f0d0c994ce6d8e3d65f6bee058cfefcdf66cc6911ae6bd4bed3eaa32efeda8cd11f4987dcfad75d1534684fae24dfda117f982856ff909eaf0d959e0848db8b770f5e357285a4bcb140c59ca7cf7f164e370cc7f199f6292147ddc585bd99961053d77beeee41763ddf8432effbeb63e02d9b1fa9fef06f827baf9badae2215f171a4cfd592c3ee5b134c55230ff0c7b1638d8778bcefb86691d6d1599fa87d53e6aba8f5ddbdcfaf27a979ff3a8e13a73e8694e779ea0f761ff27fc89e2ebd14f3f49733c3d411f7fcf4b07d0af4b7d593c16d8f8704039475ab1f0956fb89b8b8d37ba6f99e9fbfc7e7fc964d88fe9d85b18fb7f9eb7fa8dfc8997449e5d7b4ea64da326388b77cfd7f8d4ecef6f822356f9137f2e9e1b79f53aaec3bd2344e82762f966b1f23e8d6750ea9883febfd3338436586f65141c7383f07d3e90815335c6eb5b3e207d8fbb731e0dfabe1cc05edcf1e30a6c6fea5847aceb662c6f2ca5c440fc35015750b73d59dd87c31761ba7ac8ae579e57e03ca8afcdce5bdaa3004e1a3236b7d75afcd5333cc277e10cca6b2c3d7022b07b048ea06ffc989ecfc0f30093016e885bb161b5d6fd076347ac626c5af7ab179b8a634f425750ee9ee5b57b46459d2feb191f4ff37ea3ce735bbe5fbb9fee097a05cfd4718e5be6b4765651eeb35b5a3a0fe8a923eeabe8e6b4d99d3b9880f8f29d700ea9f1246d3c8b805f1bafff37b8f6f27be77ecba72b8b5cd62bc51d7d962783cfe8c7d127edbb2a353af6f0153c41dfbecffd33f8ae5cc15cd7f37ce8db211bec2f9c719573abe0e27b9f9771a83a1c4c4abd51c4201a072dcef779ae7bab458875284fcb70103a16b0c4140f97e37e691ed04d5f90a3eb511c53768656aeef9e07d6f37e03438f9cc44c5443df10de3cb7d46e2cc79ef48bbd46c7855b18bde3e5ef1cd528fe5e706aab463462b14e65ceb02f573b3f8e02d562d72cbe6ed6ec2ed7bd3b6ef2dbeffad6accfa2a53e8371196b47700a77c597b76a74e7fe67fdfc0adfe4d18ae9447d79b11943578bbde70f86ff004e353bc791471528077192e3ea6bdf4bef97f514b17b062cb72cdac5de44af8548ed57d66349c7fbecd1da905f60875af65669676bd7798fd4026adf7163ec7b8ab4899c64d295bba1f708a74fedfe17fb17f126eb97389090d560c08105aca2306158442df0a0df2f4b7d8a431c4f572edf47cf51748915c87d919afd1a2acfc0d12fad2c77ff815e3a21e3b7cb35fee8bd6351c05c7b32b7f7c1cb31a41cb395fbbfe43a819b0c5f616c989eaeecd8fa925893d431b26728abe6f1a9eca5d8b1f5ffe8350d3a1d173ee6d1b1a3bd2bd035737481afa4eb293a3cb8077befb1197ab7b4a162a9a71a9976a45ec59074f411db7214b76ca235e466349e8ccdb56a307b17f565c79eedf48cfb49a6be89ed1fbc78db05d3d176eddddef52bbdc5a631450e9827c8f1838f30aedebf35f68ba39ef7b776adfa7df47e1db101310997b6feddbc175d3bd0bd79b7b48397bcdcf42b8805fcd8a8a35fa13c75c44b1d7ec52422326a2ca8676ec08ff81f89cdb52b4c0225bc1b1b67d7ceebdaa8975c620b39dafbf6aae0f0c0df29be0ebe79c127f6cddedfe21d5ac6bf160f65dfd5100f35f8c32f98a3381b7709f586dfc8e7b66a2fc66644e40978c391c33a2fbbd6dd16d347721111b1f4107820e4733c7e95fb8239e636d7ad46ed2ca2e76655977a3e888965ae1de4e328364ddc2963f38cb8bd35771097cf04f7a9c7afeec623762d9ec8c705b5847e0cfd6c95277157fc28f5c3f9ca3bc4f41d9a62129cfd59ddceeaf7e6d1b4e35e31fa77fd84eb7b77e5f042ae3101fe1dfe17f345bfc065d6211f717dfd2bc380559f4bcf96684fc0850d3f2699a56a442423d2268b5ee627d8d01548d78f3f8fe1aeda6d5b75deebeb5a89eb63062e6d325cff68ec296ed2bdbdf84695afdf6f99682fd93da39b7866bb9b2d8e6bdf2d6fce37f2d4d0620e81f1ec78769fb597477cba2825e095e58d7c6f0fb1ee513b522de9ac5aa39d91c778f397af592c055ebabed2abaf68cfd3bc23f6d65dbe85ca39b8b427e099399379e7b3b0181735d246734eb47473b2981b22c33055b981be8b41bf586321faadbfaa1aca879f0b312edf9de50a636ee38654338a619caf9e5dd9f7b86fe51ed6e65ce735aafccdbc99dbf43bdab672dc5ae308ba26b6e05befbae6a6b3c7fdc5dab91a93d04b5be6a476a696eff6c8b99a8de12900eeb7cdb7736589690b76b1e32d7bbe890b2b3e055e62ee7ec564ebd813ee82bfaf31f7c2de25df936be842f83946b09247698b9b94cfd37a693bab1c4fc4eeb72b786cb963ddae57c6387fdf7b79dcda785ce371719e68efa6a62f866d63d5788605de18be29d592dfd31aa59063834f9b4c57e2fa4c022e508dc981be774c80c1ff7281294e6f72adb4ae17365e59df48a3ff750a3c7efbaffb0b3af7979bbc992e854de45bde21d7aa83bd41ede1115df76a2c823eec657a07f3339cccded1c7c73f85f7ec0d7231742df15b68247ebafc331dcf96bdf7080f3f7f37679473eb72342ff05b91336e5d16e7c7789e6a9b9b6e5c26b531a2ef796f6f28eb0978a9cf88b7bce13d1bc57c57d9a4d810d712d76eda8766d3976f2994bf478f4f813fe4cc79b38f005ce3eba233267ad4b21fef686cc4a7e87dc84dc121c0fcb4ca7765bf296b46dc90e912c9ae6c3ecd7adc4c0d779e2ded788cb732ac9c71e603ebb532bfa849e7799bfc19f86f3feafdf15197085f2a6775a3dfd6e67fe7e3f997f58053f40eed8e8afdfbb8e71fbe9675007c93c37347565ff974ede961298b3bd278669fa27789f1ee77ad813d94fbabdebf1e7f2f23683f4e80a9ceff9eeba4dda81556ef77d7e6fc062fa697d4ebf879bcda601fc26592bd13d61ac1ba2ddeadc947abf517f9f7ec1aabd51c5cbeacf5b039a273e6a19e0e8d85e67363e3f03bd683d365afb6c68bcd1c8cb5fbb7d7026a6337540ea4bc0efc1b889d8f4bde5fff5c3ce7ff0d6cdce6ad2bb72b8b7380d55ff2a8d3f4814b0d481cfdf79e40bbb491934c76c7e6d36cdd95774469b1638d3e18f72e4f42f860be1d844ebbdf7454e4688d9a42a1b1264ffaf650f91f8b7b7ab6e7db67673e68ac3be4cfc09ac239a772c7f02d6cb731be1d0c67f7d77ea73e27e6dbb36f6bebe126db771bb519a93356607a6cb611377bd3e253df1306a963471fa80b2c63f333af8b156331ee358f3f703e9fa780619bffa73c4f320c823b8e503f09deede3b36b49e55ce5cf19333c106ae9716f73c3feb0775cffcb5c7231beed79bb53df072ec03a765ce3ce777cc527d522828aba3abf6af497df875ceca759ff48ce47a8be34dca3d0582dceb4fcfd110b1fea9897463ea3ca3bc356ac7624f1803d827ed167a31fc17ee38f23b68f5bd74db99f62ac6dff8b58e6f62e877a84fa833ea33d816947df8c377ba4fcf6f61892ef4317572036ce86ae1c3123a1658e9b63c3fa33f218111a643d56ebcec6053a45fce9cfd29ead8a5c38ffb49a71da82fd6e8abeb3b618b2f68e37fde96d435e849e1be005a43912bc9b6a15efdc70a668e72e79b937f9b471e35dc61d15e67638f3f1dfd2d58f5f6bf24cc288f26db9c929fd69cf76d9bf4bcfce3caa3e3fbbc7e7f397757ed9fdb2463bb21eedf488fb7f5a7aec4dc7dc33f51da5de1fdc4b8db0ce75ff27ee15524d8035d6fc5bbafef1d33e36eae5aa2f5dc76ff36769eb9e3de490f7c7b8d0b9746c3ff2787197f5d653ffa2e8f7f65291ff296d8fea624df57ad5db319fdf12cf5ccecb9d5cfb653eec91f1fedaffabb1be5c13f02f70e692181892fc5b0a4e8f43364e51a0da1bac4dcae1d2b2dfdaf77fedde0c93d368df693fd9ebc29c76ddfb0ff830b837873820fbdf9c88ff1db6ccad0cdc4c378d3eadef5ae4e026ebefe978477dfa2c05b14edacabb739b9baefcb69b63eb5ad01746af5fc718a73aa6c317fe2f7869f08dc0e1535dbea2a706fc04348fc938d4ea9cd3058f20c3f6212fd5736c137a62bd5bf9f0eab31aceb33c3ebb5e4fd8d3956b597c037b07ae0de48fc487cfec82a3123e15f0fee0e02939735ac6f0d65eaacc63f51e355ff5805eace5c83fc057e88ceb5c3deeafce6c1ff5170efc67c001329fa4f8cdc7bccd8714d1c3f6a5f3e2d997a5f4aedfb1e63694cb189a27c3c1c0e05ef68bb5697c3fbf903d1ffa346fbd7c9c9e7e7f8c249c437b673eb0e91cc8e2510dbf5af1163762dc2c0f41df39485c41eb393770f1ae30e9a12fa0a3dd3bb6ac9b5b7df978971eb12786cbef2237bce91bb6ed61bc6305ab385d0103e45b33fc1bfa92f739af3cb1b42f3716b72eeff7d9fe2dfb2862b3e7800ba5d48ec9f561d0dff1798dd1adaf1b5f369bd64b634cefa3c743160535263d2f123fe83c27fa82d980a9cb4beb9b6bb67686233ecb9edf8887bb8b3d62efde5eaf3c7889ceb9b171ee1863ded762ca62c2066d33c64d2d1d0f596f20f2b7c029527c155d2bbed58fa6391711fc288ef185d26fa4fb29b2a523e55f627ed6d9134e058e4991277de5f514343e97726297df9b8fe7cf796bcc5ffcb6e14caed974d8372fd17fc3275693c19787be56ea7b3efd9e84eb1fde9973ed90f83f63ae4fe7736ceec942fb74ec49aff3bc66316bf94eadf540115a8f7f961dcfdc5f2f8fdb607d2d2ecc5ea4ce4dcd30d691aa1be22fbd392e5d547e3bd16fe7152ef147c12fd488047fb3e4d7ff91f2bb3ad80de89eef3605bf458649bbe458aaf379dda9d5dd88df810366ef19e578d4c638de1a72c5bb817bf5f6d995f709d4e72baf153a9427680adc6b9cdf13fef88c178feff684f6087d4803f47c3e673a7191a77c3e852dfbb71a9be7637e3fd722d35eefbb36b13277b7e6bf4d6bbaf83e35d22483e257f4c9c268d1d32a9ed5b7e7c66eb2584b9a7efbb96dbe6ef9dcb6fc10d6953159e85df15c9fab8ef1c3f37f547fd39a9cc9eb86f2843989b656c2bb1830602387466f56e4196e708c996e1c3d818779c91bff1a7797bf07720dab0e3e6ccb1ea89cc3c5585fe7609b7c225aa3cbc770d89a63c57b1ae037a53d7cddcec0f421cc0edec1d4a3f7b13e7485d5c5bc8847623ded3c5ee4680fca283016c671a583c3151adfa172b3e7cb968ebf95d1c5378c09e205681d7efd2cb97e0b2e66c4e70fd6d76af7bf1b9f76f29b9bf170e668f23133071366172bcfa07365cc227140645da27edff07b7b1afb8172eccae8176c8d67f0cd674f36a37fa83f25e3d99e30889af8d2ea359e09e2d2edbbad6f1cd68b5d5c5be697a8ded43bf4e6e37ee00ec598fa1ff0bff25eec70fda3338e92c50f95776c1f1b5bfb424f7357fe186da1b4d8bd161b56dc3faf5d663d49aa15206e428fe376095e9682d700fd2b59bf35d3c0ca74a386177a32616b0c8ffd785ecae05368e05abdf0c97d1ebfd3f37961d7e9450e0bfc993eb4b179b3c762fa47e7e0bc1c23efbd637e9e48bf57b5f0dcd9ce2b75381beb72d93be53a8866fa0fd7707c79ef0ebe719e8fa0eba6a2e359e424fe726cf2580efd2b3b706dbb3cc7e69b729714764ea579306377c9a5df98bf4a2607f4a529a39cd3bbdb5a9f7ece1e5aeb2e2f6d9df94042cf0df8aad8f9605ba63631b9687c792628631f9c6899be9af5b49ad7340d1af4d562ca43cb38bce9b7e49aa8c7ba860a8d99efe82fe53ee94bf896c5587f2a7e28d5bfb6e75fc85fc55ec8f1e8ffff9049aad25ee875d8b2a6642f1603571e35e0a6abfe667d9c9b7bd1a9ed3e807b7a96e7e3e74af37cb4c7586c4e18df85109d97b6df272917b1396ab489c8cd534ea18e71d7f4f3a5f9bdae7cc1d17f55aea35f71a6b3ed65cfba1b0b79c885d338a0a83da16e9178b1b463735e70ac54faa1778e855e6709fad08c3f36c341ddca83569ed51d8b130d5297f7274cbffa5d4f26d012a1dc51b09db57b34e5b3ebfe46f51db00e3d7b3ca51a58056f69fab279e3bee98fb6ae1931261473dddf55b8b298ae5f6dbe1aec0c7064e25e19496b2fedb876ba685cd5eedb11a71d89b9deca037894c93b345fec21b89f9e0f162f7dda964e39a3097b07c7ca38bfdcd8eca9c88bc4d271397fbea84136ce43279f2e7be77e40c01d1e9bbda633d1b7750ebcdb0674b6bafa2dabfbe761765f9cb7e2f1fe5948387f6ca664ceb13cef7734a32eee116f8e0ddf3b564d7fb0e87c66b5d8c8ee5a0ef9f38a6b3fdad665cad933e3e9da3eb4ee2d5f70984666d3772e38676f1adb959eebf78fa71d73dacedd5ca6756cad09ff21e5f3a02d9e10dad37a0cedf9eed383cffeba83ef110333079ed75f9f0a7769cbde647de347792f2cf01f06fae0b08653d592b6f837d4aa118b11e4bfadfe27597ca55a6d5fe4392192d5c885c117fa4fa059e2c6fdc80ba1e5ab3f7e1fde3ff889f9273bbbb92f689eba32d54c6dfeb6fb7b257105c275c02c17736a824b47de6dfcae98dcba867ee08e075f8ee51ffc50cc9ecd89912b67bc1e5eda940ff7dfccb06fce53768ec8e69e74b5159f6d36b9358f57d16ffc5ab15c35c339b0f74db9b87c0f602173ec44e04e87bdbe33e7e2d99c936d7b2ba8afb3e3877dcaebefc8bbec9bebf4eb1f3f17cf9b8f6154602abe8fef981cc838aaaf175b8f900fa0b97a4e0c5d5eef37acbda7696d4ee978301cd92eff763a3e0dd79ea643a565ac4b3b437891f7ada6dee3c6dcc378c11173decbce6af45375afd15ccc6b9cef9bf25e187f36cf3f0a7c834506aa41fcf7b3b7d55e07e287d488a3a8cfe3e279f39646cfac7ffef75bda848f6dec616a1eaf9bbc7b19c762a1ad97147cc17b8cad0adbd01437f5444b8d4c5591ca75f0cffa04a46d3bbea861fdd131a7751fed023b76b9ff8a35d72314cf29f5c81cf89e68ef4b55bb3ebd8513fac3d6dc0ee395f1d11abba565f07ff91e1bb2f0f18d5457cf11b4f34d9c55c338a85614fdbaf88e96b3afccfbe5ebf8daa769ccf1eb3dd1328d6d77bff1622eafc7cecc7da347d76ed18b91f9dfd9398afbab6c6c6ca13236b7d7708ec9fb5b9fe26fc7159c53b4869df748d5d721fc2062ced3fe0ebce3ae6c9e9b73a8fe9b1999aa4a7b95b9c04ba229d65e7ecfe2b7e3debf3a6fcbf34b127b2e2f6e81ff6af2e38c9e34fb5b1d87c6fc3ba70d8c52df2d70e368977346bb72147ab194becff3bc49c1f1da7705e8c93fe73ce354a76569b1bc12781bf1edc3ba1ef645bebe9cf348178865ee1796f4d475decdb11f11abaf3976332698085ae049f9f9dc3f773faf6af7fe9b7e8104b96237d10e0ebf65b1ae7f76ec9726bfcaa15a496b86b7ea8e3b69defb854ff512be0d473bd53c795ebaabe30046bea3842d3108b75a41ef7761497be419bc065c7f673cddf5b7fd5dcdeb769f51f25ed16160fb2e72c392f3da4b4568fdec4a8e2eaec667a762ece60d368fc7daa7dc8eddfcdde3037bb0b877b10f2f6b9178e702f7ecc9c181ee3deb14a2af16fd19e0d2037f595b4dacb66ec0d32f477cde6f4004ed976393a1634f3c25641ab667ce5362ff7386daae6ca653f3b2ff839ecd418efdcdf9a5108793fc7b508b49d83758e4e0c57e94e739d083f206ce23e61b5cf4468ca10f99f53c9dce3f51b3c1ff25e4d9cc71c96184dcd5ef2a6ef8de3ab2e72debbd18ff8e79a070b627f1d3befc4e91772cf08eb2bc50755f61cef3df1912e7cb4117bc57c399427bbf937c5cca5e145abb6daa738c1d8b0b97d6f3c5fbb79ecd2de354da12f8e9cd98f56358f14bce2aef072e72e7a9f8fc264bf0cdfc62bf49150c10af1de6c64c444cf6f19ad56c092f813bf8a0c8e681842f3b75d17fce6cd80ef32ddaf31dd3742d6a3c2b2759aff4fcba91bf73d62b5adf856edbcf0bbedc4abc9d8f4f430c74a767a639464acaf1ee9c1b4c5c53fc8d3332fff7224641cfdaf87eaf14db6ff4dbd5588f1ad781ad9f9da4738f9e507926ce984770c5f9b3f25cfa1c7aa9fe90a31c0d0d188b2a4f55ee3380cb9c628cc0130b8d598fd70237d72918afaff56fe409b7b44e3daa27fc0dfb6773e20778a93d3eeb8dabcd35e2a8b1d67bb74e51612fd96ff13cc437d07943dc41af5d35dbfddabacbc6e8b17a4e3489a07976f96e744dc8e0246ab3730f6070d977619fa3a7193914dad33c5fff78848bf84e8d32c9d64804bdae76db659b091b870e6bf65bf85bcc394778f36cdff6ef4b3f75ed073efca1eef582a4fc8e97f0e1f1e2fdc81ff6e93dd4d83c378d13b1b88dfff7e354ec13e5b3c487ba7cff93f6bc66be40e45a11e771e217345cdbd6367b9fae7925f4166c7c5bfb80a652634c563e17b5b67bbf39229fb46cebe94f06479b2f7e4bbfe3bbf138de17b69af0e66e699bb47ee3c9c7c3bb9cdfbf215668bdeed490335e1d2fafbdbc7743eeefdc9afb6b8f53322c347b9f56dc2cea8a66c0796937ff77fae935bf47a77ceff607e37a49dea183647111d587e4c494580431ccdee14d4f095bbeb5e6dbe5efdd71cfc6fe931afb4fa59f59d52684b6ebbfe25fa27d16bda5353d102140bd79db8085c15975c8fb4b55de7ff2e56847eccdc18dbf9dffc9e77b4f6c3a2eb48edf6c5bfa3d977ffe5fe04df4a1137570cf1bcc39f73e54eefa15ec5daef181b4d64df14de7775beb11ab57eb0f65fc8997388d9bdc20652c52f07e24f958743823b26f6b586f4d76beac5bb23149dbb098e06f837e74b7fda7bd3ebafffa63624f52f4d7ce78714de0bb41afc3f69e557ef43f340e437e94feff93f7b3ea1f9827af91f7e4c27ebd0d51dbd6377e723afb63f0abad734e27f0c7c197fbe8e03fb1dfce1ef39fd69bc08faf38fb129c012debb639df08aec01be7109d2349e4be89e5ee2d6d7dcbd6c77bf1ac9106bdfe95119b1433341976b1372fa15d7b1fb11863df5a3fb42ee937c9780733656ba2b5de0cdd24031a2fb6067d9c02cbd5296f79bc3bf78df7ef9c2be63479117e37976bf6bccbe7e65c36c2e4d89827e7cd9ec1d35832cafa49bbeddd36feb9069d62f096ee3cf60cd8557fac6d505722e6cd7eda31b1f54556573656ddfa6a078a3154baac61d84a8a957705253f83cb7168f1b5dad620c65db5d937cdfb1b37268df1c25216cf5d6de374317a0083e87fa157f0a2578662692fb97a9d18da5a3ec50f56f41657be30d9f8633d72c3c1c60d2f72e2c301ab25f681dbedb954530df312c559deddb8e4232ffbf6c666e8cad179891894e97942ef07bad8646c42fb2e746531214c0bcba338b5133404ce15fdbca36f4d6e611a6f9e953af042c26c37cb38ee769df4cc6afe19bdfe6e0e6611e34cbac2023db006e9730acc5b055bf510c75cfb3aa5f76fe3841b2f6d7d885ed08eb6b0f748cea5b8375ba3b0cdae25edc990837f15418bcfe58f0d3ca7fac11132bc475ef3a158b1d85be9a84365bda01ac50f438fad1d1f5e7c5f831d79608e22f4501c966995eb6cfda3898fa2c57e735e7cacf12883d745e576ef2ad7a3fd1d848f7a54af2fefa39c3f87f7e6b79abb58da7a455fafc9e647bdf9d80c158ab134cf1de79b7fa86677f90c36ef799ca08c696fe48ada44b9ae0bf240df5e8da3d15efb1b7f14704e948fdbac41ef83fd26ace97dacbbdb82ecbbeef69e47130e395fac57239636ee78daacb316f5d9fc51cd8b4c7f93ce3b7425f36fe9ac574075c2bc0a97612bb7031ff516b214769cfbd334ad3c7ff8d2d38e8ff79cda3cbee914389178bed05f6f19f7c6b36ee38f4e819373e2517db422773b223679f3a073214731b1b4bec39f0adbe2cb4150d5a8461d4b19f91b7fc8a5c41e1c3c1ef8fd49bfe094a8f4ad535b34a67dac5b9cb39e6046aaada7e06eae60ae911f41ff1de70ac0e3037f6d26456f6a66abd6c41e6c5d21cab5b8379e6c6e996e32ead4148baa0e071cd31a6caab5e12c8cdc448bbae692b35a28b484289ea977c9a566714f077bbe3d38b67626b67e56cbb9423ffe41bdc619b27da954fb58a19f183ab6028d64de89a5149cf2efb2b956c1d398e92be2ef3bd838e4cb997eea6e298be9123983440fdc2187df75eb752dd6c3753f560d93585fcfa19198e1fb7c7070c3c19c6132eadf17118e9de52d7e5863ae1067ccd4b19a79dc097fdc1370beca930374f717b487053cdddf8ac30ee4bca1f5379bef0719f7556e03b13f1ee185c9e207c79a1c7c7bb6cfe7e6d6f9416dc7489ba83d6dd4d186f4b461371b727166633c57b35e875ee69b73da3a8e6dfe4afedcc3acecc5a5f897bc9693edefe755311e592f06d5e3f6792925f3b22f83f5b2f596b02796ffc574c7376e621e7c7bbac2fe5ccae2dae5e19f63cd3e3d307fb4ee1a38b1c821178ff8dc29b0536c1f87dce7b2652e8935ea983f9b09dfa8616c6c1efdc9e607299ed59ecf6e8a1ff1adc5ff9f2b5d7ccdc869c729a06e9790796d9e8eb0e3383bd91cd2788af55117580d45d6c157b2758501b3dd853f9ee9808e2707b7b4db63e4a91c7b0a7d8ead8b3e46687b665ad075de029bd46cbf2263bc8c1574ce947179c6a8c301e508c835839d2c0756e43b9451d453c61ae78d07e098807671e4caceaee263853edec93a9dddf4e21c64dcb22c5efb5464b2f112ada7da83c04bf43ed63a7c37e0e015b9e8e1c45e1b2d0d7d3311a638070ebed5a6a37f9703815bce075b62911e6a94b674dad37cdef3cf0d3da36533500aacd4d37f0ff019b7d916b69eaecf8d36dcd09237fb3e2fa6a4cc5d1d5419fec3e94cac535cf6b69ed8bd1fb7c16c8fec51a36fc8af42c779dbbde63813eed6d2a2c1c1e7cd145c85f057a1f5c670ec5efdbdb23c5f169f8807c29fa2bce73de73fc97c0670f44c0f9843d8c525748ecfc7cee732ed85bcf435628c71cb39dce40b036bc5b39ce0b09fb8bc98004fc9722057ef9aaf95d2f79c8c29bea89769d1741b6b25ed585b087ec522f6f4962c36097d0e3422f8e0c3cbf9173ae736eafd3b9577a6f8b59ccf21e314fe5a2d682e68b0c87341593f2aea47996e8bf2fa5c70dbe735f507e2daaa4fb6851f99e92435d4b4aaf33f9cd05c9312769a537a5f92dd976252d4b81f156ba37c9f50e74f072796b66a674ed00efd78ec9e4d9a29799cd2b4a6918fc11e21c53b7d9fcb8de5bbb72e3f819fcc78b01b6dc5f6dd1e7c515f2a99761c83877851c7ee383a2e658c4f1f9c5cf077b2352083db503f2b631fcfff52c6d1c19f0f3e1d8be66e8051d9d37fb3159c8da132ceb5c9f4566ed42a275563bf0d5ffbde064cac76f684e3f3d2e292fc7a4f987c322ed33f843ff53d39a0efefa5ed78acea3737e4911ae68dfa6649edba56bfba731d53e86e6bfc838bb8f3bc49ea9a8cb518ead090cf685d838417b7d02468e5d4e1c501e599e99aebfe545af6de751f35b1b890587a6f2947e75fb17f702cf437cebec971e31f66ecfae27dc6bdd63162bfbd8e435aed95b9736273edcbc126af61234f4ec6e6a73f9e1e082f06f45d8722d5ee7f1ff6b3f5de741ef5b400fad61d63ad567da0e65c9df8ea58da17297de68d1b723b6271070f3839deecff4a48e08ecd48196b3d5798442acfde07b51c2bda22e6472ce45ae62ed39281feb0fee5d859ece40926e3b134588ef752ab6a90fa567f0f3f17797fd43168fe03f91b99c3b3504fd87ff36c7c27bcf8997d63634ef090d706b2ff6f2e807bf0595c99cf47cdbe5eda05363fdd6c42cd961f72ed9d5c1fb2395f278e1c4b5fbfe71c93d897231a87ae1fe0806cdb670797dfb138fa621c63fde87122cb3374cf93125e5c67efdbddbf6ec0e51f94911e1059efd3de836c8fad6689b97165e858bc7ca9e176f3808f72772e882cadc9789dcd85903fd33c97ffdbd839c5f31be7e967e71aeaa7d1321f559f43fc6974c7051dd4b50fadcfaf053fa17a966cbeb657bf4b8a7889daac9fc3a7df77e228b1530d82f70fcb24ebe16f59c7746f795dc768f1d2658cd83d3b8e5324262e076e55fd6c24e6be718ce86fc49e072d4d4e3c7b31b30555fb037bca8b1f2e6aa3dfe3a7631caffaf9861f7720c2b4e3fefedbfc8c98dcd5bb6de036c1751efc0bdeaf63099b6bd5f89e3c9f76113767399342e37e3e10107302934f7dc6917fe89aefceb0d31a8d1790ebf6adf5ca95cdd8978ee15b74f2c067e4a587e1eff9003dbbff4f1bf7563abe231d58056eaea667b45e516e98c5a6095b5779279adb087d5bef6579a15a7e03758a8d93a27f45dc93f980fdaeef29e160435e394f492691273fef904b716363e709da973d545644967ace9cd64affe07be898f75ec296f92efd16d83461da9dcb25de45ef36b89972be0bffa00e07c0206debf924738d7fa773de62236a3111ed8d14e91ae9caa7c57ccdc44ba99e78932da17908f0a318bcb9f7f8aeb1de947f28cea1f9036335b3f405b1fae7dc4fcae2163f75acdeca831dc97ad60e2eef6fc9bc92eb2de2a0e20ca9e022e0a70e982ec8202063d85f7de3b15e2f2f2dfa1a115ff51c7b92105bcf711819f682dae1b2b6c3ea4c816fe91b8ab99d3fb5c73357df767d5e37dad0b57f7079ffa3b8ce643d25d535367c096d4eccf32f1f8ea57fa0ee99d73b2acfc0d8d77e77d306d53064f5f302eb958ebfadf5dd582ffccb224755f3cdf07b699dfd4d42bcb87605e43fcd0c534ee3469a5fa85ff339d911197c39fd3da9e3ccf11d0141ff50ac81af033cd21c38e51d1ebeeeaeb8a665fdddf54fe8b7cdfbb1178a0119b31a203d43263df5f61ed93bd6a98fdafbdf9fbd37fcc548fcf06d7de7cc1be7f883f0a3e675c2fbc11dcda23b7bb31fb863e41bb5f3050e2aeb4bacfe7d5cf020e435e1ec9c470e9bf6edc00683e3795ad5d6c66f2847546b8ee2f314b8f6cbaef62cd6a740b575169c6b0f95c4b6d83c5a9539e1769e12fabe12b6fa380df3f8d05edd12a3726d4f0cab3a5117f39438469e63ba392717dc48ec3973ee92fb92e2b7916324bcf4ec53bda868ff2157bf25cab1e277f603f39bb27967b9dcea7dbaee8181e4c601ad6790e1209a513c12cd8b763c4346c7ef9c21b5b5219bfb25c68037d78a6c7eba3cb723f6e4b372be6c7dcbdb2d9137e3919b5aad88d5a73a131eb3fdae65069e80fc7da67fe1f20ecdb14133d04dc02b2a7d5ef4bb57fa8c69fd3b72ca6f2fd7fb2d3efdae6bb19ee76ddeabe1dd9a7ae2d883ad6ff91b3799ee75e3764c7ee173e4eba4b627f3b380f2d0cb278ef0d19ef6ab08266c36d5322296f64cac526f10b1d155afcc4dee95acee0b3fb162b7e93ea8be8bcaa3a6e873591d0e5c97a72dc9b1bb7c657cf9cd59e5cbf5a25ad5f57273cd1fbd21d63a309fc6dfd6323a63b1cae757f8785ae6acd11fb107476564028bb06ff249dafcc5ac26dabfb40f47d480b37bdd1a2b70b34e7ade5fc7efcd710ff30b5f97d03a1eb318c87a5a41ef1e790ff0cefbb1c172e25767d4a7179b0172118a9cc5f8c0c3bac3c1677e3f02dec539eacf66aadab40ebc5d5ab02d11d591702dc6951a2acdb1c2f53b36c40d8d7bf4c3e6d9b526d9788219b872f45f86d7ec0f9c44c3d9f9e94b9308794e2f36d392eb4d7b7505f45c8bb9cf5779b7cc9f2002d9bcc73e07cc8ccd3ddfdcff6deb82c8264fac137054a9975eae0fedebbeff94f117ff6dfeb509dfc0dee53fc47cbea57f5470d96187dc6dfdfd0a9c25f2ad063d0f585d1e18859d3736737e78b6beccf303784b6bc9f01bf627fce5a79322e76ba6430e3db783c0fdcb224786ddf635c5b6f2d9f85fd852f6ccca1aa8ac6197d723451aa4c4d67b4b8b74d6e0fcd532770d76aaa1bfa7717f1cbdd8ecf9e36846f9a86efbb96d3ecf27bee7f22cf186852f04be9075c67965f67c8acdbfb59e51a39bad72aef62ee3a21d1faa295c3f87adcd16fb76f6edc1111a325eda80d9a15a8b64833a7e5e577078093177a48ccda297eedd12392f440f01722699bef715df6d45d3ba5d237017e3dc2af9d8d9b85bd271696bcf3906e267c1e57ed7a6e63a92b37943fdb8d16f8fc0b7c36aa99de3619a93a463efd8838d5762c8f73f91c38e71bfd9dd3dc7722ff43eac87281b8f9b36920bbccedc9d53aec5c7b8e93f2f2ce9e8a5b97f0cecef60e7d88363dda61919164a06a76f965f01879d2b9fa84d040714e1c193735c3996992e2d69ab8c075faea01f8830093c39e8abc301b4e423f47241f7d64b8fabe9d9391effbc5c9dd79e2ca1a72200c7b33246ef998fde9518b532f02bf9e3e95619831be1b8a3bdb1955892e2006386c348a65b96bb495dc1444e073c767bc6df4c7b67fcf12400068d7e2ff24da8b50bb3ad226b81171e574e1c7dbe035bc2fa7f1c2b4a55705a84790fcd94dec34d34bad7584f45075f9fceedb5bdbb958b41cd3ec67534066476aac03e1ce8b872e058c1be3644ac57f8de93c4b9d4cc7bce7f5bd8bf524301f37f868fb79ce7fdcadaceb15f2eeb420363b45ef94df9daf0f97075ff39e338b6a7397ee0f2598ff535d37a30c6625ae0a7daf1b5f9988bd803bba5bde95af7151ed235697a4e037f1a7c4e7f38e01d7bb2c97414698f17b5b19437d7cef3261af22d5faee021af12bdcf4b9e940e3ee71b7d372bfaaf730eb2580be2a1bcf61bf90aabfcfe224fc8d7ffad4bbe3af353caeb1ef0552857ba015d11ab2b4fa172aebc0fece9f93bf694d6344ced48acd27724b1b45b5aa77e66c3d097753a03fbeaf151e2c6b4ae7f50e42072c72fd57cc10abe358b33f638d7738d06afa8f13f75c66958434e73f9d98ebe9fcdde8f9e8177709f55db94e975d1b155f36fa4f758ed5bf4739acfd7267b98f5352d3cd98cbafab177e310217b4755a0f73e67f716772e3fbbbd76ecaebc49b3d337d748bf581be08fb6d1a33110329eaf59ad8f94f21e55fa361d3bef73c9f5a84a4c7c8e392dc674ac1f9471864745ccaac2260fb373cc97a5237a488047865d815fb894a533f4315067732d11dc4b11d629ad1dda384b330d0d7a56c247444c8367c85c007cb5274b7dc6ff9df33a0ddc584f5d3e5a537f80ef4738f799fe2acd97c1b7746cfd73299be71b7960864993d2a535daa1978260ac2c3d7c5fa0c637d8a8790ed8148f8ebd0e1f8e336e9dbbc92078379bcecae72f8b1fedded16396ae0ff8fedcb6b5ac8b865824c3aee0da47ec9bcbfa6d3b9c61dff20b0df9c4957e21f82c23acd13db175015ac91dce1ffa8e0d38b88b33033e41b33f03cd203abe51ef77d733231bcb1377690bf2fc4669872adf0a3b1c822fba5b9e4afb5c37fb292df579361e0bc44eaa11edf3bdeff1e6a74ffb20284720b8408afa5a657f67f54af8ce6313baacd41740cf8c17ebc01fc377404f19facc339f16f96aeafb4efaf53d0b5e9d1ef541e8d9839c763810c00d9cfd2d4a2bf5d6c0e1b5830f7ecc967dd9d813c778b80b9f0c580ebe0ffc1b6a80f8c68f651d5fd8e5dab3c38bc7bab60bc5056ca07deb5a66661f62e9d3e1450ef15d15575ffa20cf0797c758afea9870f094c8d2e3f75835fb867f6d6feeec89f27ce01a6a3cf4fa3bdfe09f97e0b0a0f3310b5bec43abadba9dfba3bc54e034e9ab02e6fe69b7b49e70068577f660efbafef928eeaec9af6078b36b1c6173dfb890fdbe69dc94b0434cc2ae7fc0961b8ee5ad4adedd8e36e855f98e0dba78566edff580a4831a276e697f5ebecc11b7f879830fdab34dd4e2b7ef2c46269674a6feeed83c026743e6e8ef92ceb075ac6f8fe6def2be2b2f3629ef3861ffaec824752c3fc76b94351c68328d819fed1f7c6081a82d94125677eba30fa38a09812d2cfd18f83e2d79abfa7959e47b7c594adc7cac8a1e7831ab6d514eb3536633ce4fcf2a1f65bc968bda33a86d53e6bd9d021ea435b4335e366fbdb6f900f7e953db7e6c3a632fae7f38ceeb3996ff517c23e3c969c191f41ccbbb592bc8d79d973e3ca6892fb7d932f6aeb61e103eef239f14f6900858bbdfc81d306e879aed12aacf506eed599bf9ea7f1b2734cd697eefeb5ad1cd3343fb2296f60779aebbb68be24d919768f96dcbb9d6e66fe5f77ac0e6d9c875547b073af8b1e974f5780d08ef6c0b0576bd6b5da6567b25423b26afdb59dfb2067973af26e040888ec4d237ce9cf2c01d325d6891ddcffc002fbbcd15dfd09edf9268fed6283405ba8debe9d7cb77c7b5d482e89c1bbb7cc7613036b919d32597f6d7fabf94c36d55e57e2efd8f0ca3c17c401a97e4b50cd792fe505f193c4c889dd3c160be969ceb3e89a7f0ce7c525efc8aeec5ddbef9eb9ecb666e329b13135f28b0790d755abf67f3146fc213833b90b1c97a559b7181b817b8b316d4971ea4e0aaa2bdc637ef1f9de7f43cedcfbc98e22b7aef26eb4db9759d90f1237bbdd3015c51a419f7843ab6e0cba78d077d91bc4fa8adeed2ce5b2cf8af9b63f17eb773920d7eebcb5d0c09d6b36a17737cd376619d79c220e88e6d9a1e6be3f2ea7d2b263779315d0adad101a7cf10dc5fe06098c0ef1a2b92c6a1a74465ba1eb40e229f22a6bddf5ab7562bf8257088155cdbd9f5e040480b9e28688f8d2f714cfabe696d2fccd5f519051b646b47d70e707e6f3b9c5355ff03fa9bdbeb338afa492deba9291e00167b77f664e9b32b0e86714155afcbed608e81bdb6c9c2242031d9f8f29a72ed753ce34edfe8374858edabb7b426873b98cfaabf913a1689a17d9bf11436d450eb7833ada2e3b99a99da4c5df725f3bbfc8097e303fe01708dce1fea3faae79242b11c8baef8325a472ac7c2e139da3b4264f3e8cae6536b4d09f5c958ff45e3956e73cb7f636ec75e0cec79ffd3b7b59ecb73858fde321e65ce14b542aa89cf65f718f5299fe8c76ba92947ff5dcefe5d9d0fea5a31e353edbf7f852fcf2c3ff3c78dc56305e3b851c29618bc324677f9bd3a69e4b79e0f97bcf6d56f7be09c60f98decbdf798db5bf3ef778cd5dbb04b555fc38b25bea1d7f1dff1710a93c05f639f67fa78def77909daced784d83ac69d6fed63c63bc452cfe9de5fd96fd9338de7249eedd87a50e6354a4c0cea68b4bf44201b92f983892b8b2162adf7f98073e3e8e45bc60a1c08be2ca55eda922b182acf95fd933daf8c63f3ef3bdcda13ec375db18095efbab9f75be7a5bcbe352e4b1d76f6eba837dad3bf9d9f6e7d64c00444c7f063e86db231dd249827d714052f869f6ea68dfab0855e518ff21228c931c9f51f094ff3a21f4e2caedfaff3c59c3b1cb03cefdd77889796b925e3167dc334bf4f867102cec21e4e9e2729d3fe4cfca38afd9de888514a5c349b8fa5adf7e973ece9013a226a5bfea2b276085d3b51ef9dea2a71e7bb363582afa3cfc07f57cfab5cd4ed4351f0865cd1efa40a053efc70f54c7e73ae726b52dd9a5711b1720d63d2a81dd2f52ca66b31a2fead97b6af59e8c72912095cf9046cc4ebc2ecbc6e5bc63ae7567a09ab3a552aaf9f3186c82f4283c07e150f4ebca1be3579ed85ed36dd447dc6828d21f6ba1be7f25a3a667df503c3e5b94fc79e75eaf5d647d33d30efa8219138cafd84434d8329de9c330d26d6ab022d9cb9d2ec3bdfc99b0187e526f9d8f7f7ee509c313cd4c1c83919c0b1d8cbf780fe91e729b31cb048e7ab695e97967e86afd3d1afe21ec2510a930d19a30fc1b8d015809f758593b47ccbd879a54e19c3434df31ec1ea39cb7818320e01869feb217672280773c6597075aed4d64bb4f67871d788d7a9fbe278afab7a68671e80a85f79d680bfbb6f5b725fadb1125fcee10d5f0079ae3de9456f1de7f9347d699ee75b1ca944987cd0fe9d48dcb4e428122ff1f7841339d4256fe5b7eb7b5b1430c773e3f94e9e96c6bc940314f692e4dfcd47873c96556bbe98982c2d11f1f16f8ffb860dadea7d549ec5f00a4d769472f6ba7c1fbdd09df75c655c56caab973ee2a7f99679f64b4c0bf3d52611e532b681a59c318c88b1a2bccdf886145c7d3ab8ef2ff30f07b761cd2f2dd490b8c812b4d449bfc4095fffce09d7bb382bd8f7da7acf8b23f49137ada77c7e53077f1b4e360ef5fdeaf7c6bcdad249f85939d3d9faaffcf7406cf1e3aa317aedbe0de77dfd1c8fa310cf2682197982ce38e3299ec2b3c7de9fc7d64ef51e460b7f5ef0ba180e464b0b3c75d25619994ff0d99696b97fa0efafe52cbee645585a93ddafec5c3a2e818d33c50c736b1d3be3e0e6c644534d3f723e95dd42163f596eab31a73ab7f527d52834d32232e48c25fad7041d7da95d6dc564b6ee5dd704eee78c8bfab42af84768f377b2bdd9f9fce5f0a71eb1b42f4ff0586d0bbdf53ae708fa59e5f5a7fcbdcb337fa2e8e644eab8ffcfdaacfb993b1f99737d5ee3c72c31e6193e7bebf24c8b201cac11ab6498c8680fde89a5a5738e753a979cd0dc11daa7b77a2ab3675ee7b46a35ca62dc2857f86f86eddf64be9274cc724801722045bdd1e38d43f5bc447f6353ec7c6fae2e7114d9735ab14aca5c22daff429d0cba2611b5b1e7cdd7724c76ddf9a01a3593e87baae7e7ef72f9576c5fa098ebdef5d97ab16f8c51306bd04413b4b9d22536a6dfdc96e3994b2fab191f044bb3a8bd75cbf7b4f2af75e6d7528c21879ec10de2cabc4efa21696ffa7c0d2ecada1abca781fb316fd923d2cb03b5cae0787b9cc41d72eafe7050a99d76b1ff7fdf1b3b97061fc5f32531ffdbffc9715a1aa7aefe6e3aad3e6ff8d2d3560fd8dcfc590c6b486b374386e129f260e87de53877ac6fa025a48cfde01d3553f979c5f65302cc0ee5ce97a133616c15993b4093d11594a2b7c6b79e5639d72a78e788c51d7cd9dcbecf9f3a9fcb552c80b20e66aa154d1729f77f782e75c3183e77c52e9c7ecd1e993b76ef6170f0e22859a2bf5d46ff0078e9cdb5320a0c537a394cc3a7e3cfdc7f06061bf1e77812f9e073b5c0f947fb0dc043a898923e53e47ee48f9113da663a0c32fae38f142fca34b829ff0dea8cae3c5b997cb466588d8b73d67c72f8138777c7f72b9136d3a575e7b9b6869cbc443c1d8b4fb48f600cbcfd4bb87c55ee9f0b99ed4e1bf2280d363ecbe753dff655411cd45b8ecd90ea7facbe2ed6c94401bf9ecb6bff1b7a3de5bdebfb91e2751ddb446d07fecf27cb4717798536cd1ed69b1eb871ff809c04b0ff73593a2f7973eb0e07393733e3346fcf657bafb4ee0ffe0aa6c3fef2eccc4b8def622fc0afa4fe511078d032974ff089285e65098e3f59fc5ca6a2a77cf68b6f7d1b8ac15b3828f6684d5f78713c78affda63d5c1dcb8bf8260077e1c1e1b73b1f7e3bcb6975dacbb95f6c6b7fd03b90e525c4affc5d9b6ab873601dd38e677ed5de0c5f4e1d6a3e8a639d0e7773b8d71a86ddf33ceb2070e5e755d56efeadee158d31787adf3de5e86adc47e0d150f6d7d8c3361fc068ab2354fdc1c045ec150223d850ab6ef2a9d641a0464432226db2e865b6d5a6bd4aebf51d9fbdfa1dcdb1a12cd1fae723f76fb16d576b3cfb4ed425b0bed197b0fed1b5ffa1981fdc23de9c0bdda3b099f36d8e331c3891584abdae6b7dd1e6df36618fc109a46f893509bca4e029ace9e1419fc149f0f7757e1641138ff173182b2f36398fcff2414cfb3c017f14a13951a9815ba792c7bfe48bfaecfbbe6c3ed1184f58ed96b5efe7ca7bce95f675587e4fd71a9e823e2e57364387f9b35778af8bfb9a6b736ef4a2b7454d8b58efeef35ce420ebdfc9d65632418fc0c6b58ccd5bbafaf12be4aab9e01fe0ab47fc81b5e75ad1fee7e22ff292af6c5d16dfa8fd6eafed0401ad595a4fff8c6b7e2e4ba82b056ed8dd7694efd09ee7b9cc2738b0bfb1b457c34d039ef91e1f5df1ccd63c407e7e75f45152edd8e11cb2b53fc43a6dd5e4ff53f76ded892addd63f685d2cc1b87673d9d88218b52328a73b0e89a28521f188bffe7b46512028a59874effd7c17eff376af16a8e3ac59738e3946139f8a62e9a86ecad91738a05e7f3d6c157faf9b53dc7d1b73f98ea7abfb393ab6b7199727c5f37aebd1d6572570a5f3f62f6b47c375406320939db31eec43e088a3ce0e71e59b38c6abbd67661c86116ae0c7fb20367f646d5cd5f0ea37c52ca15dfaad3d441cfbe7457f1ee65eab8e9b5d7377aab777988bc3a363147405d89ecfa6671df60d7daec27bc71f0f5f3deeb5de60d1b8c6a6810f87bde8a772f7013c47f64c6b700fcf71e917c0e786de45fae0f86c28cec5d6b836c5b5077bafdd345eef3c92ebc9df9d9ffb8ca748cece58e01f0c797189d57956e485638e9292f652a6af2b9a38fb50878a583270145b9c4b6e2a53fd84d052d6d7be403d3ed55416b3abbeb1fd1ec4d26768082bdcc93ceb690b3fceb3dc736d261b433606f5ebfb66ecd44d689eb63bc8f27e91103ea7b2e85aa110f075e6ce7bb168e39d3c71f52c882696b0788da51dab1398e6faa0fcda1933cdee89b4dee63d9b4b7311f4e53c1efe504e82ad052e66d650cda7a6f7adf13478c40725afea8f7c0d527de4acc64c20d09ff252f950c13e665849d8208e5f596d4b13cd27c42261b35f55ea43257efae35f1bf30f2cd2b2a3b9f662ffd2fd1171f656c50ebfaa3feec6c5bfab9d9ccd057c4259fc868611d7dff1e8fb797712b2085107adf61adaa43f10433e7f731edee3c428f6bf2cf1ce05cf52da8eadbf3b353928bead2edab0cd7e93c54479180a43256b4ffd9b1c8b8bc41517051f626ec3e9df91bb4f815b1aa48e3de868fd30f56cbde552fec527f071a7eec53d6f98c7bb2c7d95c5b5a0f76842bb8b69f6e57da15cdc8bcb5a4e8cc965fd0eaf365d5b1e4f611cb2fdf5f403b14ae7d4f995dd29c1b9827bf17c5e1dc3123f7d54cb67c2db93e5716aec077856f8ee880be801f2ee8495f7167bee8fdd09cb7d7f6accb150b6e9e53e7063e5748c8fe84b6b783d160fc7ccabfb2c7cf7f1fefe046d6f3af6efbe8ad8085ddf1bbc23ec0d3679ecf76a1ee2c506ef86ee3eb4607d70675a21c36235f4df22aa19775e5fc666ff3c95de4bedafbd0bd4d9da46f32396fa46fb0a1bf2a3ce2fc6ef80c7597adfb621a5bb597f2c32fce4c7cbf2487dc39755a6438f5835e387d1d03ee04c1c71538a53be27f7fa7db9068a35789d17b8f4a3cffd1536bba1d9fa37fbae8eb10f9f09f6ff6af74cbe3efecc9697f616c30e94b4aae8bff781191a7fd6f9e49eb525d3f6a0eeece2ed17f1f7cf07724c2af97467529ae568724ef7f132d3111f24be4a5aa8e70f5113c7e62cc7300f23be1df444f305bc24b5b1b16bfbf3e95aac0d654e388bec86a2720a44b315d0da6ee9dd137f6cafda67b2f8289babbbf7e60c1bf78eb1f5d9d8f271718bc45f270b68618431e314efa17ea2e11e310e4d6a7fce631767dfb3c5f3f76c71bc6dc0033eca624ee6e68bb532ef9ed5f9cfb1f02ddeb9513396593c8fb6d9117f70ef961ef06bed41e76fe449733c31adf36853ff6c0e1d3be05c5f0dc66bd343fe449966ebbf8aa5cefd9d9a76444371819ad57db05ebd4ffa87fdb351f681e1174bbe6308ffd19c0ad614eb27e2a5cfddceb9a6d292766fdd4e517b9a619f572cde7acfd66b1167dd70fdea738e13eb5a325c0b9808681e931d7cae52bfead6fcde1595dd2367f4687eff8cf6c570e13f96b7a1ed606731674d966d4975ef6931eb8749edfbf9cca5b69df836f8ba9ad977d4ac9c9f4f577b4f95d68ef5d4f8ac7e74fe42e8f7f7a1c54675c7df434b03f632ff2e7c9cdadc3574afa87641bf69cc6f566acfcff9f8a471cef4da7b01f4a5c0e9720858bdb11f81171aba29f0e73badb2cef73092077e3cde848887001f023cf6da6578bfc33c141568aa2701e55dcd30af1493c6f8617c4b493d31e7d9a69c03a71b6750a96dd7b6b3b2e7bb8316b8b1dc8c6be9fd3c865f8b6f94e6806b0ffdb6be08d6b3bf5d130ceedd45b036df0afe9b5b7ba9922791a07d2334ac099e18cacfafe6a6815b484356b7c3f1a16bf609cd3fe4fd5bfaf688e61e3863fdf140ade1e191b8267b771e533a8516d605e5713d6bb5a4720b5c1cf97d36688fb1c6e9fd38e3700b17f87f7098bb297df6185acac6b381b522d0afa2585856a32f7a561607756393b8ea60e1881b70c181db78ed758b6f9d426bfcaea9ca099ca20eb483c0b1db8526167c276947b99345f85d64e7b60b8e64f03389ae4d6eeead504d168d7986f0fbde117150e04c0b3f40abbc9ffd4ea5bffb851ab42096c04d93d6fe365e7c64d85c33f511cb17670fe4e734f07b653539d466b3fe14f59b8f61acd91ae0eef5d01a4c03556acac3721acd9bfb3e46df8c666d791f167c0fc22288b784719d50bb19880af8422adc298c1bfeddb58ee0fd2e63a9e759ed03c5708a9e0d7f4f4a83f4000cd10e7e49d8953788a7d1ff9671446cfcf618711b013e05f01097f54daedaa9b34f334b995c9fdbf007fa6614a05f25be07685f368cb99fef9fddde7668c8d0b14cc32edd5b72d0263b2795cff312716c6031b60dfd1522bd07ed7a1daea23fb779191ad7719fdbf680af5eaaeb38b7879b27131c022ef3495557a8d91ae6d668fdffbc86cbbe6cbe766af087177106eacfefbcfee84a2b09ebb9a245946a77ce3ce61b52cdba85e050aef9494d5d4779fca4f339d4aeabb35c086e637df459fb91b331b4c23d97cfe95c1399f5512ddf479beb936aebeb9c0babdfbec62e8ae82bcdbb9c02d5398e8a5803f79cc3d8d4c4121bdfa9b331e896ee8c8fe095b9f7ed70eff5cd35376f321b8cb51ec517c09ea47f62dfdead63ba88e938a2407d16f0fc518c667fdc100743fdbac4895b6c5d97ee0f95752d9c7cf128307d07d43753adb6b03fe0ac73d29d1a720f6dcaea559afa830e0fdfbb0cd8bca09ee677242d7ec724f2a709ed37ab157e7345e0d2c2459583a076bfa03e6cae03c36232aeee1efc42b3edd8abc2bfc4dd07f837df3637a1bada22dee9c71de277e5356ad34251e9f038e529d63ba658ee936b8d05ec31aad58173bd3fd8fbdd43a6c1618505d713e31d2fb8f7a17fea8888b3b0fa00438e43ebb8d1a07d11878462cd5561efc464e31a07ca633feccab84b6d3caba8edbaccdb334d3d175c5af3e79e32318cd5a68405d8faed4102dc33dde794a39ca0aead85ba04adbb984e5bbd64d895bba39556b2e7e87fb80fe2edc61795d5953dbf8d4def3ad6f833a0d87761061e199c97c19ad50697d77ef6fb3ec5575887ad89b1588f178c736b6bcc1ad71397d7674ddcf4ce996e0db62cde53c49d82542aad476d5b5a8f1cbb416b0afe262723f6f67fb4ed8a74ca73af4de7a55a1f42ba5353a2350d675e44c4e42a75670f619defdb399e4d2691cf38e44a3ecabe9cbf45fc9ade1d8abf736c37701984d5b652bdad428fb051cc79fce05cd036b665e03a882d9eb519ed76c625a7453cdf1a383625e341691f99df3361fc29ef888dd133f8ad3f4e9d7425d95d6df70cec5b24b5c175029fcc35a41ff83eea405143198ae3d33337bf0a1b7e370e4e73cd5a54875fd1e8fb87df3a8342aa0d4cd7da7ab43ff35f99c59ef3d57a3d446346a67f613f6d4b7c6cff310d25f0f3bf79568772a730bdc0b77c1d6ad1bdb85e968f877faaf5aa1a879a92db64f3a4751703c31c95383bcd27f86c81480a8c36b3e1e0983ef9a2db0a450578805c7391e16c2a9c5f05777d9e9b476c4453c2c48ff57dd81ecda7645268a95cfa854d711e36d6e1aafcdd56f2d2228359fae7ec87618eeee2734c701a643cda45fbeec51ece770092046959f7bad49615f9adaf726e85667683af4b5dba13cf3aeab4e021b8bafbd4f29b7a96b2b545c4b2eaefc6413bd98679dd41531c24e9b0380c6ab691534fb2783e7cfa68f5af67c036490bdf3e8acf7618f96dca35b9721f8bed247eec16dc07670eb9ea389bbda63a314ea93f3fe7e3e9e8ee783b6b3298127d50531f74e6ad077fc89ac0874a0a5ecc7b6756160b885e2decf5152f763598f59afac91aa72f3734cd63727048684f5570daca0938b6ead68767cb912984a340344f342756e270e1ac95f3fd7079c45afd645c6d49a00eded89fb11e96417b14f1f6efaca7ddd9bfd5fd73cdcb87f52174668232d238f6bc3e5e4a2693585a0471bdaf89f1b0db6e811b2ebd0f739604fdf134d3e6ecac1fda537152fc7d2892432034dc2b22d9e5b5a65847be6deec06bc6cfa9a33e5fa1e7c1373933cbbe41f1ce7b3199cabacd30addb57ebfcfc998f87b3a6effae13a716c7a1e73f795ab421f692004eda6ba64012f2fb174ed41cbb0c7a7d0627e31d153f084e2de712ff6867698593bf23be6aae07151cf1a1e1c6d9abddf37ebf07717f3d269056b68dd5dfb6ef7ec6f699cf878614bdfcfb27ad7a9a74a873fb6a662b2424cd0578f27bcb7e97967cc9481dd523e1c7b9e9d776cfe2b7ba051dfa5136a04b8783a4b40fd2bd5ad7f20572f34c0d30d7c4b1018f7d22378baf273b5b6abd2e675e153fd999a49d249cedf37a1a30cbf60eb591dfc39791193d3a07bcff767f8895859bad660ef8b9b4abdf850245bdf36b37cb1217c04b5583ac238309af95de353ef819c7bfeee22ee1d85f618fc4ec0499d28dff71a388f718be5f3a083360f90ff503b24b8c2b2977c3b0bf1f371abc6d7a85fe7ecf7b3585979b679d2816de1c4473c7ba08431302f8f9c47148b873a68c45252c7d293d01e6d298666ad3f969b17f371a3cfd4612706be2de36eb368b88f8ee379837dc4de5933a637ce246d6fb7c8ce119543d036378e29196ec145a8d5af95bb679200ce7cd1316ef61f988cd35fc6240cf0fe59eecbd5fb30576de16316eaf8c1a856f729006eee9424f9bb1c51a8d6d495ef35f933adbf8d7720dba03f20417b7c0bef70b10fb016c0b331dbe33ee789f49e9d22aff3480d18bb33e6dfbf859928c63fd3091d35f4511ee17063e3cd34397f9fdb7500962f1024b42fbab1afcaedbbe3a7ff8c66bdc5e48b399ccb6f01bf4d713bcfd31fef5a09abfc489c80374797e3c2adf9b03a4be83387d6b1a9bd3a8d260fe464cbef675c254c3762ebc40ad58547ccce57c932cf15b8aab9f16cf47f55d62ecd34c3a94e28ad1b617cdb2cef00ffae2baf5ceb982036f56a9cb52569eea2e0289ad5d67d20bee4b44dd49c405bbca7abd254530568bdac59bb36ec4cc4d934774405b9839d9b1e6eb57fa3a1c6127d8c15d4142e4b1c7531f4277cc49323f974ae9d7b28b7f04befd58c3367ad54d67d3c86eeb7e0abbdeb357f9bf324293fcbbb97307c1ef5cfbeab1b74670c2adf1a9a555fb0e1589c9fbf3e5f6b7cbb2cb758798eeb5b03abbb7807c6bca91f379a348ed92296495ed545e2c4a4f51bb82fd57cf2541279f684cbd1034c318bb52f3495f28d279a926b31e84faf91700afbe60a1c9ddcb3ab3a77d77611f5a1f0b7c44535ee0f6ee9f37870ecfc0336b5d4ff476d243bc7ca63c63fc762dc577f7cf7fce2c7b056826cb4945fb396393df37434884b556a454960f7479f98df49cb34a6d1cfe4e554ccb16497fa8edf38a27ea271745af73026418cfb4d67e975b1b6697ff76fb0712a59faa9708ebbd3df4ba9db3e2266b083efe088f3bd677556d857cfe6217aa171f1716f96429f307fc77c4eb1cfe02ee06318eaefd0abfc1dd73e15ff0cccda33b44b7917fe39087eff66fbf3fb7c8c584f1dccc1f08fd6c2e67649ef20474f39d9334e2df68ed2bec078c6c8cd488d3934f83c3d65ffb713634df8ad64da38ce913fc3628ca5b9657bcfc5dadcff817b3ebf7e386f8398d7b669cf17cfd376d23bb2d87924e6787a2007873c8140fbb1a66bf6ad98c75f97ff763f2677d55e8effe55ae025385ce0e26568a8d2bc1ff3574a9a4172ea5ad00ca66d79f7ec9ff35c8333ab47f9f93e1106e367433ebddae3966bb59ad5bd2f8f89bf9685b0d0d4003fe10c76eb6a5c8af92687889d5d1f61ac6c42fa7bed9de58e13bbabe538aec0eefef8a76ead94c61177e61f9ed53984f6247f4e74aca3e04e8540431d37da1591408bbed646e4a65fc84dff3ee4f82917ef9f3c8229d8b96b93dad82c47c9c36a925dd096a143f180162997eba538a3ac034fbf764050fff7d6838eed6a6e589d53a04247e248c2aeacf816b880815bd089d66d6d5fa2fbf6c7abbca3e6fe5d6b9b25e4b84f018b295d6b0ed271d9dcc915e5fbef7a8c105b57e9d99af8ebf10178a35c2bb5ec3f5fcefdbdb86ef95d377224bba00d1fb5316e5c28b581ce6ff5efa3b4faf7d9e5df9f38eba116abe6afc788819630bbe3bd7fb6f373e0d242153a7439d7ac097f2d459ffc583f697db20fedb2467d6eab102b011f6d1577000c99bf1e437fe3a4f5155a1b84ff1ee01e272a87103c03c85f00cb06fee17ef1ce08b128708dbb96c9726232e6b385fa24bfbfba110fed9c682cb93d691eff2ec6a5f99ad32229f5c5edfefc3dfd21ce30af786e426bc46afd60d6dfbf1ddbcbc715dfb1c4e3dea5797f337c3c8647fbb40b5516c363efbd1dc363cffcf59a25d8157de1aff5afd42cb1786ca97f69270debefa02dd73acc27392f468f69525b99deada99a4b4fedecc163f3800f5c6a23f4e39d076276c05f1efecb793aec5c239bb6477f0babedb98b7705e6d3efca327092613c2bf4cb9936fc0cba053e6a80c4b0a37517f2d4ccf5817b94b738e80f12d44bd078536c6efd3634e3ab63c4b0a088ffa4e0380edaa80b36b71a6253917c803df1c50eb8a496be281ca8dec77a94f312e46dfc3553ccd357b146d93c82277761d8ca21d17a4ccbd790c715ad7d45278eb8d8f9d8376d70c2bd57385b39f72ece9eaaec8b07d6d143f734bc979be3a663b71e139df25d36d6c73c3ea42941c7b67372673ab84b5b858e8c0a1e8f60cef0793b564b92fbb579edc829440cb00dde1379f68a5c8daaac8023c69e7245336567880d9e802c6e3e9a3b31e667b4d314fddd3dfbca73077c6ed00867dff6db21ecc6298b63d29cdc277bdf16be1178968085f34581ce7f9042834ada6aaa043d1c70a32dddbc7e5d758963eb0262c3e049f5445aff021e8d15d3ef2ddea35578f0325eef80d6e5cac8cd9ecfd378721e9f2ee2cdd0520b1946fb29e2f8b357f35a735fac9e6f313046c0059a2bf0bf86aad93877775e679d936be573ccf7839117419fbe5befc05d6b36cebf568e95409d228d83308c25ec887179c79a9d63e197354b17b9db4c334c530724abfdbe757793b1aede8358125c713e877648204aa8254a30cec3aebc75edf1c9b53a6bd7d6ce77467b019b072cfdf29a23efc266647dbdbeaf707d1fac69f8b38ff83ec0e9e51a2be7f9838f0d5fc6b1e5e42daf1512aabf71449dc5b0a4ed50ac3e1b2e7bc9dd3b56253f7aeeef2d7b96f54f96c145e8884712348cc18cbfb8ce26f600f562b47e93ad395adf4b6d8aca78748cc31cf14fd4be05e07ac799d79705273e2681389f83fb31400d46d1e6276e8cf98af3fdc61895c77c681d133fde32ede6edb936672994d6b050d8dbec8ebef9c7146773ca1fae4ac81315effbd2ba7c708e8722301164e9abe6893bdfebf1bbdffef94dfee112665e2527af2ba4be78a3ee346b270f7354b37fc869680d127f7d0456143ce7da54f899348d3160cc0371b10f6ec41780dd405ea1d938cc0e5fb93b4c89ab4c57e4a5612c9de353bac48fc9adbac322a67cc1fd4871e7beaaa4ae38db06e278010cbbab9235eaf0fd2e3d3ff7389ffc36fcce516ecbcb7a565437836aacf4474c07b2ea6b417fbff4ce45d01e13caa967a1d6ea09f557a75025adf07c9e94bfdb61fe03f2d184d64175994ed685df9bfbb937f63ab051156def3765dc09da3af10d4e3ea20b5bcdf472cd43e4c612eab353c4ea9ea7d275bd25b0f4f17815b4510b939df9a5bc03fc5bc46f45d7421de3eadf37b55867db730d8dc9f425c21f8e68a67e56a3ff4e6d4fb62613b473087da9aef60ffe3c69e953adbbfad7c8df7d3aec9d69077dfa08970cdbffc55c066b5ff3dcab58d7a7193f5f257612a7e199329ace39f6e66c4be0eb79d603f83dfa7dd8814eec59412d96cb60ef2ce2a45fe621ac19b3cc5e27b41d62797f726d137488d0de53539f6f7c78e42c465f11db62e7aff534f7db32f123a1d056a1ff4eb10093b9d31e2cdcb58ef8679c73550ebb723215461bada7ec5ce405ac491e9b820dfb0c8159559543d01f71e2ed3837d8f94ae3d8f9194d63d39fdeb98d357c44e34bcde7f27a2ec6aee69ce1c52fd8dc20f6c69d933488a5c8319aad632e8eaeec97305def4b3edd3c1f0c9be2d983d417078bdc27a4ffbfd678f79824580ff64edc7bdc8f60dfc962b79d28886ae3b7821323e6d96b78663abcdafaf37cc5c53b6bec0f3b0b6bc6f7fc9c92dfbbf6b956eed02e61b8c5f1de8fddd3504cf83ae477c7c68c5cabb3ba81337c1e11ca9146fe1c4efb1d71e53d7413efe1c08c58dafbc034ddb7851fa375d64ed31c8c6ec62fef8dc972c06a1d718e05b56332b18e5168b51aae950738afe344f6d581e0b3b808ad776b8fb6257cd63c50c17ff3637ebe7f576af5a81d73da03c41f28ef108da9f5cd03d52ec8e22a6bc43ee8bdd21ee59a54896f51ec20bb8fb889ab9afb20e5c615f231683087571aa9cd795356cac6599bdbe7ae7ccedf3f1257ab9b6b7ace278b6c9c3b3bd79eec5deba9c626245dbf3d3e69bd0b0c9b52c2183439f7bf8463a8d5e97c9ec4d289eac044ad3f51f3c8de27cb17f5fe793cf47a6ef9e31961ac983ed78783f78a0ab87ae95d8a3bb6a89dfef6385ee8daddd1e2bf83732b7cece18cf15eb1b69aa219c15f1d1a5fc6929c6d60a5ff77731ff7e6a9595c2ea6b684fab2f97cc186d4d937dd9cccf5ec5e7b086272fa6e2dfbad313febf4d3be7eb8f664eb56bf5dbfb7cb77f09539d37a268d4720cf1088b3667ec2196ff9e1da3a61f155f88959cc211ea4b5354c64349f9438c01b9c8b69035d92e7592aff7a908ffc79d6cab8c06f9f79657f03f9f971c14ffe7afab9199d06d2e3d8f4e47497439c8c15479412bfe11e1f4f9de667e52a99ead678ef594f797cb78dda199c8d94a7c43840836ce95a347773706c52c4ed7146be5a921044f2da17a55590cafb2a3e38e3b10a336e90d8b535dc153a415fdf03bf1cc4e6ce69af22ee3cb6cca52f925d536c96b71e93a9c56abf9bc66209e533a5fb3b4857ffbda43f9013ca348be2049c31b8af173e24fdb7ee8fbbf34bb10f62fefc13bf8e8fe8234df913f1b61b35c7ab643655687cec36ceb812277967d8223dc30e890576e8d9ec1da7464f9abcfdaae0353fc24c17f3e0f57f26dc38dfb9bfcdcf45665b42f5508927a036043951b76f7e86ea6cefadf5cdd0a6635917db7c76ac45e21872cf6a8a6d2e8f2f34880f1cdb53bab3e5fb4b53c90af164e4cc116f9d202f61ebf239df64ae0c68acb1781e77bc62e5ddb3949ed5b08eb46457169e4a168c1b67f5daad597bb1b2f12ce9f44d6c44f93c5ef82ad9d7711d37c719e4efe0d9e0db6b04b920bf3de2e5339e1ddb5d7c573f2ebf47d6d920838c9599301e4c5b9d81de7d38969bb58fe5fb1df1b808daa33c8fb5d15429a2f8caae006d64e00f1073e1eb41190c8744df77c58fb500fec0c1dd2536db9a4aef8c190e81e54b29a7968a3bb4b973687fa95ed5013e8f476b41f9f71af4e381f8ca07fd3deffcc33e31326d75e0c7fe728dc6b5ef9ae1a18133c459016ce61fb93770c6e8ae06eeed7d2f21e7be61f9e23dc3a7e4dad77b8f8e25c55d93d7aef011b47f6e47d39130fe150837ee19651e79601f63bfede26e3d435ece1349eb4ffa6f157dd41ec5c489f04d4ce418d46dd2182b19e91f9e5d5f9b3b5013bca7b8ffe6bec030da2403a5f97ce6f69edd31f03d9c9ddb576b5470a36bd11dfb95c54d0e9e4a80fb89aabab512de591b3fc9c689f11bf5c023679e1acec39fdb1bf01fff1cff586d9ffee41ea1ebdf66b18798bd3fa6cf57b876b9e3ac0033f27f33ceb3ee9f1de7ff23db436dbd6bc15f1b1f90d3424dd31ddbb3f2c55143ff78c2f18f6bef3ef9bb2f700626ce5b96cb2ceab8681c909d454b6099eab4e9718f32147d3ca5b990c1d6b127796c908e39f843b29a7660a0747289af2b6aa59687c5d068cdf5c23f62bc616a311ef3a1d1fae786df2836d55fcbee2ae80be5935f00e3c1fc463a578138dbffee4a2b1f3c726d86d76b43cf4062bceb521bf54b7e3b441c3d6fdf8ef9c535e7b9f9f9da5cc757181f1ad7a47cb8d6b6e3abc7d459e67f3e4477f35d797b72bf0b58b754801e5d0a3ecfdc87d7fad46ecf2b3eeb15a6f2303fd73301a3a2153895d798f955f43de68937f795f5dc1d14b680ce7ff5cec5e60fb960e9dcf79cd3dd3c44acb6e49fbc8f2fd5bbebb9c6a177a54ff5ccc66f3e8da5b6d66d719ecbea22b45e42bc36d9f85d79aacf7eccc181abcdebe7ad6c87d8376ae2348deb2a8b763ef7dd3f1ddfcefad41f447cdfd4dc7922b9d659e5c5661eb34fc48fb7c9ad3cac639bc019e5b14c66bfaa7a84cc666598cabebc09ad90bed3599b89df6776ae875caa9ef86b731fdaa3b971c189fbdc1b0f9e0df910da03f8ffd778aadbe7d9b36b753e81f3c0fad6beaa3159be67b7e5851fc94b5f554ec109b9d17cacc66f38a7edaef64f9d1629ce2a2f9f33ea9f91fd5038442fa5b1a6cf2b879dd6bdd34eaeedcdd7c4fd98f4156f27272f978fdfa44572cedd5abff6dcb781e0e7b1fce881759ff9a2c5f728ff4f5ddc2a365b197eaae1ba9fdf8f9d202fe0aa447c53959367e9e4d99013f717e62e21619fc675b6ce1a9ccb7ae2c761a02df9f74fd7d61fe3afcafbd32b713672d621cf07f28a31e99cdc7a5bd10afbabff3d5dd7ec7becce3a2f63803eb0ffbee33f826befd5f8c2fae6d9da2cc6471cf1082e3f56fb2c219fd0aae56d8e4914c48dfd70b1d446d47d099cb1bd85d5fb80de6b9e8fe58d51bd7fc1da7ae68c5d9ef98de5336e34ca71ef543b80f198ca2c8f2e47a14536b833301ccd04b1f1b02becdd48983ad69168ddc5c417464919eff740fd8633641cecae35d94ed6ab6adb1bac09363ed739a338f9f2783e10374c9cf5800469b3dccd5064bf7fec8e9eb7991b5ff42c61e5a7dfe3ceb8779666df10f2bab3659eef28bd036d7186b324f12d7d3f34c37bdca1e7186ecc9eb9b69b15ee552f265b37d3f3a6799186e3976143e8b35cddc467cf1e2cdcb8a926ff75cd27676f97e3d449102bb16b348c23c5f9ef6f8e61beefd9998bb80dfa613e54d7e8aad232483becd9d98d31d24f9ed16c9d8da7da233e6712f6c745cd99bb1e10d7c8f27897be67a6c74050b3d3820fe91bb2e88b64e91a3fdfcd581accbaab4d8e81714469e7c6d02ca9f00a15358e9a2a80076b81bb04f46f2a588a35c315f769ed11d56bad702d71fd30d20ac4554d9ea96a0b7c9544432bebf7d012886b4f0a0ddc86ebfac383bf61f0f82b92246cbe9e3b9cf57bdb76cf9281b99a6d4d73accc22a1c83d0c7f5efd6e3ae5ff1bdab9d5e3e33e4c85817be777b3d83c8616384eb9bf7da2e31acfa0d991a2a667684367433eb8168bcb72ec7ee55cb15df2402e21b731a25be152e1dddfa4c889cd74821c4bc3fd349aae38fba9bcf6caef7dbc2eb7d60ead92bd130f182f0bd70e35c785b136d2fc523e36153b73d75ffbf0c541e4da83c8b1c7b7c638c31f36f3d58ecdb56792bd2f0a1bc47d7c604ed45e74df17636d6960df4a5c6ddb4acd6a3faf19408c4f83de7f047df48c638de94917cf3e744f466d1d6a3b5bc319726ed2aaea8bb1b61b25adfeb456abbf7e2e598c15efc25deb45d59390e478ea09e249ed20064ecf4cedf620d0d68732ff161bcb5ceb62837b45ec5ae6ea0d5c73b6bcf7c175da1f254371bcf74de9c74b1f5c0e47c2b8c7cf77f5b59cf8ea01bcedff833176a1cf83dabce9bb30ea6abc333b9bebfb5a188df752f6be5abbf551d488b2338267a71ef04f59fb7bdfab712e743d067bb6ae25b4d56ee85f306c403e96bcf34af06d99f2303fa019c5b189b53e46f9fd79ec7de9ab92e076e5327e0cfa8d9b90c5a0321f60bc05ee565307e097d9d17acf5b359d6b738bfba4af1e4973edcecbfef3e73fef5b8d1d834d04e6e39461c24aed806f418e41986ef69576d3f694db2b40432a7b5e35c1972130cd989dad1cdbcf050ff395ade4ce7ff5fdbc5a864dfa5dcdf2725c077162d811adc7f69c897cd0f6a42987c838513b339f514ddbc9dc38697cecd0e99e3641c946debf7f0e4704fb93ec5cc134729f8873a672c7d38536f349e3d5360d0d733c858d0862b2bcb2f9dfd1246991e94c2cb4df6ac6a5becf5353fa356d49436366bee93d693c9991df5f8c11667e96484e41a91d9cfbcad0b192851337d60ce1f4ff06c68d489f5e3c782bf4326efb48a57365bbf06225f28ca6b621fffdd7d68b1f9b6d4f543e1c6b9070fd2551d9067d68c9355e2fa56fe3efa3c7c72fdebe513d8b1c2fc1b177b53e55d1de9c8782fa031b6081cbdaa29aea12d7d223ca0951e66cebebefc893d29aedfea85c2b4a314ec15a4e3de4ec5379e351de89a74d955ba9a82d5b386df09f506d28c4da908f3a6b3bf4f53df4a187b60c4c67eb465dc6d0b184c3ab756cacf763b494f14c21239dee2f613c6d314ed7f29ec49a1224aa33ecd5f3077ee6dfbdbd27abf75747741163289e3df3036cdf9cb5d90a5569f7382e79bbf06db9e3abdc1804c648741aae512ea6bd3ae6a2f3c03ef419968f3b4ecdf9aefd496b1bb2fe341cf38c87c1b709c65d744cc41149aa458d6ce727eb6b124475fb1ffacf8786b6b2f734fcf9edbc417dec97b5a37807cf86c65be25bcac6693f52eb727e86b7be5053e7a7b269345d63cb4770ef5b026d354d310779ac8be2367ecd72ee988a3ea7bb1e2c7ccbcc38bab39c3f8bc797b53c0b8e23d8a4b9073bd6a51a04d4ce39b8e318727136e4f8f82a2777878b0ba8ea3a25a8edfe64f9cd0ca3bd1eb7603b8254faa463976bfd5ac2de07460b7e672c247e44eb6d76d02364f880fd4ba4d5cf6d699ceee285e244871ecfab71df5e7ac050a83fea6d64f66f7fb3d69afa2ea16ad2fa3d5667bd676dda86d6aac627de321da01ff3bfcc27f76b6a8e27a87576ed41ea5afaafd03a669aaf8ff2c989c0f292a57f4ab01ec0a9b2b7a10f91de98eb521f9b9e7d188fe1eaa2beefcfd8e561de6e8df2db15710fc33636735b39bc530c833a9917f110e1183c1b0fc4442eecfab0351ecf04a643d00a49a80c48cea341f78c3d7e7fed6a777cbd521c329f036eddf896046d3d75adc3ff5a5ed82e7db3896d67bfadc9eb55ef5e538b2c8358a275b45a4f99ceba9ddfba39b9eb7354f067a577300c1a627242904a8ba00d9d8409eab853d790e21035d794fb435ff8fdd13ed7faa4f6cf1eb782d8dcd49e2b36615a044dc67bc4dbc375f8fdfcdde798068be7014befab046bb2a39d3191f3e7deb8378d2ef9381b7373bf7aa2d90127d4d032a1d9b4738dc9fd395d6dc9eb0cdfbd8e4bdff109c9ab355ef9dcb8c136716265d7d4271c2d57a5f6e1ef33cef97d6ddb5c0bb5117ae2b41927ccafe4d3b3800d691003164984782e972f47a5189039ee43a1a56c3cbba8155d39f6f8ddc9740a196eca04370c6cebc9b594d411e7e0746a9d353ccc13726041db445dcb29c7533922ea6b0789af92163d3f55e002f57dd095f76e84ba8d4afc99692700032aed689bafbf0bae6c0171e9207a9a9bf06180f11295dd99334b8e1c5b83ce07b43d598dfa1876a685dabc403dee330e59c4d9e4043c7dacbe31fbad8a78d4688ef667fc1dd03d3f2e0268a0f7ddbddf37b7ae3d627c3f4acb319ac7be3d71b01b5ab4c68cda282f9fa3e870fd3bfb925bbd84d332f42fc5c35ffa7a022ee7977c0d4f0f7b7012f9ea8c8bb1cddb58a3c37181a3eeec5d75767dffb2071b6663d9be19ed5d609fd4c1deb107ab869acdd49729f603ce99b4a2855eec8bbafd0accbb678d9bf3ea2f67f76353b043e0ae7b84573f7fa6fe5e3cf4ac0e812ff30778f52f781af33118212ebf0d9717f8faf4da6ff6d6e3ba357667aef27861aeab979d6d880b821f2714cd8ddf958a7e1a645457f3817f3f5dfb4ebcb3cbf98aaff034b4288eb2e518c232e30068184b8bf3e7eee66828b7e82ce316bd7bfe64fe41f16eded9b371ecc1ce31e45f1e6a9e444968ac45f8abb76912aba83f4b60ff3a0bad377e77adf1a7df3659bd5d0ff61bfe1101663c50950ee31cade2bd32bdfb2138d8fcb837f780ed42ae027caefd8c8b65d01ecda91f8c3b807afecec53df13c0fc558348ca510e933fbbe521dbb2fc437999f907f1f73b5671c84bb335f681d7710daec26af8df9f646e9237e99af2aa2679739f210db201b702707f19630aedc329685e6767da6fbdd38df241e17614c3a7eda70ec8b7e4bd3d0ca78429db85e47d42bde7d736e2eed5bfefe1cabd56cbf6578cccf7cdcf89cfddb9d2fb2f5789d67e1de8b793a23e578a01f4b6c2d023f5583a1a81bcf78fb97b457e859fb59b4494df87c0da2d418ebf0bb5b99e3943b2e8fd68b91cea72ff656f9195299b3cb71167b77f36ad3585ab946131c1b1b2751f9e073a56df70ef020bd32d7dd976af2f9f57517f94786419879b0cdfd222e44ef6967ee14397a35e4a12f2a1bc7906de038f472dc8fc620b836b7a64f356bf60e56a1319e8074eeb5b3dee7e5c76369fb8756a5fd1c7cc076efc4648cfbc7f7f10165db2901cb817b1d72f5f7c66eec65791ee8cbcc27e678325c7514b37bdfd7f444a1efdafa14b6bc14ab2a3d87766db754077486fa6316a7e4eca5bb36541c80170c1cfc29e3cf6bd5c7fda5f7a677e7f1f467e3bbb2274aefce34f9f4c5c5455ebc362ebef7d41eb7dea8541704ee8111f0d00e621a058feacf7763769c3e5fdefdf2fd58b57fd18b4a76411f3e53c12f42db69430f40243b5ba47549e4b5af075a249ff58dc089ddc77ddf6cd95d6d1ef6c981ea1ec58bc445ac8540d7adbc16a86653a9764edb3b6298500ee108f66ab128ea5c6eac513a960df07c2eee764df2f1ad85fc8762a5afd9fa2963c3be7be631cc087daf406b1642ab9384fd15c53bd4d605e1b756a7f9f9c7b1dfb738363cf68d86b9fe1adce0760f3fdb29dbba53b63f2ee3470f9fbb2bf66e4356c1c9e1da2cce57c27b3d302795f3257befe3674a9d7d2ae615b8c6d2d9c1d5931391537afa7b9a92f196e65fecf68000271ea0feffe6bec8633755bba82d8f59be4c3d2efc3800a691da024d3d73387b6961cfa85e8063e34eb0d9faaab90bd20c87fe6a8f2febcb8a9a575b399e9e5bdb17a365fed67aba3221665f6f91df7aaa71fa5d39e3308e77fcad8b3e11599b55ce35f8dd42672628236d5ebf7f9ae312b371bf5bebc839fbf2f383adbd4f5fdc9273ae310176eddf32d6f1ad2bd17a26c70ac9dd9aca1bbe0ada3c8c0592f7ffbc3606435f3ca2b627617e50a3f37434f9437ef76a0bbdf2288857bc3ddd1c9f0d6e156b907ac6a3be1c789dc01b496a6348be28ec5df58fe4312bf6c9b7a4959fcad087fa9bdae438e7a025c372820f8e4de193610ca8de3a177fe08b52e419df5c3f57f790cef02512c2e7d676ef8b8304fbce3184ff6eeebd886b4bf64e5c53dfd27c2cf33e3e368622f281ca76dcedac5c838e215beb25db80f5200ef66c3d7cf3dcb8cdf19a8d83b4c77a1e9adfbefb57d7f4b90f77d77493fc67d55e6afbbced5ad464dcf3f6f0efd521ea106c57f080abffeeda2dc708da7a1288440cadeddeafe39cbec8a37ba2d4f2ec7112aecd4f5fec10e07f913f074719d5f46a541357cea357df378cdda476cfb61163fd2eceb6fe5e14d23c2ed3626b9bd81759ae6b3d5884d6045afa2d685cd26710c7a5dc6ca64863b7f86ffd33ef78ce9f097e48df12a021f4408c5112fc3a4dd5fa3bc6a763747ec13ff4d7fa54ef6d38b1c51eece0c6871e69831af8ca3aae9e1511e5055049fc4a3171d9b8d1b98fcdd6504ccab5c4e13d3f95c5fee93b6ec420c1119fe22ee8daa3b95ecaa1353cdf5a0f70cc7c86d6001acf049a9dbfe3cab7ebefbcd0e9a57f069e0639d49ff04b2bef29b719dc15dcb96f9ba8b742ae8c043531e61b63586e274f3373a49bf22cc3ae7ec75e5fc7207cd53c84aab41b5a54db72e3da720b7de2d89bbc1d7fd38f28faca39076edaa34a7fb871b2e38bd9d0068fa7f3076c11decbb0d63d3d414ed1a15a877a5e1354a7bf760afb03e25802cd6704a92c002f033c41866728f3dceba963116847adf93926b4e1fa2e5ab10b672de18ddf36a1fb93046d3dcf437cdc3df34a583dd406e1fe06fc372fee3e5595b5cfdad760cf8b5f8cb58f26b3f08587ef7eed0a1bc70af7f97e2dd93a89b7d6d9ef6bced4db63e258c2e2353609fcfc216c6e9bce61dd3a5c04b12236c798f59ebe805b44ce6f11c6e6c6063f507b9cdc1b831adb5abce3777c6ef3bd1cec545552c776895fe832bba96bcd3657b81e05f768e06fb57925e6c15fe3791bee62dfaeed50ed593ccafb7733b6c1df0bc5f8dcd803c8b51e1cbb69adcde4f8401e7584b86fb09617afb9fde9532ec5c4c9b439a99d61f1a898e29afaf2e235c3e8e51827d4f0335dc10e09bb4f7383ea80e92b8f61a3c03d1eaa0b92c76c3c6b34f72ce885ea09beaff565cce73b6a8e31c7599ef7302ff51dbaa4271ff86a609ada23ca530e7c157cac57a3541382da55aaed3999076d13da62e0b82de906524e73aa75e6b747731f5c68ccb7c37768bed8d6b8be9a7f6ed303f734701b98293003bf31cf71073af7fb60992c51fbe8a07ea43dfacf173b6f14ab4e248cc59b638d97f01d31c7f0617d71108736c5771153359fc22e6fbd5df8eed97a03d7e41ef6de8fa1732fa5be1da2667777ee13812da798cc676573184e5734be6989bdedebaab77dc53d379236b47e5fd806763fd8dac616cf7c0475bc4351a33dc0ce83f3b8c2efab8b454f11bb8da1cfdfec0c1e9d668f9cc1c4b1282730cd9564ebf5c2def4aabcb9595c48495f736c81857cd79120e7e601bfa91e3b9acaf42073dd62e013fa23eefa3af7b1617e7c75d8e92a74f2949d239afd20262b668b4a7b3ffbdd4c954e1368c9313d7adeef8a7bf53d9c3ee27d22d633e504d939e2ecbf51c335e99ffbf93fa3eee1345a0b8fdc2137aea5c4e08ef6d6dc1ac551be771af80e8dea5ec1dfe409597dbdd61f101f5c66798d86b800cfc81e1c6d8170886eccefa90efb50e7e763bfd2f789e3d30d9f9484ddf0049d8f4c8b61d2f09c08b8fa96ae3d6819f6f884da8f7c6f5c7ee3fe196eb68073d2adce325893437875962b42a84a5bd832b77b607854badfe6d0480c5512677c311927a2a74ae040ecdc38dba906e5f97b8fdca58ee0a3237e3c4e5f6db995e54ceaeea5c704b98ce667f123f72912238eff3a4d369ee582d7a363b7e57db086c6a6d614eb37ca9f1d9ac5b3d8c3794cf2049e87af714d94d7306b6be3dabef2b8f1fd47eefc6431de625cf8be1289c3ee777527ebe72604c66449e7a6d51cdb3fdf666d12ac7c8c99deedee3b631f76efe60ca70ec59234b3a5e813c67e88d845b7765cd75e5f1f0327ae5b9d35ee9b4ded2acfc6dcba77f8a6b4f7636845ce6eae97b26d062781d63b26ae883ca2b483adcaea27e45f7e5bee0431597bfdc99cf5657b2b56e159e17bd8438d9dacf87198d4c647ff60dca2e67bccf654e6e1ce1c86c893eefda89386f57b037318f9ed7943db35eb34e7edc9c6dd89a527bb2dbffb6d7d6f8b217c7512acc95bc19ffc2b61ed1cec5f15e9e0a952cb17a45c77afa34575f9e05c3774336775924c37145cc89d4023ad4fffdcb707b543cfedaec1b55fe02ecfbf45ec7b68cb9bd01e6dbdb522a006e879fae34b9a51be4a6b9bde5d75b1f04485e18928deffc3cdf0fee731fba5d5c6f8e8bff7c707d7423d89f90bb984ef61b84a63dfed6d87861cd8ca766af614c3548e816dfc5c3bf171ef88dbd350a4b91c8c398d310dd29fdbe1f4cede626dada9b3b818f3627d338de931be93ed01553ae4dc29d4ff8b6a39754eae2531de587a077ff7dbe0a2fb59f3cccdfce2a6dc6eaf4f63ebdb615b4e7d51179cb67e1a8a650ed07adfc18fb753df52d2e6f797fb18bb29f549c75b689d35adcd982ae337838c0b6df2e1cf4b1fa2f3391305f0b7d4c6c46c5188ec36c6ca7c73dbe63d2ecaea9c920e3bd729c779114fc43703facd229e88bcfbce6b677db3d3339ee1255dd5ecf3e45497136cb607cfdfb9e15bec3cd15c798d31330fdd3d133fde12b7477614cfce7811fdf660e3c2ef56113b0b49d09e502e7a376638a918f18ebcf610752f41231ec5525faee36017f7b4612a9d31f434ff43d7317155c234d0c14b411ee2a3f04bdfe7ddddb01e101ff22319bca8d4f66a8abe70d3867b879f0b04c6fb6a4ff8b1c4d6e964fe02ae002bf483f660f9bc6cad5d75b4732cfd54ee7bb64ec2f0da7fc1582b2dcae3b3a6f9ab8bf592ff6f700ae24b2e32e6fb157751ba068b76be195f98cffab8e5db790e3a6fb4b6cf843d1db48041b4c5228650ffed8b3512889d45001eea18f5e27a695d1c597c49380d2de0f38e1dd47c05d0df66739ae7909bae1f7a1f10cf79d5eb39c19d72f0e922f61807db33df58e784750acd2caf3fd996f50cce7d1b8c3c5b113c6b3287ae69431ff74b7907e415c2f4d17aac6312d866e2e22e52c37d76f7fe44fba6235ecedf77341f939c1af65de0c64dca673e7b67e3f309f1acc7ce940df2485843f0cdf2f5f43c953e827472778c984d3addaa0199aaa4e59accc76a3636dcda8fba3c09ee65415b069f123007efbfe323ced753a09ac45524f83c6f793dbc16dd3f4f42d59cd2678bb8cb2271c5f93610c70b5f5522cf3a02f74bf5b259cc9f04d153d474fd16fa49d9f88fd0fe196dffa4498d4af9f7356710c717134b63d2876ecdfcc63acec773329f88cad2539bf2f56a27cebda7367f52ea47fe9d026bef58c7cdd022bba105ec8e9cdddb5465e98894a724af413ff87d730d5d0f5a7faee02cd7c117727a449303f6a4d4e7ad53f4f967d4603eea9ebd7be7354529751539851d6e1aeb479d6890d5896698f376a15f027fafe5daec6e608d178eb820f57900d2f25422f846435f60f23826bdf886e902275560381bc422e6d02961f549059e946afdf4b07e170934f239f38075fbeed9e6b6c656566bd0af30c41c5bda2333a347cce917f9edb2facfa2dd37f69b4b3485d6b776be191baadccfc3d89c2257f55a8793af8f354e0df0cee5fba4ee7e63bb6486fcdb7a75734eef9fa72e7857f09e9dbf264bce5a15dd9902aee6a69a8a02efdca88fb397de7fe6bfc8b526681b35155ad893eadd40a1770ccacb92e96201db26d1bc288dd3f7c11bb4257caee4a71b6bd825132b440eb6c686d4faa3e567be352714572a6e6eaed389b85884ddbfc1b57f4cc2d8fc75d668adccc532b4c7d07622654dedb2b687d6470e7b5eaa51a6f3b6d1d405f1fb3f81473c796979ce58de84fa83e01e5336afe05c688faef8486ee44d8a36d7d89caa9f158f573ec9fcfc30861620f2889d65e1efa74f1fd0067744dc45c387f279987fe8a6a36edbe1e261e97735d73a1e1aefa5f2dac3de3a3cbab7b2b12feae7aa73330fa161dabdbd17a83d6a9ccf3d6a416c2edcfee3b6a962af63f31de3348c499d0626dd07468cbc36eaf79bed85d1b4f7e85e78f6db668a7559e06c4569e95935710bea4f426ba2e050a078b620959718774f3449d045fe70b0f1c560a3a9c93e0407819569f2b92a59c00e6b11f78e2cbab3e2996bbb748e1962dd52bbe9e5e343efb6b85b365acb0ccf4044d73a7fef963d9ae1ac62fbb1d19a9e3fb8864d19b80cf89a0ce3a3ef7df1c0f4308197a118fbcc1fa57305bba3477e5b3f316c4c5657059db938b83dc6c5b7aed7fb0596f095f9851497e2f7e5966385c5d8076c4c0a9c711bb11f29ff4d03bb72e1bfc426d374e46bf7d33562529e319c7b7fbdce6156d4a90bdd57e36c2f66949fcd6cddac87b91af74abbeffaee4df16d86e92a334137f49939b385b132114cc33075d76e6d15eb619ff26ceb03d64747146eddb945d7723b4173ad15e1b1bb9b4bdc35490b9ee33a0ee3527d7490323daf7eee3b8d13e074618b19166defb7c7d093d83b2201df71994774ef5fdeaf4bf9cb920643e2f68f99b642ba02ae7c736ee7cd333c7eb5078df98d75bc6f76dc537f899307b8c5bb71a1f370b8137ba7efd3a3ce2f9a23b13aad4956f3509b5f607dbdb5b62efc9273ff6d91ec3c5b7fd8f7c8be79d3ef6073d921dfaf47c9f36bd5df6bcb5c9343f7ede5e11fdb78ffc7123a21cd2b4eb5a7b7a9740ae23178623f5ecc6310a4893f100e916dfcd83b743c7512c03f5b930538a8603b83f56cefa9a485b8d86fe118ccd20dcd97501eacb58e1ae64fa63fb07a3e6d9297f6e53ac8eadca86d9c655836d863708287d81bb67699ef2b9e19b6e8b895b0a1adeded1afeaa1f8376518e45ebfcddbc4fd01574c46d508e51dbb7ec63e91d77fddc08714369e177259ad3f3c54e81717c11808b246fb6b1899af86397ed1fae07c2b0cd740a3818a3109cf28c1fb6813f70c939d308cb46ed8dbd390e7fcd8e147ba70ee8377fdfbe27b4bd7a1b538d4d94f0e977fdddb8dadfa639073aae769804f1ac3617d8301e75c65f5ba5fe71cf2309785dd517c7429036b401cbd91d1b007c038929b78099610b6de518f9d9b762fa2de11838fc79d903eba3c7d232b4efd5345ed89b95b273dbe09dbcb2fdbd51aad5b7fb7e5dc7b9dd6967e77725e247b5eb1c366bebb7758cfb9ff0b54ae349734219877624316e6ea9a8cbb5ed4d3aee43cf7e417c6b863a8b8e8fbbb56a0243c8bdcbf9226afb50b7e8120e9f72651f54dadd1d18a105bd7769a7298385671dab3153ac2fc48945a93789be3ef6be2880e752745197c5bf4767bc940dcfb1f174f500564ea29caabfe3e21b77fd305fd4178138cf3976a9aefe8cdd15c06deaac07b8dbcd43719180171539f0101a53c06923b7a7921630d8a1d55956f405d59c2b9dc6b6d6d863c80db9a9dc72ecf167563395fd1dfcacee1af8d69cd3eeea19e44717ae4ad697b12fd75e2cfdbeb9ca7d6bd3ea502ec68cd7f8ca0fa9ecdda145fbde344ef63624ae3223e3c1b4f5477cb2b761ac276e0ccc28f4c94c760fb9b2076f43d454d8d43f2633111a1464e7b6473b8a3b6c0f5a41da69f69b1bedbf6c6b43df8fadb3a78f473008ec5ec89ebdb14f84c13e8b8336b4f7d39f8fc4484aef9753d40e07b17eca353683947207d33808cdd1d11aaccea98813aad23ad71c806f91717cc92bc7d61737e27da56f5ee790f336d6f819678d0c7c97f2e665fed833f6997d4cfc74557ed7bc5c2f184402628284d50b26fee3e7cbc617c314dc84ae3db9718797682e2d1449cbebcab26f29ff657e6093b3c5e93c727ff4c53029349e7bcad6b113d89a8b1a25f3e41a720771b0d26f58ad26e5c862315c191837d4afade85db20f7c04f4fe3b2d9fcd71202e16413cc37fa377d49c7b9af515f609bcd54990c565d75a5f7ff7c03b6ccbc05575ce3c79949f3ad554e570ab166542eb11a55d0d4eaff64ee98b1d36de9d6c6c08ea53f5ce6dbf8e3bdf2b173580a8f9b335561b29e5180faaa3064c89db15d6e0b7034e927e3312e87c0cad7cac396bc4a6f9e3f914783eab372fe7b31bf8dce9a83c66dd9fa7df3f1f89c9ddf87e1e27a5f11165cbb475055725fb6fe7e9616f7047b1c6ef5a4f00866ba129f93be7fffe8ee44b5df9cf6763f56fdd3952ac857e8b6f67b8dfba8e0ddeb6d34227682b826b99ffb9d660ff7ac35e7b71efcc6967e98b86f32972f6fdc31a3b21b8edba028d15b8f49e67ae86c8fbb40705b751a5cd573e66b2d07af4be087f6555cb0f56778f5a1d5f8c95f266ccbe983f138f7b2f568a76bb2ac3b8c6839463673f1c43b63c7bd002a7fe37c799635f9385a6b849b0d605c4d938b88aaabea47a5c84aabb0ffe1f775fd2a5a8d26efd83dec1114ccf4a86a929a8a9548ad2ce0cb0ec02d32a5bfcf5dfda4180a0118a9575eee01bdc75df532910edd33f7bc735f4041fc8a8d90a63fddf897bbc13b72ecfa5aabc9bb8d64f6268bf7c47538beb70d35397d6a855fd2df601b53e3b4f05cf1afaf6efe355ded3d9c0e7e8aba75fd1dafa192de62cdef331d2aaf5f22d4bb87338f3cbc0783941e7a3ae8ea8e887a449d4718a399267f57ae6bbfcf2bd7caff3b397cdf9a233b23b025c372ba98a89f30c06383f0f66ca3962ffd7e72efb8ea07653d24382b58c335c55768f91fd865db5c5da107078777a94c7f5ea1f2c26d24b2a9d9f56effe3b164feea99bed1393d97b7f2dee6120754b0993669ba8b0135fbfcb9d23e851a10b32de6cd977146d957de74ff568d471ce7d3bbd9f455bf6e748bad7b58861d968d0e987c73134dcadf6e291ecce75e4f8edd48fe982708cf650d5e2a813c15e3c90f4db07f8cd41c759461df052a4e310ed45e4b6abae7fbdfafa9f14d439466e794ec2fbd771723e6fe239dbc858ed426042b8c046a9a535f075605c620fc319418eb2eecc03f0ba218ee3467bd4e3851d86d39b4c5cf461338e374adc1e059e6cd082ef0f5bfae5215fdbb33600e277e38e3347ccbd84c3f8e7b69422b203ee9e87f8a4c8f9a04f8a0f6eb4aaf573e7a158a60be23fbed764757b6cbfd36f3cd4f3916b52f0d7f0f84f8c3c26cf3b1f42c3d9f73d137974d406e4dc3841d68758ac9fb9c48ae432b7e32c81cbf837ef1fc9de19a3e6c6fc0ad275477c3391f40229c0ab78f96a0561d26c55efc96b1f0be341adaacc5f2d9d1d7c2b5499dd7c8eaad5a0b0f165f57392f3f928e75efcaec45e573e2dbdf2dc2b9f41e0564edf37c0947c01fe6c14e79ce395e5bd100b9436cc71cdf23399599a4b31bf7265837d1a7a1a8b3e37aeea41d9fc5ffb6abb8c0f86675ab37f50034ee2dd6ba69f3f787e20e321efd7cdfdc46b1ed9ff56aad86fdd57d47c10fe9e893b447e19fcc72fb6eacc81c1e2a9b06114d887e03a8b510f486293fe58768f3763e458dfbcd67513000f21d67ff9de7c9cded3f9cf89db38469e257e9fc4262bf8436cbdff5a2dacadb55dde57505e97f43b5e4dd1ad366d7a4ab3e9b467776deeea78bcd8e339f128efb95f14f4c15860878f24b684c8d662d89dca21e848ebe04ddfb59a41c734d14be55796f715fa0e5d7d3574ad338f5f548d5f833fdf1c719c6ea1ffb33669d45646811b38f6ea58ddefb9aa2782ee653d5b6eaff1b3d3dc4c0d670b2cd67eaccc03435f856a1affb8d42e761fc836562bb0f35d6b1e74cc14bb485a2fa0cc710e22a3aa1debff01be7690702ec2b4578bdede7f73fc2693dd02fb2c1ff3bfc44deb9549f250779b3eabd9b50a3c3c57983946b12fb6c4c7530f635a031f1e3072522caf97452579dae1e3683553b9aa327ccb5d183ba7c8a549e0e24e38db943f00f3b20f65bbebabecc73cfbce55b656dacf27de5b9b7a4d0abcf2886137692f7d375f73d8e29b48671c0792b350ba7b7c7f6ee3f7251c88ab75bba9f942bcdca363db3e2e247684588ee27c88f344aeeff53681db30109fe6f75b22cff8ba08e40f6ad1fa2ad6c6be7eff79a2d27d5fbd9cfd3eea58628b06b1ae908ef5fa61603d9d04b67fa8da1b11fef267adf49b7f87881f790eea86ca1804c03eade36cd1655fd19200fd735e8f72beb82b7d665fe5dbbe12997e13d991690c2e3f0f77e439dd0ed5f986786f1565cb2029ade17ba854f6d9d6d617fa7081ff1a7943c8850d31cc2f9cb1eee2516e4b418e7b3931e82ecc7bd04adc5cf3c8b30e391655b1f62eb5fdc127be21e83fb8f47316ea84cbb222301a227d63bbfaf0f67cb17d6dc690fd043904f889e8b1ee34b3def3cb7dc03d1c8af58fdcde830d55cbf02ad27c9c5b5c0f4509e3a3d8361b71f913d31564057107bbc05516816bade06386759c1d3399b83de08c315bed46872e4fe93aeacccefb45505b02b9152bcbbeebecfcd85905695c5af1d37bb808460a7bc6bbb9d3ddd7d4861b66f3e1761e7f974bb75eab27b80f027b31c5926805a8235e348b677966ab744dd6ceb1db4ed7ae3faafdaf827dc838dffcf41d823ec23b32663d3863ffb3bc92ef45e7dc1650ad43a436b644d557c0b5ebc785bdcbe39cac466ce7270a386c36e8a10e1c41ed11cf9bf65dba83aebcf3be74df698e5922d603723bf0f24eb9bf85dfaca3ca1806525921b105e836a8337dce6237e805216b735ea8a9c8ee74d36eaf8a35ff0c3f2f3080ad7d82cf9f84c9f7ee37fb8e112969bf4a8103859f27895d54f09bf3b5129c2bc11e14e39ed9b7535e65518c8dadd5047594d5f2267573f844fc9a7d7f4333cc35b2c865e92a48ebdc805978e941424fd8a22c67594edab028b04d49bd899ea51a3802fecebe6c68949ffb3fda975fa801f8b37dc1badcdb971ded3b26256bb36afda5fae3eda19c2abd57e0cf5ed5b428bffb5e3a868b4cd2116f09bd77e075f1f1559bfb0efc3881db3807a3fbf3b68139dcde1dd07f5475ee12f950de2fd519038367847858755f91ad196c8f50e2b3e77f6fa34e07f69bae04f623ec8a876784e131913a30825ef7937a57284789db3b7cb3cf954e0d731ba1fe1e67aad5fb20b0438df6427e8694051160473e118b48c7cdf7bf3427895dcae29ba8a5885f7741ac2924b678dfba2cb6accc491cd169dc384486f36fc535520ae380de79860bd6f4ddc63af0367b52b7e834ef71ea5166bb7bd041c35964e80b62d8337073c08708d0cbe15a6bc891ee42ea67ed03d5f997c45a2df0be04f2e69e7d11d523aeeb8beb91e7d34af71fbc27a7304ab67b52fae606f62870767f239ed15f6c70ffd5c0eb6a450ea6477124efb9f35f9ab3dc0f017fb1d9722ad7c50dc4e311c66ff53d784ffcf5b0f8bf1742db1b5c0caeb2e6fd33c089aec16ebef456310e503edec16cb876d6bc4795868bae54c790b5b5096b3dfb0f628fb2d851bebe7c3e0cff40b2b68d677a9407e7eef6cf7b96953959d342cf72bb6897d5d27fd7b7c4d0ea61823a36e082bfcefc7a8fa216b4dc2bc8ec08c3774dcaee59c272db67e46aee616ae39e4d6dbc47a0d3c5ba61e7d7a34d6044f4299c98357f46e7f7724d590f73a663bb8b4af76487fd61bdca77ed884663a86afbc8d0977ccfbe811976b356c038875d7d7e684bc0fe55f573a83a35e67ba80c270d7ef77eea5edef32c0ed325cfc3ce0f7cbf9acff00ae5be4718d3b9d5aeca2f3ba8497480307689770746af8835097b76e3bbd6a24aac828f4dca931ac6e893c8fd18e6d3fbb18eda4e093e421371cf239ef1e353dedbcfe36dd2bb90e6abf5d553f84e6a36ffae5ca6783d8c9d461d8b3e81ef747a0a8b828d5d03974d2defa964b5c1cd433a6e602f42769a19f6cd36e79b4d7d9096ef9abffd58dba775f3b9fcd910d4fa1bafb350e5fd2ce8076018ed38c3662d5c3bbcc792d54ca3af678d7c317a63829b9e02fd887760ce908361f2321b71dc779feddb697be17dd2e2421f01ec0f56979bfa476c2c94b888d9e571ac39d6388c1b4c1e46e9eff6826fcaedbcabbd12d4a3957450e9deb47a0ec1195f9b4b52ef51513c6208be01f0a0c536bbeb927b268bfd16f658629717c7ffd7ebfd99ee5ee02cf3f85d613cd57b000af7e6c06ae70de70ebf397eb7a3c17fd12f83f5549d95c7b04437d4af0ff37fbb2b2354217eaa70cff2f7558ec7a36e309b73c6fda96cc862f5cff3eb8b77e02ecbfd057ca77aae6cf09c6f90c68e0f8558468669700edcd3364caee21e9e038c964c3e15f171588c0a9c1c29279db2005f4970159f4ee582560386c555bc3b8fb7643d1b857a2cd4f4b31e2256efad9bfa98ff067e34726451abb90c63878febaeecc05844f565e2fbec215738ff9de29a56ceb5eed06b3531769b34be96cd53cbf94348fe5ede3b9b3cb2918bb523d9fbeee44118577d6ff90c27dc60f8942e63fcee43ec7dab19fbaec9facc380e0065bc7a1dc850baec1a01fce273cab3d53803eb9ee9ae4eef10b9d117a93b35ce9383fe8e2df80e88ab9db1a7bcbf783b1d5d7edf357a09ce116ad860670706ad750d738edea07eabb90adcde06cf62ee85b3444343df04f9bfbf48fd255eafc16c86be7b9afbb81786b3f2d70c6fe0381929bbd2fa4ae450b6463e5ba327ec1554ab268dd2374ab1c08bfccff7013ebb751e24dd7681e33ba9268fe558ff125bb00efdbddb9055781e2c9a7181f77bff0cf67f01dfa3c81d5e5a3b362fe43017527ee1925f9a8f2dc6d8be110ba28dec0ee18e76105ff818c974c31d5fe8f28ef5e02cc51565df22a8675f54dcb399f8aedec3ecf3a06fea166a5bf75539cbe4771f35c22f156b124f71df657c6d34685d7041f29e3cb52cff9fa82f1d4367041e7ca6b785b7baccafd792ecd5cdfd64f310c4aea47bfa8ba8744f0acfca7d0a9c458673d2ceec62e02656aec95ffa4fdd4bc81a861dd5c6dfe99a2c9ac04ba459dca2c0c7041f80f95fb013c93a8f4d00fbf66e9e49c2f7f3ab8051413c0acc8dcdee327f6e2351706245d147a2a0c6ab8ed8dc67726b3ff975f37c47a6e23e315c198bd73283d72212e2710a6323977d69e3bd0d9ba8d19a18f6dd7b90ad37aff9fcfa3c7f1dca31461607cec6f6d7f0d7993e50231a19ca8a14fb42cb3a807ff7b499c6f66ca8985579ced5a7fa3e44dfe1678bed9951d43dc029a3e097db70eecdb46fd330d1b707ff17b8a847a236c0df4c8bbd97d7f73f3294b417687902b6dcef543f7fcd269d1e0d964ad8a5bba9d7ea451ff1e50e4cbc8d88837919d607776c437e2e0cfa6f306abedb8a002fe21a67a3bc267f86b5c1f3d3c5b1633defe8fcdaa4bd994fabc63f97f6937264fee17b39de00e3f80e136511c65a1db1cf3b3c6f3c1e616dc23aea557036b027c091805f6f4216220f91f90fa8736032c75ff70e1174d27ab0ed7670af1beb6e9b3d6b8e5b8cebd00e9c59da0bdcd6c7c31aeda5eba00cb81fa184729c41dcf9af51acbf54b6fb8b6b2cf4e1b3f7c931a0fec87f2fd5bfa63265626ce653fd9b3224b729ad2fd4339772fe57670b1819dd76118ffebbbdfd77ea3469b3e7d4681fd869b662f6acfbf16a616c133c69e4ccf4cd81b8af8b2a72ec768e593c8ef1aff1fcfe55ada60847b4d81b931c334ed024721be821df43efa2aeb05f531ab6a20f989c5b28676eb7ecefe9398cf199f38adff3dc6ee1f76c8d37914a7f8bff065e83fbdce7329b399dbbbd9b78964262071c06c73417dd9b879db71deae7cdf3a03e58b6d51fefb37a69ae229b7b31fbe7c78aef67fd349c78d16692ac66fd51f3137d2e5d3db7293dc88ca1db98930ef05bafec05f66eed10a8270a1e22df5bed305636ff583f862d05355a873066788a47f61d26ab95831fd36d30ae9a2f49d71536cb646dbe8606ad4f5cc6f7799c74deaadd4b95cff74efc879defa469fb6e38437e83d54955f413ccf7aee44eca64bfe85b790c9ffbf0e66fde77bfbf8eef8037224ceeca61dcd1676c35600ad9be1b997c0cfcac48ecfb8a780924bb5f4c0e6aebc8657839078c9fe4f3060f87957118b1fa21e1feb078b67208eb55b925bb95e39f93d8d9c3070e53f9065b63c7301417326ca7c621dbd7cf45d32649dac707f900fb228dab1cd1074e119feba217c6eddde40070a6bbefc74381f745cad14f4aef7ec0bd57b63f17965a92d7d8f351e0eacba8255b23e139d91147e3f595211b5369ac2cc6abfcf4eb4e021c9f48d7608f16795cca323156761ec3cf73f6a16a4bdfc7b8883936a4445e48e378bedb3b44de707fb576d2f83afab3fcaa7af85cfd7c115757a0ff7ec4f9372ac48c9ddac41d54f1fdefc58e6b44d5b6dcef7ce7f5ea35d87c24467f0538b487dbae9e6385f21e9de3cc62b5c247c842e4066a9045fd56160f468f05b0c29d33e2830ce72a8d2d163027f23cd86d2ca23e00beef9c78cd39f32d8dabf160bcb172eeb79aa7087de4984bdc9803f72f4c8eb330763896d3711678d6b22faba1c8d7bb6acf21ffbd7291bf63b5c738f0eecbc3b2bfe22a8d79dfdb44848d6df347b1673e6ee82b716edf9bbf5bedaa35b483d373f90af6eeecec2df373635834c53c04865c869dbddd763bd1d704182b255e11f0b4a77618e699c58803c36139845075328eb1cc4e0396d43c6855ef8f2cf5c3d3c68fc0b3dae355c956c71cfdbe6db6c70bc520f55e3d8bd5772962bf73604954e18ac9d644e0ab4a7ccddc1760dfb813939c8fb13e1c67b99aec91e410aec65b78ef037dd1ea0d47fadba28a3d7a634fae94f95467f39f018f50b69677eafa5efae3c171f0fe766f8d78ed70c5f591cae692edff12a432ae6abd8f39356afb61ac9f210f853a6da5bc9051da773482ac364eb9eeeabfddbecbb9d480fd917e2385394c3bc06a526808bf5eaee358bfa1e5f27a01e0c9563d73e3e13336aee03bdcf7eb98a80967b1d1948ba236b3e213f007612f2901ecb0bce642d910972eb99ce83a6d65608eb88eb29d2dd717b3a1d3ec711d97c74f2eef40bc3f424d8c1276c0473d9c8d74cb1cbfdb1cb35fdbf7c06958778ea1a125e891030e23e19858beaaad02d7bcc35d215a53c17d13e99b58a1a91e12d93eac1620afa764eb4a5fbefa8beda6a7dff6933d7befb89e61630fb2b1dfe31e63eba17b1377b7a918f77c91e81c517c818d037ede64bc49fb5ae12faaab0a7186c2b8789c3458f728ea7ca06fae6ba8d837464d9a712a8b6dfcf2d9ef2e4f0ba25a0d9eb34c71d1cfac97e9326ee778b3879f2df405eaac3634eb9bffa43956c7cc5faf38f65a3afecf9636176153f3bad20338be989df6bef170b619e762ddec22bfd74faac8bccbb7fea0a6541813215eb887ad425a8d5a1ae3776ca28acf74a446f6c4b3b6929a9d7aa07c3bceb70bbc1bbc35dc57f4aa02033fe7d0f2b88c72b077a3b77f3e5a2fbf3f46d6f77ae14b35cc6cadeb6cada5714067059ead8af7e9f45ced007ff72d07d031727bdb89677ec1be2df20d3c91131463cea46baa90d150b63fd772532122fefeb26df283a8bc5e7021b7b9099fef032ee69bfefc0ce3fd63ac31b913b94ade7ff9488ef2d852360fb9ceaddea721eb75bbe46710bf5ba2f610d843c00e62f819bbc075162451f8bf3b61777d32d9bf29a7f0a315016b0a3eff6a3a423c6eb5e92ea43282fab12076243aef053b977d4b5ebb46c16dd9d50bfdb5d5d6e394bf03fffdee17d604b1f0f6b69add27f2c99dedc4332f58004cef9c784e858fb1926e38cefba3dacc8e9d3d70bb20d73916aa893eb730615c283367792def524e83aedea3ac5612782c597d2e1bdb60665ff0dc16927371b94f71bac67daf709f9fabd96c8606e35cbc1ae78d6f807f33a78e698d4bfa01716eb341dce040d63718bc830ce3be74a68071837593c6a124d8737144c1118c3827c79e9bdeae19ee71c997c4b88f399e8e7bc1d3b9ec13b8dd5f64b2eb91cfced63f32c049d600ded97a7aa7d78df5537bbd316af327156d70b3bc7635f328d607f7ea5348da7b9da02fc957b777731212bdc29eb7d3e7b318c19e24ca1272ba6b280cdf2c5c9b5f13efad8c498838911e513f0eb6a47e87c3a9fc8d5b5924f1cf6ed654ee535dd5f3e66346ffc06f617da9d7dbf2f3c265ffeba19fbcb0df96d77497614b3f77760adfefc7547c663cd66b5e99fb57d6af5bac2f090caa663dfabe7a9a87f581e62ac7454f5176d9f7d2ffaefd4f80efde1854b1115dc4adf5e738fa175afe7d418d41e5785afe6de6db40bece157f81fd1161a241de3887b06a7dd8b8bbad56e327e18550b49f88b304ae96f45df31c2adacf3b7722fddd03bbfd4aa69b446dac49cd6a95de0bdb33d6569c17403266c91df32c9af3d05ebf331f630fb5a9f32caeefad14dd693b9ff6eaedcb5538065542ffa6ad9d7e57eebbd62606e65b715f2bdc1ba6bbdb382ff34360d8b3089ce8ef5f33d466f9232506be96370237d0db3f0c47e2cebea663bb8d19486370aeb663b10a4307b7338f67625d4b7c40381b94d59fd57b35df3d02dfe71c193a3816f85dec312cdeebb3517e3f6482e584067a6ec5799aabdf1f26ee90c7c1b3bd2edf936eeca4fd386b6b4e5a8a12e573d8cdfb6e107a86157d247f76160297ae48bd848d2591a5c07da1cb8ab254358f0f63aebbec9d02dfba24dbaeee68fe5ca67724b6de3d19879c0ff21c92da35caea8bd047d07d1fa866d57b707e2b7cff6d669e9faa71daf8f55e8a01db7a3b9bcb1c5b9373976a71b79363bff0fcc3a53e95f14ec44111a7b0f0bee63c4c52ae01d61b95c607cd896b26a40e2ccee16cac0631ded3ed34796e99d550ed2b62f698ee2da60ececd21f2ac2d51b5dfb99fc9cf16df2ff1992dddf7ebbdb8f57fa5be26f7b50af3e4b80cc5b5a961ad45baadb87e4d5b79db8f578e5df1fc9f7ebc3d6f6b0e1d73d85f357447d7c6e8c1c5d918b943a10cc16fed9a33b66bf473bc78ba3ee932b7f7cd3cacbfed51f7dc5d3c3aa3fad86e357e58ce50d673b9bdf01d2867e050f29cd6750f61b13ebf84ff9be646f14c33af8d48e3c44d706a1cc3585b017bb66b580d602421e78bfd470f1f6a419e8ac9d8fa396c831b507133bdca63067b89bedddee917e03c3cbd29f606e79d9d97fcbc3735996ee4789b1cc38ef18b2046f1d353829ee54463a7addbd6a82bf66325e7f461bc72457bb6007f14f883623b1eb548fa39ecccfe817dd9eb9c42effdb51c1779a06b7ccf3cf663acf70399db765e7cd5a9ca4f900c6477addee4b2df3c06e3af833fde1465c1bfe080bae6bb13fb7082b1e57142e4558039afed7116c33a3881805539a781c7fbc5807b3de2f5c6f0ed164dd4e927bc4600355f7312d37ff97fa7717857074eac42325e18664fb425f1f7b705f6e4d360767386f9f542dcd33e3c6feac05600a7f18fb5b99c18cecef7063b5298531f79d0f1e610c4c1061894e9ba9cc2eefac8622e9e7e3a7f208e464d7be8f4ec9f6de787d55acdc6357f36561cdbd61dbbdb0e46e35a6d6639f377cb3ece6cdd698ddb743cc2994c9a9e657767765bef58a3a63772364d7b65314e4cd87a3fdf4fab89e7e7e3feb16e2a7e7cda84ea3c932b9ad7eafe6365e35a5e9d3b99bee07b25e8b994c5bd87e00fcb64aeb74a9fc75d7e582758be87fad4302969cd66179bee291c71b9edc2f574bf28c339a641769e1fe8b2aea35b439603ac7abf66e2fb55b9d6a0f47d25ff3ece6c3ff91b58ebc5f7dffa0522fb0039bdd21d90fa4285f3a4a7b928db400d5ee5babaa3c43e7da497d3d8dbba79009fd463dd5c5c83668a594df9b33ccf97f2de333dcafb526dc8271aaa975ef802bfde39ad79b06741a7370fb27a944eef10b6d23aa99b9aca8b4e432fca6baae33836e27abe2346e3fc631d42b72961fcf26fa89a5fc8c787895294cb3bd8683f622d0e62ba0cc6e575f05abdd73426b0455dd316b2ed53617d1bc0b4e5f685e28f6d26272efd184b8df700b178136423fefebf9fefa7c30f7af5fc8ad553cda3f706f196a7e8e37c3c90f36618c6a8df6ad438a604ab5bf0bde1ff5ce565ee8db6afe4bc191383d56cfe0f679a3f97ff1bce7be1b7b9cdf159aaaf3f1eca7f5bfd23c243ad24ffcae75550b721891753f800518ffbbc536ee30cd16316a1e6bf646309e3cb25dfa2f47cebedab5be851fa54047393d84c523b5fe5676524b0f5ebe91a84fcce3e908dc32cd797f6effdb7f7bbf8ada76c103dd78f34e4d859e0b0bbb2b11fe300315f2fc8380ad107b302772db3b73bb0651cf44ac177dc075e742606c35c48584da1a19da34b7f734c3a34ed9331e83e8a697647f037d4982c271d2709bcc1ff89fd12769c05720be8e767f20218a6ce6e132c582e37fa5c9e5e887bfcdf47359bba7826fefc0e15c7f4bc3d511cc3fff5fd299cb547fa1178503dfa9c7e6c3f9183bc7cebc73afd567aaf2d706f25b01b1eea495a1a63166359124353820b3e04c393413d346a20d25ec04b9e30ed19e435b8bc6e26e379bc603fbc2caada4ac535b4d97b1c2e6b2deabbcaf812e361eb31bd537b5138cf90e3e6d06658e8ce396a474a1667bc3e7ba56fb6e714786c131d981939bff7d57b67bb0acf3c17db299f9daa39acb19df51523ff056c8e1a6d8bc7ac9dc376ef1074e82701ffb66bbdfb2e3083f89a48eecc13759dc5fb3a9e187a1238dcfe7af63ed68b671bf5f8977592c7ec8b6b191dc2f83fb74f818bb17a78df56ca988f27bb6becb9be07ddc3fbfde3f0d64fee3493096cc3986ea7198e4baab758dd7e6068cb88d5335af2de5cf8accb53fadf3c673735942d590ffe8d508fb67612b2dc9cc17d445cfddc778b3217f9d9dabf99bff5638d3958cc278ece1b0f73b0f2310caf7dd84bcddb5263bfb5558e75b5d489d7eace3ea9f2fad9316b53f74479eff0fff8b36fa1c1e4cad573ed6a3e6fbad67faca71c554b823fb0f1d873ffc7fe2e7aba8396f02e6c8941c1c5b9fb6ecf791ea72bffbe9c973c6b5f61ecc4b0535ca5117669ed37298c41908f653ddf927b57b2438871da80939408f25d727d5e7a4e96e3d8074c1eae2aca09bb3046e436ba12bd2d94011b125bac2634ebcdffd0cdb9ef0c360ca7cd6bce498a0d873e55c47855c48ec3e4462f1f08e357b267a9ef682984f312c03725750bf6d1cc5fb35e6d1ad607b3ac6f8ec7906fb0a1d0078e7833314e7bc4dc79fc1a7d5667709c041dc6a9d8e82fe4b62289ad16e65615db6b643873629887c0703c8e677b570765ebc9f53fc396c5799a5cdef35c0d5e9e1769acfc585b4dddacd6515a8fb6817f4792c6b26abf97397e0a27a4f0feace7b97740cc3ff561e694749ae76ea7a790457319aee91178a6b84fbce7b384d377b557992f757ba65b5d601ca4df66bdb6e6b28f1ebb05e359a461fc8a380662a5b0cd5ffbee7c13a0a787638214b13725775920d7524e22fefebdaf0e84f95670cb0c57957d50c95a0b6de8fdc4ed2da0cb494c17be4c772e4ff35bfc941dd665e3c7bd3c2ee28f798c44398584d5f6293abb8baa3ff355ed3805b78c3aaf7599bcdc2e24b9e06cbeb776df833a4a3ce7c7720c23fcdd866fb0a8766e074b995cbb871b95e5bc2e7361dfa4a7b07f7e2d7373ff0dfeb03aef65645c22114d732a1ae426ebbd03c705c6c07ca28558f647adefe22794ec8fbd28ee2ae919e4be616579873357eb2ba7f053ad805b92e69bbe7c2f80ef846fc9cf0672acaad3a868233c856198bf9bebbb00b58e9ddec65fa3e607f91d66af2f88a1add1c31324459e2d695c95d7c05a5fbedbf8ddedd04394e9312fb5a3c72e3d87ea8e4e47edd9b0633622d821c9dbae3f7e91df3d3ed627f22457b126659f73c3ad944d58377f07deea695b6f126b1bb268ecb2b5ebaf91d3b5c5f2d10be615f74dc677702f379cf770f65d861bc3b070737d15071b827ca9abd0cf117a5614355075e8e05f61fdc4c6662177bdb6ce5c3f6c6467bdb00f3b3c27c8db0a6d88b14b55df094cafa60f47e2fe10360e67a5fc74321b7cf164fc20563651ac7fd889f0fd6cbc233ad8db86945becf29b0c3753d7de59ddabc847cfbfd718f23c7fcd93f9f397df8e19de62868559940fcc7fa7aa6f6fc67d9af2f97b35d84bbc2eaba2fc89e2689ee9f947f655a1467513c5ed3bb2677ef4dd0625df96c3627b6ada415ccad9b2186f9df10b32bbbb681bfb45ac92dceeb5f7dd36ad65981a1c272b812d8cd8afcf319643703178d617afd5e0396b6d31714ff4da1e2be05d1ea298e547d25c546790f5c2cfc3187862ce8ae3362bc0e84c63c82f597f00f05977fd45b307ecd5f479d61bc971cc591fe4862c9479d8799b0d475aab0b0c8cba9561c722ae90a0860aba3a8d29f46eb871ca7231dba38aba2d2eac7be5fef7f23e776373c5f1595f42a3b1cdeaa6985dba1ea0eeed2544afac7b0abd56edcb3dbfed7e8c787d28c3f8e2d8ada2b8f0d5ddf0bde686dbb1857772df9af5da6e9fb06bb9bf9ebfeb283ffb35ebe0d707156ddba7fa687f232653e89d6579083f69a663358a318c237426b01f99fef4d7ce86189caf2ec3a32fe6430ca16f71f10363e5376252a25ed6ca3144dad84d54b636bb228edff7e228451e8b1de6734efbeb84f5dc07dfab1cb73f3e630f11f5c2551118743df150cbda9c07ad327615bbd72df99dc47b0476ca1d1fafb7c333e095bdad416c468fe4398fa7605d32ce59813dad2ddc8a725c8e335eb4a7f13e419f9f48e650d4c46b8b693bda12b537e77662f139acc14f4f550efe7ade2186b6f0dd13ef19e86ec5efa2fbb06ecdc1213d5435e5397bbd884369419e2016b8f94cb41d517b2f592ccc55dbbb29fc216f7becbff734ae5ff1eceaa77e0a3f1fd6dfdd9341da627a1fbf1c985af1745471cfca7233790e9f123a31c8fd009c31c825d822c1ba77400eeaa6e7c760bee696c5a3527d4881f5f6546da3a0df341f8b641fcbf7acb709d6abaa7998ecf7d5cf49d9465ff4136d19184c77e19c6c8235cd75d1b5deea27da3cd55be97c721d9974c577eb613f44fa1e796e455b4c3cc63b5b5136da4fe1c760be614ccf17fe8d14ef0fb907de83cc6c1ade0f8658ea2102ce9fdb58019bc84f584d0fb0b336a4b3caf224b4db3177c8715d7a6e9bf99d410e9f404fe3fda80d77a3f4dfd4465a6f8ebaa0bab383adc670071916841513f5b42175591e5fd2ebc96290c06bb32e9c2a97359d39b9dc6db6e0af06c0e1eff8957b5f6472945cbe2138c7927cc86d9e635ad8ff5954acd511d5e548cefe5ddd12d364e299f4cef90347687f525937db0dc9f92bc6ad0fe0601da9b456dde72c8ee3d6d72afc7d5ffcdfceca195dead4effa9f15ece5d277fe0cdb96e3a013d5fc9a8c1ab045be22b7bb9fb84c77608ebf27de50187760cf38f079be1b4f14c566d9bc70474b9c48ec9b1efb6655f95fc220e7eb057c4a0146f437e391e95ae66bc8e4306c0d75bec9fe3fcebe782dd1c7ddd84cd7ddef9e6b895ccddf9fe59eaf3885e053f5e6e125f7b40a3c8b82a38b63e8c4c0858e188e5a13f8c21bc2390927ee0bdec77cca5e7d70c10a5edcb35df3f108f2b332bd7a79c6ab7f1befe38e9ddc2dc8dbd53fd83bc861d4a5870b8e875e947da3d53f39569bc0d7445ddf60bc09bdf7ee42b267f7ec379cebfdc4dbdce951d4561337d8046e5599e857d117d93bbfa32f42af355f7afa71c3fab00cac238d272e621bce3bb843c0d1118c9a8c5f95d51a7422563bd41fd576f8bfae51f4db226e13599b20a6346829673c079ba788f15f45df146253f998fa9c3715ef92f836eb085ce8ab27f0d646c74af1573186fa8ee7d2d10b1a45977c7ab883efe023e7ef9d8ea4e3acd19ff9b36351e2b1da2c1a26abb2ad58d8dbf23c9ec7772bfb38bd5d90d4a07b588dd4cf4e731bb9d186accdd7c870c02fc6f0fc3e46af8b477b92d900411d3182461289cffa86ac94c3a43207f8ec79cceb95f239b21b3fbfd153865a2786798d9a3082312fba15643485ad00cc751eb361385633f04f323bb4c4d7863b44f75dbdd9401ff13d4ea5cb7b1feff5d4b3be887af52e91afbdc65d897e8675d8b0e6d7208e94098f7b4bd6a9246fbb31db439c9b9ff0af8171faa1a33f64f5c5eac54773e8e5cd4f169fd738cfd0cb61b21e1ca05789abf17cb329d0d90dc85afcfd09fb07fbf4bac7b912f9c89398f1566f48dd3957ccb548fb1eefd53fe6b59b3c0646d4cd811874397135a58c5b001b64b60b2f3d770bde7377fd8cd87e29cb835ff0657c951e224fc0a91073ce420fe7e06d614317b6ba0ff4d71b5fd76c2caffbc81b08d7961827e8b56af274ec57b6217dd73c452e452f49f60d597e7f43dc88e32ab07873fa7b27e77897fa0dec77827aa46b19891a5aa2d6fecc46479e5bcdbf25ab4b39f0ba1e7be22a74ca3828ff6a1c8ef18356e173bc8ce506bbf64606a4bf536a9731834b4a61fca41fe3d7efad570997502b7c431cef2d8cf99beb268cc717de5f8e6d08b90c1e7178747a94b89a8a9804abdb321a14b974d4022196cf6df63d62fe516ccf46b1aefa0ed739ad661b3642d7d016596c1ff8c87eddda4e47f7f4086a4c87c0b7a96ab3eff833927c2470d31ab5bfced1919fab5beea22a79cbcc0e798c6ba9ed03e395e330b62bda238f6defabf77ec7fe0686cb8a24a97dfdac7d0c1d1b18af3b8e67ba47ae58786fe2e8f01ff1221f481c1d02a3fb2f1b8baaadfc4a7614c7ade171e64b7df47047eabd0dce1ceeca38d6c6b67d9c050672223d60ce94f26013e4665a4d95a827057879ec77a3b45f197594d2fcd7654d6ef7ee410d171bbb6a2de4b88fda3ea8f7aae7ca97ddcafa721a83c715f5f661badee977fe34ce923d5fc5fee0bf7d689f8e22b7d7a8b49ed097719404ee31b9b796ace6a93db71dfd6d56ecd3ad60dffd8d5ee00389519ff4facfc71ff63195e57303b546c028b6ed5633db8fea6750cdc6a3e4f573f7641ff9367fe5ed19c4b94bfda6c695ff5a99ab32c39e80ec3b545c37118fcf1d9bb671487b36c00326afa9c3f739c6c3f7b0a3aec7cadef9f0ae645c2e721e1eb1ce460e754f388f41769f65eb786be73678bdc48e868b6fe8df8b3ce4f3d5285908cfe1c1419ebc33a8a87fdab2dac5b2fc56ac03514fe727f201857108f301d9dff7b94df3977200c4d50ebc8668ff419f8dfb6bbc7fbdb147edbd50bfab1c77b9ca195eb6ff28de32ae5903cba66d4f5c5f6712555b11c3c9b18078ce5ebc3e02dd86350a8c136536453a9f0a7604be6957e1511904ae9e9098e50f331e2466db7675b311d62d4a466f5fe3b632fe1835eb614cd13bbce7f19baeddcaf82dca75b1e0de470f07b3efdbd10f67d4ec702cea6dd6f7ec27cd017ab2c8fa36272ef46f553e7ff77408cadc3e577286cdfd712ca03d1f56902926f448188b31e0dc5123fbde5d5923ab1d820e67fd4ffc3e78973d169f8f7bfa8f3d27af95486df4bf2467d8385f6e7da9abfbcd7c11f545e80bf75add6af5c46a2f09d5d93e40fd60c759002794bf579cdbab5b07c6c951cd1692e5f88b6710b9f4df59ef61911b14ffede9c703f186bbbc67183d029cbbf631e7ac56233516cb3e47f7746279ccb7671758626e631db9a779b8da8cafff36a1bac2f86b66623d5dd6855d369f8c171fdc65885d5ef41d6aa5f573a83ab530ed0fdc23a6ff1c4e29b747ead6c157957b7e2ae318b3e2d3214abe5b637b5977c2deebc4c4d0ea55f5a4a72a71f8449cb874a75abd4b5d4c1df823c0028eb2da9885e40c4aef4469fc6cedc471a1284ef39c4e871e83d1f76c39010ed779e2cde7a1a1272cbf5d0fe67e7ca25edd6a84862d97cffc775d9dfd4e108f792246507e976c5fee9cbff4f988ad8f5c764e63f0ec37c7914b6bdf5dc7f25a6ce6e8a9b6eb8e84675ab616ba82dcc9651dd87b64f357c2f898cbe3742eaff330fde6439b969fb77fc121d58f1b5b91cc8dbcdefc292ea7d1b112c74371ad2edf78e83f3403435b04a3e63b514f2b8bd5ca6bfb6aeb7a7bc62edfe538befaf76b862e395cd4cb2b4a78a7c7007c41be6b7e553e77e3ae44fe0963bb0a7258e89d2fd7365ef2b1dd4e6607cae3ab97310af646e4f716befb1fc450f3776775aacfe9262e633d07b8c1e00628d91ea23d9a8e9a06ecc06e3bed8fac6667c9e2a9b23abc145f2fc32befa94a4c5a0aabc7ebbbbd46df551a41cc7c058c612de2d429f482def8ab537018a816b375527ddc984723d9592ae8528fc6983b6a1c2782bbf9c84f167f57a8cf1aff492fad4717fe72b39bbabdb27d2fbe2f184315bf2a26f59e4246a55e7581ff24ed11448dd76bf67d6e7bfe66188e67d4632ac05dfccd6a3f993ddd38786a83468a7604876b583fbefa29f7d2af6ec7da44c68902d7e0126b6ab6a6a3a639b57bad3bf8490d612f6fc98fba3aa337fc49e9be317eea9944c68be503dfebb7c5337295e73f1af77a89a7766f4bd46840d4b0e259b21fde81b1475be8011d1bda5a604f96e5d3baa9f82cd6a134fc18beece91028c27cadcc6e49eb9d3dcafa4ef17f52fbd9a3efbe677e3d633f9b7f101b765b8a39acf9bb917dbfc7a0a0337653d79c87ea5c66834be532af7d6af8aa7e9e18b0a3c37d10af84be20e76ba934efc179f88ccc3887ee31fbff8b87fd031e65f91a09de32c340e4586be8a76139643fd6514b9ef163a731990aba389d73357ced54cfb139c8ef8b538c397c070bec725f4688d3d6073b7fbdda0d5c7d1fbcb77738c744e4078be403e5eb60f3be52fab2ff58eacdf16a78ea2fbeca7846d041b771cb5fc2b8a5738ba53c90e6812adb90d3fe62535cc7fdc7e2eadbcb5935db067203b202b86286035c1aa69b988de3d1fccef491f7442e956672e5e597b0e668b97a78460aba1a58808750ce77d500feb8afeac76fc65e4a3a287ba7404654e6bd1b7b740fce64df7b93adf37d599b8dc135810bcdd6bc1f0789e0befcb0ecd7597fd4fc24c0b450e77312a3f7b459ac191d5e3002be279385b266e57c1670ad9785ef166d118ec9c2f1efbcc18c78ce3632e811f1824bff48dae374dd5f1b18da3526136a8f97613d48dc7a30271d877ac06b5d35f4b112e9431a004fe9f6aee06e1ace4b74cd9183bb3694e8903b7ae8525386f7f57e05de70476227d70d77f411db139f617128f36ebba18f05b2a884a3be66f5aa73b68f9061ae96c7739eb853bf58ad3aab0b64ba80c9e17edc139e2d67353c57bc5b89397ce2ccd0a03dc8fa6dafea77a2989e2357df4ebc1c93743b717b898f7862c7faf2bde1953d7c550fd4413eea28e935bac2e05a34b3ba61d8aebfc93ac09aae06c96d3eee6e5c95cde7619ca03da810f7236b7fefbbd6caaaf7e6a13113e601f09b3bb931f4b6c7c0c2422fe68f65f3cff36577d6a34a7e92e9fb75b01a487b2a1bf32876d641524d2e5589b58e635d0ddca8825d6ccd533b1863b076a15ed5262eda85d9f8a51c383f02573bff0dde71e0b4f3f838db1b47a7c3f17990f2813f91ff4f6d73659871f4e17e77570d4aea83c367c26221ff3cc37d5c90696cae8f6b1ed9b766dc4790c40dd96f0e1573b390856749ac84af3fb3dbabda93324e653a35cc6d84b5c5796df56cd7697e0e52eec945559de1b61483f920975e5b369f743d5eff61f778fcfa4c3caa60bfb07513c4b81fe422e56bcb63fee97b27ae284ed2a061c73c7cd306abde97cc736df9771f9fcb7de035cf225bff81aecc9e93d89f0d1ae9a692c9866f600a2dc17594dd8fabb9ffb015cdb59cfbb189d21df42c9e37b8d54752191d3b351e9f97f9653f02cfda4edca02aee953a289eafd6db79f08c7d103b3562d0783a2ae03aae07b309c30fbf6037663d007df7c4701bfd585f4e8089dd2a715cb11a45cc5d8a31c3fb2a182e0dbb074f710265f5639cffa34123c4ab6ef959fdbedd30fa4e8f869e43c3faf099f845befe02f99acb4d8feb27f46bf695ddb4afd4188716c9d61375faa835829ef34ee80fdff493a7f45d363739ae05be957ccfcfb89af7413067a1ed04eea431af8b91c8cab20d106f80739db07561737b7db62e28f7d7b89cd8fb9ea8f6b741a38e45bbed2001e6196a3026dfaf0994d709612e1d8bf6dd5e42ead1f9516dda037d95db0a7d3be7f3c57944efbd51e0e492ad795edb50d2b3371c56b8836df84fb5c8b077e1dad9626e8571ce322e55def3b709de81c76c82f3e808dfabb4670c6f97eec38e532bfd3e0e1232aa2d24b2b8a44b6ff7ecf91e4071bc99bf5b84a7c2fff617f254d2bbccd7b8d40b5a96f521b8b6b7137750d5667a06d7a7f8fecc971b5f6205ca3c4c9479dfc9b1c518a645e0f518ce12efb98ec1391132bc5d6ddfed346918a7be347c1e9e23c97baf27ee69137556c01adbb33815ab3f93c6348be313d852c2baad1ff6ca71c6bc5ea3f05eacc13ef0505f7ddaf8f765d3bdfdaa877c3c72fd3caff56f6bca64faf9f4a375ac54a32da8c3f8377069ed47dca0d3cef0a64e55a2cf5f4afd3c7a8f0213ae6b14ef16b0d5bae0f55303f774088d8c4fa8b1468c88e39bb35efc3c4f75a3cf5f1655e5dab4a51c73b976e12967ebc8fddbd96732278356adf134066bc11fc55d95f08995fca2b26cec8d02575f46d7b92da6879a0c63ad304f9cb11fe8f967fb2fd947715c5bfb395e39bea36beda163353de5f5ae9e10d98fcc5e567116ac3bd849ecef1dcb7e9959aabe25869354c6513a8ae72338971be2eaebc03d35d2b369212efb33b87caf825c9ad7ac9af3a3db8ee6c43851603cdc8d35197487fe963cae99e2facf42e3a4009f95f3f6d526869d62ddeb8c33eaccf00ad716fbed35d6f8d4336b815b93c4afc458399f06cb0dccc06d198c95f0b2de8db0bb68e6671639d962ff043805fae79719f8f4eeea953b3af2765f1feac894a38061059565c74d5d60ecbc44c0df5b286b6012a1a6b0af6ececfd9ace91e910eb759ebb055ac2378b9c2a4f05e8ff7e367b5d217d9bc18c7e8d39f55d485dda430a759f7dd7fa28f8bf5afbf64ffff717d00ff5da99626c395ce62ee26e37922f0d18d1eed76500f389cf9e9b94ec0971aae39876f7ddee8b79a5ba2eae0039e23dfefab4ed26f018789725cd07983eb57c6c987f7a39ee74ffd2592ae6d558c99ed6d3c4b07a7d47a3252def3ba25b98e2d9e5db67602b92c384f6fb0f55197b39fb6582cf31008fbdf1b9ba8d33bd8a8e349aac9b7c17220b1ef85fb5d787fc6c7c76c20c8316020a327afebb49541b62f5c06c5be67d652be5b7b460c6dce31bce894d94e94925bacb8e25a15be7b1b47a98c55597a8f4477ca7d2dd86e09a933fdc770974476d0c40b583cee7bb9e33b3d18d9df1cad805fddd844c03a519a0991cdebf9beac7c2e0fcf72dcd844eb94bfae7a4de76dde781c5395c5939fd5ffb5c626a2e9f7275e57742f809fbc9facabee4bb7fe84cc0427c6aff0bcd9e736d063b9998d47ce496d9809a99be7be77e1f0eab7aeb0a0c02db1b636ace7d56da813af7720716a03703f854e0d671931ecbc6b1c2913639891ba530b55ba025e1fc74506f75d1db12de97d8cf3f1dfeeb7e8feb09e0d3ce3dcb3d1f0f7d6b02a46d9acb25dc6f6c58fbbff92585b0537756f4fdf8be918efb17bad5e959e59fe5b413ee83a2f91ade91d9ba437e5ebb8e035551bc2e3459e7efca7bba826c7f87a48fdef0838f029c6d25faaed442ca43d8bc02fa63a35d8819edadb820f00366064d01a316c70ac16bedd0cbbf2f3a744b1b37b98635ba5bfabb82e692c6deda8819be255f1facc45b810e61d94ccfffc669f91343e151854cdfb70dc21d6e7a7a7369469c7ba5a1bd8e2273ef721e7f3fafa5f048e0643abc39ff414ed87e3cc6df85892715cd6f732b707b99cf29decd2400946d735c765f92bf9b6309eda33d838eefa84a5fb518e312cfa8ab68fbc792d8f135f9fcf3b7704cf41be49eec8a7533bce86aa9ea4fd2dc76fd6568a7484e68d5bcd77dfeb1d8075405a39672a25accf05b8521b8bc58f474d8e2df692c9fd6d807b9a726d5c7893e17318da3e54a91a8c9acbc2bbcf29df6fef26ef50a52fcc6bf5a20f55ab9334873d0f63d6afb315d4a32faf7dae42dceb7a4d05b644398f98f7792deed410d4238e477f79ef9331f643a49e2e6bf5de2df7e6d5b43a695fe63c10ca0acd1fd9c359e0fc0d992a3c2bc5f79762067d5eabddede4b5dac5fa9b59a86adb887316303cf9ce30e765216a8f610b7419ef3fc732830fa646c823ec388f15f25dc391fe76153328e84065deb35a4d6aabf34328a8d5fca31876acbd0c55ed3051291dc75a2d10c73e0f0c17bce3d49c58df4e5cebe20b94ce79fa3ed4334fdc468bd425752ff539f039e7446f1ec28e7394bdc731b4e3189834d231291b12d396ef8173e67e0ecee77314c947d86d1ee3cdc1777a87a941574fdbce693f047bb6ef3a671fb94c69ceb9748e67439c23cf815fd4e8b6590d268fb9feb7e75bf2ddffefcefd75cd4df97d32398d989c42bc85127d545bb76d57cf733db314db4fccdff05f9f270b783f5eefc871c5f5c0a0f919a89053500be3834d9a3c9113fa74dbb4dd359c147fa9cd645d127652fc6ccb454f096addb29ac16e325c340fe1a2d91aac06b3c0bde0dd43ce928e938edbe82913f75443cc2b9595398e177240e05a42bce418c61a6a9b53ce3e5e1360b7e743a9ff555eb7e7eb6d62e7c5574f0a722582f7c97a74188f97abbcecbcd1a6e27a1d67171ea7b7142ff21b1c09693c557b99b8c35de099736238f25e6ad504dfd861a2befe17f2683931f43d517787ac8fb074068c8c9bb2c9fc089e4b820d512b9e0f60d797cf0ef3bf39efcbfc10d62dc823b97cb9cc5170066e727158db83af367ee7bc569dc15ddd93cd9bcb27f66cdfbdf89c15eda7d4af52cdfd442e0796117a58bdeeec2f6019dcab673af8e8c18f9dc403ee75fd92df96ad41d13ecd9eadd8973bf0c18d1f3bdb3f8c87e5df7b362686dc0351cd1a517b9049bb3bf217ebce7311d5e22ee6387cea9e5cde7fc36f54e4118c0b751c92dcd35bb19f6f311dbdbdfaa3ac7efa58883315bec9ea6bb524508e8b4f992c8d8b637cb8a71f81bb6b10e3f887f1e7e2b72e3ee9cf91dc7fb99d4f857b77bd0ed2989bb62295e5637b5ba5764a107f3b048676f811a7df7a98ab57ad03496bf317a93d86b5bae90b3b046bab0ebef96e27fa9ab8e617307a729dd63647e35159b6f65b4df0bca1cf63dd6f21866f1e22b751e3ff8df72b41ac2fa38e93f07f63ef9f18f0ebec34f794e2885df1331f392e28055ed41e7b3dbdea637be453f378cd8cfbd4693d52a2d503b77b40dd7f1ff5ef885530bf9563912817ace59f97ba41d4cbcfc3ba49a38ef933e5ea411dfef0f021eaf55a4a712b3eb1678f6296a5f321c6a4c6791a92ba59036e20eb8b2d9edd168f8f0a6346aca6722191f1d57ba1d879aa78d7ead0bfdaaf307905860fec90d27a6678d6fdfa29b751586cc133cf88b7786a90700ece553fa1825e88ae52f1eee2aec86a6d3e89a157aedf339fc247d0b6bed7fb9dd934be7a3a44ee70c77a335d866dcbe2462caf5bb063eed8a9ec7d0fe3b4b4c1fd07162f3ae0ff32ac71ac2f514ff330def17e2325f45adaaf5035113b4ff187d4d381c5f097fa539807dc9e4cc728c595d1f681ad0fc7a36aeb5da947446dd0a1d33405eb228c830e69a43b76edae9d22b303f81cf781ab1fe598d4da3e70a097abf25248ebed8a7b9fbd5310c713daa69f2476c691a1d73c057962479db84e9dc75824f757727fb80f4a6286695193fb080dea747a4a58afccc751ff039bf333ec80974edb0f55c45e516773b74640e89f92d8b1c3b5738e781cb86b985b5267b563f350452c18f7a53d83cf1ac5faf25a0721df97ca6ed454d845feb63bfe45be3eb77b58929d323e509eab764ff328b3c54ae71dbfd58e11f3a5188f1a6cc205e7464b02d445af33ee30137fdf235e5638f758df43a49bed9162d622b727b8fffffd782467e27e8f2df02bf8da4ae5fcfa3f8b171f03b781dc422d58f76830bac1f328c7ca6eb8fe72ff96f5dac2178e3a83fd831843fecd3be78df9cb8f6a156f71379eb1071a671eb328f40acbe44bf18c335b1c73607ebc87fadfbac5b9072afbc207bea77bf0def92299c4ea009acd40376bc8db54e65b38cbf6bfb0be37ef16c42445b1f695d6b415ad69dd975bf7f5ec7a3e9f1a541eaf49c7f64e0cd8b729f750659ff4095f849d73d7dcfa0c9f145c4cd6f9478cbacac6cf146bc84c398b3af23c15ded16d97df21d0e5b233398f6027f3f3c8eb3e96a5790bf151d8fc0f6187261337fa8a5af2b174df5f0b1ccbab7f44b1dcea7bc86acf84ebf6602f3f80f741627d9b63ab7a838ab2cc3efe899e1d5173fca8a74970268e91db0367cccf89da40ae8f064644bd7aeeeffdcbf6886abfb2f978f5cb7c1efab3f2b5987db4b50f3b59c96a8066459e2ddf6b225790f38ba30f3fabfb2de423d2b978e617fbb78eb99c18cca6df4e47cd7cfc3775ea37677b839cc9de778f33bb4ecf8c1fea716ce4267ff1445c043df4b311f2c631c38419a775c8ca1939e46eabcce1f617cef37182b8b91a28fdd89cf755f87cd2730c0ec2323677c5f8e4e0ed39b9041bde2f7c27934bf8774f459df6a9019f284c64f104e47e6a33ac995db7e6e1da7ab5dcc6ea63d44cf755877fdb6c16b9ab9d1c03fc6dd71f3f3817b7cf57b6ed9d1ac3fcdb07315df7637038384ed637587a1ee7a61ecd3db5ca6fcc5dd019dcbdef0ffc22c87de8ad229fb7d4ef666b90e53c965fb3e9f16bf6ff987bb32e45912f5afc03dd87164cfaa68f892508a5548932be31584e814597237efaffda4180a0846266fdfeeb3ef4caea4c08623c71867df679b207c4167612ddfb652c47955e8ce5b4e2cc64f2db2eebf0d13156dbc1be147ba7b9aba7beb8ec34f4edf3f9287c3f07c68e584eae13f1723a214337facceccbd44fe07fb9a6c623cc5f815f6758bffb6fb71f23e36ef79cb70395ed897ef1197e9bea84eae4f0a3cf1bafb18cb6aded52ce381fe08837bd6fac6edee0a18dddc40786fe25b47fd57f3f941dc04ac5fdd7ef9016e797eae30f75918db18fbec87fd120b397a1ba5f42a63139bdbed507dad717a1ef7f0bcbba6bb53134fa8cafdc96da91adc13ecade9fac817079e6d3d536e0c3a9c7fa0bfb91ad119525e0b1d6564ff67fc51753bcc36c6ece3ac53373fad5b3cdb5778756a7ac11d0f1693e02e34f60392954a71a9a84c55d597eff99c47d19b622749d659ea720ebcc0fb3f15d791776098b49c94294c7b3dc89ad2dfc84ec58ddaf65dc97a91ee7abf629547b12726080b7a035038685ccc13ac2bf601dda63946bf20a63e4eda1ca9d131f4331def94df65ffd3c52cc78ab7516299efce08b3d703cb2bae0bd13b399b7a843dfe077c4be1f4649ef4f5b9dea07ef7c3ec049b1bd9585e2eea1ae707b5eccae7e6c91737d3f472fc478afdf413d3d59fc99fd755d97d6b8a632626b1c69ecc0cd71ad23514fc315f82decee4828ed9d3ff4d984ced71175eea76a6f5ffa48eeceec793977ecacb57cbdbc8481c5fe187b2e21113f57e2b666de95af0b3eab156ae619a75055246a13a9c232629c938819b6ce79a8f7a5b5de8bf59da98a845cb411f1158b18faac23fd9a11db986cec296dbb5aa3da6ee03eaa7e07fab1783a4c44e51027ca65e628050eb1f9febef161e56b5baed993fdc3f2bf6bef7063c9989f1fbe232c5bef05ce597e990fa2feede679b8f5838173ca515ef1dbdcfb122bed7cc1fe38e473c68dcd606c13cfddb4d40527e757706fd89f91aa48570e457208939e58d4a32cb91173bc630aff04ec767f2a9f3c9794f1f82216a8e19e870f02672dc79f77c24c3ec1af1ca894e3f51076a33c663e9497f329ab83bd3a2d62e0aafbf201f17fc4ad817988fb5c9bf458f4fd697c31d7cfd05f1643ec1c5ce50cbeef56fbbfb25627cf6579bc8922706d15a2438768cb55c4ad4fc7f52715edb3382df69836bce6892177368f09613d588e6e8993a0f9b859ecbc2d66437ba90d4bf950e25f03ecabc159f0ac54b7fb5fd649f275c25a0e376df36cdb9d61360fcf74dadaf9409ebd480ef35afc00e7ec2c78538e4ec9d579e89e404e7d673e7d28176756a76d8ec8f8c239bf0db987b4ed4b249efe65f7fbe5a60e24f7cc5b82312ef64fd5cf58a95f4bf930ea7e47e95270604622f20a7542e38a8e208449e51915f56eb505ce4934b43bf837e57c117b59009e2c275ea20e3ef2133de47f8b34aff6c430b259b5462eb8b7c2ad09ce27a62bcbf06b2107bcc36ae71ef1fb5c779e7c768f967302dfd9a8a28339e2603f279d7f5ed4c56ef506acffbdcef0785f615db9f947e8af4db96473ffa455e2d9a54b4bb9c3bb276efc890c8fd4cdeb9845e2b2b075984fd0040fcb027aa2a65cef81287ba84759e03961fec819eab6527cf576fcd4ff3573c83a4a7a94db571b2833ab2ffd30edc9aad59d4b7d09d7796232bd83be3cf41f105b37be5c8fe45676a1cd06dba269af6e8d65d49784602a957292f180ff1ead76e92b7c99858ecd6c978be77eec6367b32fe3dbedf185d8a34230b3e81ee5df858bb6724f7c51eebd153fb5d5a3795e349cbbf2cebb5b3fed9ec3ee3fc4ff73f9bae461104afcd228eb85eeaa17d31cb36d7e0ffaaa2e55deafe87977637d187f9bd7deaddf3139ff424ac2615b3d31e2f182d4ce6aa5ddd61873aaaf38e45f70e1bc689ba0f67b3a4fecdddcbee5721f3f3de3d5fc936a1ff8671bb18f33d106751e274da9dc0d5ff24535f94ef36ffe48eadfa478f4fc3efa148f49311627eb2c5cabde36fd9d5db44ffd0cdf8053f4906f82b6733bf9fb78632bac967f389aca31cd05a2ba9ab91cb9bf0f23e1659f44752fa14eedee155e50a67717ef61ef1f63d55e072ab033142b7e0c1db241bdd09cbbad977aeef81852fbd94f7d373a06b436e7e2c0f2581eec814a1e46bbfbf31579958f61965e312776ef54e1c56fbbe6dec8522c33139cda3d004ecd8d629942852be2f37ea4aaaf39bffb85de66ee48c879c9803de6cbdb7c0f36e8e33cb99663a18af7e839ed2da3217477c6bfbe1d1fbd2e707cbdbdef6a7bdf5d9ec0c5077fbb3fed55303cc0c8f736b16374a2acfa7ba132cf3d60c9c8bc2fe5636cd2ab12650dbeb74905ff34c971b05f8cc53cd0458bb3615df720fbe6fe9a3f75c7b3ded266aa7030e45c027f1897c0918d75ef89069f6be113e796e1866fd7b5ba26eb50ed09fec3f3684ae0130b9cf16256e34d946705b76ecb737afef1093fd3bc2f94f3e3abc265e4d8eb807165b7f21fe7efbea2f357e55cd31dcffece78affe7fb9a3a82ef4abe039d6562df7f3ad6dc5e631af772f14f7d65ff405bc2c778ab5e5c7fa991cb012861374a44d69df0e7a076d60c2ef77f0fb7f1117ce1fc78d3f4be9f85d43f4bae3c377b1f8b7d112fbce95bb4294d564e8bd1cbecac463d4302f23e40238c5bcb497b5d4761c30beb076673a1b9f9acf7423b6265fcb7fa167b822fbceea69eca1386fd4be9e88b43662113f2ce3050c77853c0fe47d74eeb818d9d919f5e56ea09275001faa0bae3b1f9c4c949b3152edaca8ab82fb2614bd824bef4b5ce0c5b9804f63628fd39fc26eaf2982eb3b3ad5efd89898bef77174a7b9cee04d857f47b061b24dfa53686f5bfecfe59fb844ddd7bf91235abfdf2aed3ef3256a1b21f5bafa91faccfab26c091f07f07e7f3afe9718bf3d577f89b701ba71d8a5fef4ccbfe6a5511ef0e6734656c1f4ab72aaf98c048e9e5e6307ca2eeca3668d700c130b79725920c21f04bf5e15bf267742b1b77b106ba3ed36e0c01bf3636682ae4f369b87f931377e8fa23e35f2f05701b53549db7a1ff93b53290db3c67ca12cecfc355ba2d4c56b3a665fbbab95e9a39ed354c87c5711025727a103399ed7c10cbbfa71943de38bda438e2f472ead0f904689bd0f455360bc5c47f0db04657ed483f30439265ec7ff0ccb5f1fd71dfea75ad7b0795fd67c08f5bd4cfb22dcf3a45deda04e5ee3b82fc51c5e02ce371fed0ddc7b0f3084a272685d9f60fd52aef5057c07854f3fdf0fa85f4144cf31a45a7c88f9f003d5de45c3f162eef48468255f750555a7f91ffeb4827bd94e8af8dcce73e38b3634044f5c501e57b3634e2c70bc52196a4c26fc7a8dc77cfcf7b6c4edf964386fdcab17dcab058fd4a77c41f9377935927ec6aeb98ac0d5de5297e6d51aabde7fd736efc71a34db807db7ab9350b5817b758a3bfc11ef7ea5cdfa3808385585e58cd6287e98b351d72bb7710aec7b3ca4bae4f1da5ee18bebddfcae75de0bd337ed8eb7d59781f3c63d1fb16beeb0f60556391ab6cd7f1a9f5e897b97dfa1fcfd348e847ceb1263123812e5dc2cf0f645ce777e4ef2b85881030b93a22e53c1a129acbc9c0b17ba701a023fa612e8833b9c4d4f5476b9de0ceec3de31ec9f161e3d6bf0c919953a85da8ee9974b6d682e237157e9c71b97a705b95981708e2281c3d5b67eafc8bd0d8d9f858e7d19757552708b4759ef188b4a02bdb6a83ff7640fdeee7fba8ecfe47f3b7daad1cfac479d5ed7b3e5cc77843476847ea9933ff439db199ba72330e4231138e9c5115c14d09343517a47bd07e4cb8f12e5120d174f650df4312a4bfbd2b11c37d7e7bc843d75f9a25ed0b8a7e7aad2c1fe65360af3b15cf7228fff2a16972964b9ef484b2f015f13708c7646f5b4ecca1f48311bc5dea43535ed345aa18ed8db5d0dcd07b58caa7ade1bce57d4501bab75ee0891d8b88dc8a5726b09c429955fe5efcbbcf1cd17f8d1d0d6f801467d29a16f2dd75578a91e55d1f680f2496d104f676b4cf5686d681e19d7d406f2a5c6f55fae83c5e155a9df6ddafabc0a4553427e3172ca7e4e518bf2b4fad58f5247009f7f1c7f4f7a6f73f05fac84fcd96abbfda2c6e58ee99569ced1526b07fa66da8dfa4269378fba8cff7e0bff7d3ede51c6d1bf6a3e13f6ec33bb8d205e604e18f70af70ebcd1479ff6515b34af23773f896cee92722d7977e1c41c78aff0cb4a1c5951f5e7833b6113b8deffa1b5f559be1038a07d57afc48434569301cf9c3781eba3fefe7fcc6f0c9df2100f8c63b83581e3201139ad7e56dad656cd6b650d4c15fc356ded3a3c3f252cbf67756afadba33aa4b7ba7ce57bf051f64ebeeaff17c1567365c86456a7877c0b106312596dca9aec828e236f7c8cc131d3a83b3952ce4c75728c543379a5665265af604d595fd0a6d98f1db2f31579e3af5e88f7dfcc0ffa136c8d71e068afccd1d33b13fb7ad4456c2d2611d501a91cf98762dd67e01df463e80bf87e4bdd701f76d33454cfcb5112a7b1ba14bc156c6bac0fddff4d3a3bb513bfcae5fe2097743255e499697f2e9f34187efc1ba9cb93b67a62b3d13cf682cf81f9869cd33e1ada59a4f68095bcde05e0a5842ed8b5690d05f0f6fafdbc6611d644534d1227f62eecca8d9c436d7d86e8f7e852d30fff69b17feb3532b7b92fc6ddcabfa3fefb3f551fff27fd8958ef7bfc41b92f29efc329187e20a6b0f9a59ca39fd966f54c1e436fa3e34d241237c4a22807a3e50be5d969611bbe903f84feeefd2db8217b871f74ae84846230c5b7d67944cdf5f1b0af0495b5b578f9bcb7e45c637ea3da38f8b2bf3a974f722aeabad27dce23f46897cc2cebc4f3473dc6f2d6e7fae089ef87d8d9b1b62a7b909e4fe147ececc917fdcdf79800f441958440b4a8ac18014b255a5c9b8ecd75b9d69aaa4b9a52e9dfc379a76d3fc5d6ddcd737b3f36cee77eb6b5fff0b9341ae43fe29edd721e785847c8e281efe882dfd1976de39dc6379eefec3a379877d452889d78e5b95ac3fc34da9d936078d39f7b8ee7533094516b42f0c5eb3365ff869db6fa67a58d2b0e8ab3c7f8b9eeb5be70fd6093a9bdd4dbeb9b9ac0e947936d3a09dc653a25e3c5682a5b88794d15d3987db31693ae4e0a1f8be950ac3a19ad3e3293e25af5e1ac0ffb1e181efddee771bd1f4e68bfb11e49b3ef808eb551bf23b2ee0c36077b634fad0ef939fbb4cec4e680de11d86be48dc619564bf047476eff2dfd29a617bddf5c4b77b4a27c676c5c850ddb797aa7556cd6724e7836ab859a8696bf6b5d0b6f3668ef8f2694c34b0d87577e0bf8cc18d68ce6d0d37acecc17ed39e71d6ac556724656540f86bfd99136348634a46d5e46396732c5fa547e07eea86d94313ec6812f5beef8c0938bb49ea383b1dfdf4537bee813fc7e7e7296e8f973de5bae01ed5702dc6463dd7242fffe2768cfb9c699fb7bfc463eb67d1a7f4b69df039170315b56f16c436e767b1fcc752c1c19c7954d45ff78feb2bc7fe7f675bf17cdfa57eb5c6c224dd027e0683963b9faafb674dcbbf9b33cda7a1f5735ff4c755dfa1fab89232ce749afe0da28b14ed5bc36f7d9bd9ac7294f6c1c5c9f159d5bd75f86eec717f7e0232cd062cfe67753d80551e5bb4fe7383f27a2e708a786d87cb3dfa0362ebeedf64c7e62fee244d9473cdc359197e1d6fced3399f795fdd9e4f7b3c0ab06dffcb5b6815b9ea3411d6762dd72b429557f571e9b33c137a72abbcfe249dcea786ff62dead97ae0d355ed23cd111c92b7914339ccaf75ea6eefdaed628f3cb9791f71ebc1fe057c5de55baf9dbd465b82d5d7acb481b11b9eaba79e65f635ce9a3d92fdc53cd11a31cd672ff3d55ea7e5beb9bcb86ff2b6592cd8bae677ed3d77bcf7a6c21543d95fce3c47997d075fa4abfff1a18b25d1c273ed4e280a4b56f7a082a1adeb60be7a976f84b9b31c6572a73fe0f9a8e85b391eaa3f36eb50cfe40a6b6b649dfbcdba5ce5191bb58e4cd2e2b94bf498e7ae269ba2c43c36f29a34f5792ba7138bf0fc8ae9a4c3ffdb54ed5d62555a868ed5e80f8d543289925e27148d23b319abfd41bf4f816d02d3f6a738cfbc73f6542ebafa26e4f3564e3cc89281bda3fcdb53791276f58ee79cfeb7774ce9a728ebf471fbf1745db7c83134108b87dc7f6237dfecf136f1ccfec76a6a91d9edef6c56af2d621867dfd578b61e2f477d1d7665294ac8366075d3b0afa34c56d8581696dacb6255d9bdb2f6b99f8c62ffd95c56794f2befe33c6c0d324a5aeb6b5c8eb48aaca7ebe84fa5465dfdde5ea5fd902d62283365f3d57dcebedb28bf3bc1707cf80bf6f20d0603b188e5a5a1765a0b6c6fced770ad4f86f3d75b868999f98e92f8ae9ef9aed909a6bd93e79abfa34c68e4bdb35445b2b047daad61e7159fa7e79293e79cfe05e740846fac9edb91735b5e56eadf1dc304f14570182b12ad5b3e1c2f22c65358e62777ed5384bd3e1c57380c804bb4256d0839285d7cf8b4996ec470ba174d55189691caaf4e94f40ec841f09c336a131d0a5e84587d5fc4431d758c695fc19518a9ca09b1d9d0b13b81dadb4419700e7be448fff61de34ff1add0e91d7c77b3401df2869a7b1bd8f9777b3ad1857868defba1e97f3acd61adcf175bd7fb7d8fe7d3d089c3a8abafbfaf3bdb622df2fd12c7a3bedcf8f7a22e16e3aad8bad3277da9603070f6caf10cb9f1c934dcca42cc308d6e77bc9fabe07937fe3cb05fe95e6d1f53547c6ba08c6ffbf78b8b29fc58dd8dbf919f957f1e8bf7f936ae4d5af3f957fbd9ffe8bea617e23b45bca9e4705ee57130f857183729cbfd8f51fb82e1fa804b0a5c3f8d871b708c1fe3a4b15e64553722beb36b9b333af11dfb0f5b934a7b1fac9dfdf7a8a39c82e67a7abf22d5264187d5b6c8dafb5a812b824f87be8f3525e728ce76ab57ee08f49b5b3b0073e0ea56ece86de307afd42cc39c65f6d05ef9d3624dfdcc770a9c0e72a3dff6059e6bd4870fd840cdb1cc73cee93cb137a3be9cf8d0a912a5033e32baa6ce7b8def3baac57f64d9eb42fe91cedc96058fed0de46fd86a2f8b50ffa47f6b1ff4f60deb7dcb9fc9ce1de51260b6664c34e8f753f91276f56c3e95573130ab39f70409afbc6ab7f37008bb939c7b02f81f47470e473770cddfd4b7e816f1523d83bfd177113b7cabe082e8d96c960b35b9639388988297580d7b9c1737e96d7f6c2b385ce66fbaf6ff15ff592e5b82a40799897d06fe7b827c59bf09974e6442793c9cb6f519adee333c49edf9be7eaac5a6be9d97578e423dcf2315812d2dfa2135f9b49fb5c3ce1f383ab0bf698ef301673884ede392d457ed25fdff4a2ce9816e59b6f11c3f244be0530d575aab75f192f3d1cfa4adef9ad76f3c90136162a4e140dfc58ed5727deaf598c6b3bf673721b73670cefd706893ca1cf63873587bfeb375ce9be42cf63738463c91ecbce42cf83c8e1d8a7786fc1fb49cbb71e72539eb022b22812b89e5355e7551f4af226393d839836b1b7843e8d829f09423e0809325e58ef4fbf2264a7aa791430eda502790b55e59a383e6e1b37c875379cfb2bb59409e58e080a312e345ed0ef800e9772fc0147b8e9e862ae9cca72c06a29243d435b398e6a299cc97f3b6e2ace3a91867832ef52c9e5ecc3fd72780b663ca1ddf3b7c31965ed517995c615845fbb4c21c799990f94e8c9c447e5e73be67045603b0c1c66f1bfbd6903f571bdf2f45ff3d07c7bf4bdebe4fb5e6bb841f0faff5ebc17ca6b0337c57f7dacea7b1789d3bd3edca6f63a65355dec15cfe8a86cbc427bdb5c7fffb6fbfd91785bf89aff87b2be37dfbdcfe24f0999068bb78100f6178a2167af8781dbd108fb3b3f05b8af3d5c6de2551722afcfd89e7082962a8342774181f357599fae2621faab6e83ba75d336eecb408ca5aba3674bb9da65ef3e92157fcaebe0c1de10657f6f95c535739e7f3a79c236fb5f8e7c7eae39fd1a5f7153ed39bb3fa027f163d435ceeac49a02aab28e72b3bb6ce9f9f8d77edf0250f38b781f9114b1f6ff9ceafe9d33df1db73f42ce7c4e865256f5bd786fc28f385cb5a50d5fa8837b840cad7863b079c240c7741735086e3ddb58622e559a0b9c9a64a12f84980290c1c6587f77de42c27ac3e177c12e269c772680681e317b82a3c730abb581f5a13b1cc991ef5913b602da690d514b34eebd822a7601f8a127d3ebff36a6d1b9e23a511b8dea0df6fed6deec7410d758df149d2e726c1504f61a70053128be44f307d5b58ea72e96de1e3a5b580f2f3e1c49803e43be4f6c1204ee3fe6931438e7b1777adb243ad48f86cacaebd2af7edeaed094e6b873b21afe9d23da77e7f03ddf554df779d1547d6ddeccf069f6735be759f3379835fd08e517dec4770daf9eb8ffd0f5bf8bf3f869d63b435322f430d8cde2918dae948d883ef67ef4ef7ccefd88e5f8d7e47243bd465e7fb3a96cb2247a7cd9dc5f36fdcccd7c57f05bf051ed22206527dbed2bf2b57ef732c37477e71efa4a8f846421a7158816a1f425148bdff454d5e22fff61de9183a645dc811962bbcace780525c7c1aaa779cc81cfbafd8fbfbd4ff2644daf6fa1df8b2a3a4778cfb9b7fdcaa6f46e1cafbf2dd86bbfec62ed3097c53da8aefbf1b75afedc136f4b63aeac9e5f875b25f8e1cef349a91f87bdbfaba0c5f715d274e2e38917f87a27d08dcb61c7883b7a773b33596f38474c24c38056a8f8409797b6673d5d7499ffa8eb28e6bb8b3629ca88b5bf37f615d7e84a24990b7a1f1ce62a36d591d3b5fc77b21cfab1c2fafad26b944e3396299578078ded112957fc12734ea967de49cc35e27148cdf3ef5fd6b6dd790731679f956c8c111905ff347cb768bab3d4eeb09d07bd25297c758edcd906318aba83f8e3ddfa9fae96ed6acc801cdfde81ef6f556fe1d76cd23f83b43d43828737a354e7fabbeaefaf75fdc6f8f72faeb7b3049cdd8b1b339af4f57bc3ff64227ec166ba37f0e1f596b43e3daaec19061db1599d6020e93f87fc2bb14a9cb3cd7605bf9ce1af924f9f7dd6eedfb4ffd16cdfdaefb8a19cf4ce1d7a0fedcdb5a6305878d36c4395a5c636b2cffc4dada2bf81c2cd4c7423e35ec89be5cca8dcfda1456311f2b7902fb1e7524a0eb55f3499aeee336b929bcb66bf6ca27f9a8386db7b35f8abb85ad5d041d52b40f3e7412ae4e4572fed669cb3d393db5c9316eca594843c73846097216ca6fb65ddbc67570af6d7273732bbade29708475d8d5c9276a84f2f1f1459b9cda3416ea4428f2729efbd12e2d75576e5cbc393677f30d1ea74e99675d3c3b2963df8c8fe0e827c8af1a2ff25cef25307b8ce79be25688364867b3cedb6262cb3a7c87be6308b4c660572788676baa41f99022dc1789b2853f217421fb377baf6bd3388fd7dd2c3c5139153e47c87e60b03d5a9b48597b624f08b7b5384ce99b0c45295f87ea1886fad113ed0bab9fc3e45089415b6baa2fe07788b9875b23c159450c3eea22af3daf9b09de7be41d7b8eb10ebb76434ddaeb198de01bbde2071ace665d87f45c3985ee587fef93b8fcbc1601b5c7592cbd59ff70a46dd83571d77f51f7a8ca26821a3d1ddf51f69f38433cdc11ee9fd417a582f3afd6f7f2fd61e7c979acf9ecd23089c1f7ba0ca7521aad1af5eb4a9d88af71b7f0ce24f41d5a2baa3c8f640f5cb036a4bc5dd47f96fb1e8ca32712e054f6be63a6de54be94184b157618d9868869d15cfdbc4606cdef74fd6b1baa9946a271c47d369fca477f056e91dccfffc4773f61fe3ef846eee391b7f650d6a37a04dbbfa7ebbb66b19f8fc8bbc55e0f5cb325f6a890a5d77e70f52957263ead53de36d665659571a33eece595f84de57b3c6e8c8be750de8b6fd6a6c39519964a4ebe636681eb93b6789319f20e06fa31ec1acc86e0db424fe5c6751c0f7cd8e4cd77ceaadf9aaf56e3c88c46df413a57ed9d897d7cbd9fd6b14ac4c035a56868afaeb547eecf49c55eb99963f37857e71af7aabd67dc1171652ef23ef8c02fd0fb3a8e1d6012d4330196a9d40557717c2fa7302ea513c33fb8b53b8d3e5a86610a5c73c7d6617e6df3a35a57f9b12fe87e7dd959ac621c4a7d68e16d370bb67689ef4c3e814728d7bd018ff008af7a5e46898258c28a73a6b8723abaf697eb6b0f610bb4ce719a48af9c6bd67671a613dfc1be53b63ef498dcd7b9abd4bd0106126b0edce0163f199f1974ba8cde79aa9f01230b3e24d42109104344cd66d527b4ae36f440d5f80d4c60289e8fbe48d6c0fde5383e703509c7a83b81df7b8dbb5d53a123e8c009a316600699a8ad78eb775e82bfb1c1b6e6ddb977f5a85c4273e548dc47fca596c3ff52de7d931cc2bd1cb13e163ff9faf2f59c47e2a2e5da7b3cecd3239be26de45ef13423c7d8c56e45ce36e4a85cf31804e410ae918315765b3d9fe53c29ad9ebd54edd836764df9ae6bfc6eb85b1e9cc1fa5c37e848e5fccc8471cbb5a8634b8cb5f63ab604b93d576cc98a614be6d7fe0a3fad8c8b77aafa82abfd6fd06db83634e59cabcccf3ee4d7769e84aa025fc32a545be36fdad5a8efb69263c5b7b9350ec1ad0a5d344cc043e513c4c6b44151c7b35e93fb918c416c8e62525aea2f51e59d8771cb1b1db3fa1ecb9b653ae5cdef5b7339e29cc32702bd99eb6fdfb5af9337786bb57ed577fafa6436507c5730144bb8c68678e7bb09ff8e3a94fe16b177bb1b76f53f391e90fcaafeeed1fa3dcd35edeb338a059a6aafd85a3bcf254994287bdf45dee0eec139b133d8e42d7d202fe159cbb6595ccadfea04be07e87a792cc0aeda5907df35e91c327c54e1c3a479e3f08314f82c6d08be6df3e889bd5d94b5c2a71ec22eeb5fff09c69d485648f9f2ac85a59e4994f4f6de14b9006702debda21ea23f95699d588be9d4a35545f6dd9e259c039574ee6551f137d4fda26b3dbf7ef3a6bd49f39eacda8cc57cbf78dfb0ef4997503452cf3935fa2e42d5beb4c5b4ff78c9263f13f060329d2fe7eca4b97157bf51e9af56817903df26d5c38027165047e4ead7bed6bd63bae092c63db766168a5843e5e03ba7854fb13667d460da308cde38702461ceb001d7bcadd29e449ecd32704ed5be5dfd01743ffa699e8ffeb66a9ba7deccf523e14c5e68fde0cdf9ddce485ac7a836e13c05f64e650f89bb5677b1ef1a7f1a74d41b5c3de57f3e56399723f68d76b23eb777f33ef2fd63a14ab67ecb3df64a8e126df7c27e0abdfbbaee15bc47d4959721a9d555798b931eec8cfdb8af71e73170e2ed0b7770ea8bf1afc7b8a1b678c572de9adb698a49e4b8c4bccfdc18c419fee9b6772f479f6c8a89d1768b9f2bbe2ceb1d5ad4339f505ca6f8f6599e8b5d284a5c4c74d37e6632137d7f6023f73aedebb671f3086bfb2bec9acdf992f5f9188c5bec3f4b3d1f63f52cddf21b5ee3708fb9d3ebfb8bddabc3f1757f0dc7b0578f9e68522c3fe62b56203bce127841f3b1b4be9f908fbd9b4f7bffe1be2d7c58cdbeb2b3d41eff37e1ecd9263f99be9b5f395a2ff051508c5e8eb3cbf91986a8494e3af9dd70a27cd2e190547cc190a1f09be1eff28072f3af3ef8fb7f489ae2f2bcf5945ee1a4aace6f658d248a9fa1fc06cb70dcef4834663a7d3f14389a27b1d987bafedcd5a8cdd0785ec017fc457f7f6dee687b0d67a5d1ae95bb816322afe317ce9b9f90ed63bb88274fd91d47bfdd68cb1cc321e25ae7b679b8c28fe7633d85e259025767d8bf9795c53e6e687be5b23d809a4d23d1d8d0bb7d7bed6361e3d56c973ec55ed59ea972f873e4d883b3dddbcd1d3f0dd5138f5309dfdabf80d5e1fa9c0a8c9bb65afcf3637da6f38678eac8ceeffa5fdfce88c3fe6198b7cc774ec07822067d8cb666a4319d808d7fcf302a143be7f6b5946fe3618c0289562df7e375cc7f81e7b52e9ba3a22f6db9fe733da1e8cf1e38f320b1d7719f9bb7738c86727b2ccd6cf3820c2eda2e73753aa178dec52a39697d6f11a9bd13adc1046e138a512647266b8b7ae7cd3adfcdb9f859d44fefcb792e0ab0b4970ad7fe0adf914f6cfdff8bb2f74a0ce1859a23f53d728a54bbb5bcf7728e3d52bcf7585e55f7407dfeb5bf359607b8977caeb403b8fcb8fa128da581cf65dcf69cb7cd952b794f7faa661aaf50b741b94458cfad0c2e23d8be429471f5faca33edcf6fa5dd477772835cfc607356ce07df6f8339ebeac4129471cbfbe4627c34df278d36136b3fea2abb1ff95c6d29f794735a3db7e961bfc799cff2de3ce77c0933f94f98f4bae14a5e46995cf61ffc7e4caf4aa2c4260c6b06dbfc30728d634c6b7e8233127e5f9c69c46fe00750a86d7e579fade08d50ed7d8ecb26ff527f126aabd3dcbe82177c93eb650afa58a98b931864b47ab81f300fe0aa6d87ffaaea0695397d90d74b63885ed21362959cdbe78f7a4fe50cf3dfaf10f7085c4568aa015ad319cadc5dec45338d5c9bbce6abbffb1edff6a7df8997515b3d70d1bc97b9725d5558ddfb3cb68c3a5ad6a0e79ab63e9cd8b77856ed3d70a413f2d3e32139f93321d29265eaab2609c969a5e5bc4dd00f2e39efa51955e52f6ffe99cf66ebb91f2fec1d16531695d3283149d3dc4589bd0a5ae303c6afd42029dae6c6310aff96a6ea981f6aefc45d1db526c1efba98ba136a1fb1fc7e70a7001b48739cc22eb0cf92809a86316d7f52c514ec3470e50d8199895027e72daf259ee72aa156bd3f65fec19b7a8bf89e3694052f39a791b8443c8b1f4319eac44bc8a6b5ada5e8ca644306ae60ea33cbfe69db2ce7b1da3ece91aafff744fed76cb2da1af5b563c4fad51053c15a2d7d557abbf296f442979c56eef437f2949023b80a1cf37dd49557ecfd54e39c17ae8fa398175e4c78a8131fb90a16c51d7fb5a64555c666bee3a7f3bea0e23e68bd2eac3f268d31bc600b6fd9f7548cc3667a90f6e49ece6d67d4f6828e1e76adc395a389e0cc56dab41ae51deed25855286f6ecbfb5bfc4c7cebf63b5c3db109bf3e04ee0b75aa96c7e85b7a626d5199a7ad9eeaeb1467e7d9c6efb0fb51bbe3438708e1f474274b281759516771681cc3216a4df60ea3bedc8d12f8566ce0f58afa5ec8437890fb5ac6002e317c315dbdc8c7a739188c6f3eafab47f32cadb21617c582f6e5cb1cd803e7163ff6887fa1010f5dcc834be7818f89e6dc5b573f3ff2473ede3dfb1c45d93ebfcb2e527d7dabfd842dd31fec47d38fe324391f3d71b7409ee07c25c7df33b9a805b9402ed77460cbe6c09efe52cc9f1672ba94f3e567d6d9ffbc8d3bb0fa32a38e70f487367858faa1da5b07991c7a53e15f4de9fcd1faf4bde77721db5b96b83c46fd277ede369c9f8feb0734ca8cdafe6ece6bcfbc4ea5ce6987de3b9d2893480c6e1ca5bab632ab33d313e2e6b6e8deb7b05f87f63a5672ce32e044ae67e61cfd1019ef11e28ca2f5ef785db35bd127d4eb59fb166acbd058b3e1531ff884e56d9ffe2ff3636dc0e3e1cf7e6746cdd70abd4d46ee31315572c17d3bb721038db4e26b80ed07ee8824704c024e8a1f6b4df87ace179baf5c26bc6a17e5f88fda9a71797cb3c035f62de5eaf9a578e5504f23b153ea43e069f31d89e118e4cbdc913a9ebb58042ad906e0941497c02f3dd23fd05e6b5ead28219790f166b5bddf30170d7ac4a3bb3f8d447009f2e73714c11d1693503d7d155f53bbfb2bed3660831ef832b6b9bee74127da56db39d731d0387fb84b447b172acc0fb6685eff67f1cc28e925816b767de7ccb8e01aedb9a29ef3c2c6b984aebab5dbfa802f4fed9cbef6eeab44bcc9d5edbaa2b2f192de9b9b690fe6fabe5f6df5ad6818fff21c73178acae6192eeec6a69c333b91c68870ff203f00fbf3fb8cd6aba79c7c71a54ff81bfc2023c4c4b772177720e211bfc095015f267818fa2deb03153ecc617c443dfb28f185b0ff689fa7a8a199b48e4f2c9efbece9185d703a4c1aecc0877bfcda9fafefddb20f746eb766d6aca722a7d29422d5faaa0f9e635f96ed173a620d57a30dfc0c7ce3b1d8cb820c3c134a47539534b727df17bea3a0aed6c6777d12c2079ceb6e47bf52d7f59afb283de237a498226aef4f5bfbfaaaef38ad6bbbb53a271ab3e58af9b1dfa1ebc66a6fe975ed5dd8b52fdfa7c005576a5e13c46fcdf8fb74f3cf689542eefd1735e1845bf91dca7d527c1ff701cfef9fcf43422e2de55ac68de335cdf3b6de7e3b5f3764149d2f11753ebfbf12f3108515fc93a168fcb9ad6197cf879f36f2ec627d12fb60ba4bf8d4db72c10b2f9e17e8a0ca1cfe952df3cb4ca157a1865fb4f012e582fc835aadfe865ae1d7fa2c36704c178a3f2d30f55319dc57c8d503feee12bbbae8bba4a8d3224227440cc61763f0722de30a7f4cc9bf0b5cab236d5be2f3eafcb46c1e7d368fbad0c09f8bf974cd0e6af78307bee18e2af644467937fae09b421d893c478acd23c9e791e64445ee30fa7395a76c7f4d5eccdbb8d9030d98a03bfd0c71ab30b1df10cba138899cb7f4188b64e38b76e7e99eadfa5c6fe68e9b633cd48f7395ec83d631176bf7e23d87bd4a7d765126ff0c1d29a5b9ab8944c2067efd1b1d81c5e427fb4a8d63c843acd33e70ded29faf717b9473c3c6cc8dab54fa6c8709cb431d54fc12edceb438fa68838faac6a7f0ef82ff0ef18dc13e4ca2bde7ca27eaab4ef4cb84ce5f4c26ae7c72ebf6e5a7e7fd553b7be2485bca2db349671a6f0f34de95bd5f1eebffdfc2bd3d5d2bce3ddb423762ed72f542c1dbb03ce6afeaf195758b1ddd809ee235d4cfbe391faccf9437357457bd98de6fd406cafb55e8ccafdcf5b1a3efd9f7b9719ad8d177e09c9b7484d917791f1ffa2d6d4b1f4f2de3dbcc367ed9b6a1588ff14dad790aaca1bd823e58f4ff337adacd9a95f3d1603bd5f3af37e9324e9e63a62a38fedca6158dfda3bd180e0da22906412e7f5bce08e39bf73adfddc69c591d329c6dc88fc767b8d11f7cd34f79190217d8657abc6a9f42b527698a3f996df3df45a2bd8e5d1d35afe8ffcf1dca5fce72c425e466c39f94c788dd47311e7b1789f68cf12234d85c050f8d76f455e5e04d858337ed2d63d7fc3dcab427bea95ade051d63d1efbc0e6dafac6d1765bdcc778463ac5a7b701a20576de4f480b1a0dc7b8d98c621d654fe66b5cef5f25e8aefa19fdac09859058ffcd020cc7eba848eb20bfb27c4db2e91da43ec5c647d2938c64854da64066a39acfc29722790b7490eb456fd4a2e6dfa3b6c3cc7e79dd79b673e6ed8fcab92c325fd9ebda73fbb32f82d501b785fa9a7b18eba461366a53207e067a2f5cfef7866692d7cd2f90f9c9bfe54104653f81a8cfdc83deffdbef01eaad1fed770b91b51cecbf36664c3e6d23867a8b6f78af56bd8773c798cf1d9eba83be6e1e284485ca20e57da5206bf566f0a5c3534c67dadf538b13695fc3f798fbdeb39f16d1e78c1e18e3d70c11e88557b47f1e9347fb6e24bcfe44e9881c3fe9c228676c3872c44e26277cd0d2af88fedbde790dd1d5f32dfa62fe7e9a91e9e28275acf737b7da7f51dcab04e31623c89b20fa65c592df9e24b9c33e71f2fe439cc9d38e720b954fe9d3573fd69eb1adef1fa3cd5ab8d4b24ec230d39f7abe67d1d0dc996cfb5c2ddd7e5f8f9716592851dc3b0b276f7d878f23a077234245948efd4afe81446274aec65e88ef7a64a2e050741e5be93a2a4f76d668f79ed57f769f1ec93da541fab99aa641e7c5dfd67f7528e259bbbe0e1112e7c3b90717cb5db8f9971e2cc77d37ea471a9b77fa3e21baba73a02e3e42f319ef95d8f18bd6b42ee50df4211570afbc229768151623958620c5f21eaf16e359ad31e0b88e7def8fc1eadebac9ae70ddfb4bb3d83cb2f2de609bf1bfd1d0e6436d67b9b8ca71316f3c83d3bae0d6ea8b5077e9b81f13b14c9bab53ec8e5bf6e5a274540bd464f542e711f71cf5eea89d60d8e0f7977a84f32b98dab5f73e3a7f40ed8fb8eddd1d43d994fa12b10d1730c8972e9bb761a2536936332c5f5e1bef7333941cea2a6da93f2f9818967297709724ccd8e39b156ecde1818dfacc180af1f56e6ad75fc237f07be33f135bc4965eee0eb49846dc9dd48e7cb10ca7a17cf646ad5b7511d033debbdad3f94b39158aec3bebe0ec22514cfc0181ec3462ea6da1a1bf0b3535df1efd58133634739c4e026eb4eda72fd9ee93b2c37f8155f30e694cd51be6676899dcadb2ce2295f9bf3aaaebf2fe66ce42882af121a471f39047af436189a97467ddfb511df862e964d1ce912bfc0ad6c4c5ed0f19abed35f9ae3cd202df9c8d5de89f108dfe2714d7d33a8e97d1473a7805facc7b84039ba7e5f7bd7a69dbda6943679ce95d3d41fce798d2acf7aecd9a7b6f73df759fd5ce20cae7a676f2a00f7d081cc197595e5c8859fdb2e63293fabb951f697622be7d801360bb954fa32dc1a5de0043818793376c83ac824e8082dedc0f639991487b5bde2ad7e6c150135da4609d59178fa63433da0de09588852ae604ee95dd58bb435ed3bd67ac59707e5181be4416bbf60de0ef4d80df448d88fb258e5187d890794b786b7635df162636c2e37c632da4ebe88e5aefa8b078b0098c7b550e626ddae63718f8c84d3ea27f29e08703732f8e1b69413127c8e5bf26e89fb9def7e143526c0c3708cbb1b7e4e936ba79eb83b4c44256bcab36b8f07cae706fdccdbfa7f61ad5ae7e765c6c74bf216796e373a52bda69ba6c4bed521ae63450b700e629ef8f1eabcaf6df515f6fd87380daede49df3d3fc003e97d2b93bf05c05e8aad7102ede249957b83de21ac96e45c1576e176fc6f1153f839e5cd53ad6f4fedabbb9847fb78c47442c68b2246f3ea1ce7fe431d1800563b434aa255837eb0f9ba6d5c91b9538edfba3d4719952b3afcf4ef16ec61f52ca1cdd7eb24d13b31cb6d55bfc4ab5fdfd5a7665f9ec515bce45ff77b6d5017a4a8ab55f843ef63e7e04d8f86948f7713b8f69ee52c317f29ea1a1a69089f29f581b1fca4e92d072e6ace2e49e06a0b135841d7843fdba8d66c47ad2fd433f1bb1bbeed52eb735bdc8a3eb8bef382ed924c2ee38bbd8b5dfb143bf1653e633cede0dea45c7b5207b29c72c989e965249e8f142bbd12ca7646d9db7f2387fdfea53c547d135dc7093974f0b65a932c1a84625b1d69d25a47f25545f4ed1efc187bcf1dff8b3af15ea63df3af4f4db5b7ce7d13a6e0613f0c96d6cc2afc993456897db167b55b37a16808d43e2bf639fcaa2b791a3bba94b703cc9e7957d7a9d0cb6ae3bba91b50d517682eeb56df84e2781f754d29546de643bfcb35191667bed0d5cb5c2ab1b18e236af8afb10f22f0c83bb00f6252e822ac9eceffb136e6d1bb9c8edeecadc2ff9ee2670b0e78eddd47fd11d5cee08ff9feed7df913f69be81fc1af1caddf524790e2efeeee3cfa669dc6c3ce7bf44d821fe73fb3d4411ed5a304760a31855e867947df8bfc0efc3b70f2bfb1711eddbec6c603ac696ff973f551c164214ef0c9bc60b4993d89d9f7f57c6f3cb79df375e9ea24541093b29367b53cdad6dfa0eb3ccde7a3496e3b7dd905af9355f038b593dd194f76bfcea9047f6dac4c78be82ea9c9325fada803de6c825b1acc745f3cca2a4f72770cde5bc2fadc34c1282ac57897ff5b2398d8969df6f64edca4a9475d031969eb86ceb63c9c6a7e6f969bcdbaaed17f67daec72ffca17e8c28273dcb831525eab743ccd577a425b8fdb4c1d2b2950f70c4af03c8aa8cd58a40bb579e2ce4fdedf2ba66e4186ec72fe4ce2cf6740e32e19be718bf9ff8d4aafaddd213f7ec9d4f9c95a6fb26f7f1e04efe1d3b791d915157a7f7f6dcd9e32e425d9d7d3e1756d31db40c1c691c8aad73bb2ee38f97d6320d37e76325af0a58d77d81fdf31d81729579e239a57e8c4ffabfddea383832a2d4cfebe3a9c570cb7b674bdbdb615e465deff85dd99d46b30db5f3465de4d1007bd2a37bc89f0a9db06baf83be40d71a7217bafbe8227d4e965ee7ec133640b33cf51c7208d51e3f77a8f8fb6073fe3178cc2dc9b399a3e42c4743f90e5bdc9ab7ba7e6e6e7c5e52650c3d7154f56d4d1bedee2731a72a16a2b2d61407d1e463d6970138785bd7b08e3e8325ca3c672fc435ffe543d9d2a8c7156d68fd25c5d1a2f6e77c2a2b1e6aadbbe3147cae21e50c94a5b00bdd1eb253c9c2950cfff0ef91436506b014a8e3b8b9c34238c6ddb71a62d6cdb18844f9030c6c48f465b8117493bb1ff33158acbef12bfef35855b6f40c26c229ff597cb3f0a3bfb837f275e7f3de17edafe40178bb6355d9d5b07dede42a0f0b53bb3ff23d2895df995c31a70db2a25116946b36a9d75afaa47e65a6d0373de78cfb185c436b7f2a5df7318d3548ab46ff00385fd541cbf364b5c8c7068fadb96cf011d465c9963d57e41d64cff6038d4f235ebbf19c941f9f062e435548fb3abe16e72e688a63ea4b7084f84ee9975b4749ef12654259db541b00b3a7ccf2b14e16a14a70de50ff94e19e60a3c7174df16197c156db46b4cf14e34275a4729d1f61d4ca71b6b6e18bbeff7d9ea8b22f37f925d926fd093c16b5dba12f020b753a04db67b82bbe3cf055218d87bae4250af2618ff1d6f813b89bd2decaf3e9cb756ac2a44e3dd738790959866d79a7d68bd7f648575e058e7228f4e69cc798c6c432aff4d514bc33d71c732b512e91aa9368ab4b9a22a71af8681213f2ffd39804d666ea39b5fb835b8fabfa7cf9bd21ffac17636db87b9ecaf4f25d2eb78dbe9c3ba81fd2360e62bd924b36f55cb2cecf646d9daab92c3cdc1af75c468922c1c7dc9a4fb03646beec2ff619b34ba0f3426e66c5180afebdeb73bdd6bc14953ea761f3dd2a8559cbb3d2c287ecb9e4f82cfe5a5bd7be2e87492c84aa924dc99dbfc9081c230bbb343f7231b18dc9682329765fe39c59ce7dec92e35fca3398b3f96a6ee7993f1b739390a6dccaa96fc1bfd36e1d5e8a352536fc7a5cce1e5a1fbc5fdc5fe57881e595adc166013f4e4cebb815fe5686b719d098277de65aa78d6beb5f6395b0ffe007ed6bcd7207f254edad9feda1c6dac09cf5f71dccad24deda4d5fccc93cc2af148a46e4f6df908f04ac19fe9dfe14d3cba7fc0a02e5d9d985c3cdd14a6ceabb6e909b794de4763a2fb7c67603462ff11c837849ef185cd2ecbe8e70b39ccd9f2b317ab7d8907afeae7a4e3ddc0ddb0de581d2541d71cb0d8d41d3dc43ad883bd3be44998cfbd39e4eabbea83b7ff706f8dafbb30b6e8dcde2e74ade04ae77ff77d84af07521cfaef00955cf5ef11f014e5f59c70aea7c99df10d7809d5cdcc1d7bdc2e441ab1cc14aec9ec53029f7cc54689af7ea9d4cd7be759c37b1975e77f28a4e586913fbb6f85e793e3e5b2fbd661fdcc87f3666e8974217e76104fd073906d9e61f277bdbbbd31263f91f7e7eb7f7f391d0f9af9dbef9b1d0eaf592706e312f8d39844d63aa9c1b8c659ebf6f46aeaa5d738939e78c6bd781b7d3313a0fb8308bb335f51d5398b7b579665a7b7d76d34bfc84ac0347d9690a93a72a78a911738c2f45bc897d9fbb27fda1fd77ea94b3b678735993bf7539b72ae673849c84a1fdf67d20bf8fb24d75dd912372a4bc47c0eacede8b7db0871c8fdd05fe7ea8e49034e5cb67bcbe3d5be7a25fe0aae0f33db2355028c7e20bdcb92facf9dd376a79dc1bdf31761e8d89d1fc1e5a178bcae7722f40def3fd55c59ec57bbeab3df7afd2b334597d629d59ff971fbffaef690d27045cb6b05f8edc14ebfecffd3b9035cfcebdb6e2d81b0f746d8cc52c6a893df067d92bdf391be06df0a75fd4bd1fc4bfe67ddc273a0955e118bb061939794c69e494755078f5ceeaefb986106df5a32f2a1d9f6122a3a4b77bac5357ee38ca3d2697b1381a6b85bc49ecdd0c797c22e2cd8ff6944ec201ebcbebb628fd4e48bff3a0b66662afbdf63c0daf61498ab6992d5ae8dc61ce479df3d3b90662618b585c1e3555590113807a143ed5cded14dc0be0da43dd563f831f59ef68431fb568d5526fcb64c3afd424e7d9b1457fdafa945d013a5081596ce0b72cc6f7820fb9acb9ccde7d31bfaae8cfc3f5a4f6f04068ed1ffef1f1da9a96edb375f5c4f331762665ec0d77425e2fdbbed4b8865de312b84659eb2d14cf2b8fd6bc869d5ec9852def14590c5ccc432f438e6598959c1c940315f588695fc08788fd252a52814fa25c39dda22e85f9db77f8fe8dea981aeef31bfc4f9123a7a34677079ccbc51a8e445ad7be336a6f07613d53f8cc1ef0024ff39cddb3f43fa9d19ed03cdddd7c5abb0f49917314400ed2bbd1b8203fd12bf633f539c1d74573e8b60cff85f1406632df12ab29de97f79e232d7d31cf63b9fa07219b94830f1c99e82dbc2d6ae14904759ca34c461db49d3f8c535fb56ed6ae226351bb7c6877580edf86d61471759a5f8dfa337cd99acf695b9b222ae7e96fdbd33ad5b7e87ea27d260557dbaab59e45dfdbf0f83ca688874fe1136cb77fb21f1fed6ce790dacbf4bcfd0b8c55ec2e562df6db461b2c970f6ce7a20e59a36ff9f33ee3e2bbdaee0e7351e6f4a28e98f66f6dce386b58df4bf9b39fb9a37d9a2bccc514135f55de8afdf245ae89baed5269b7a1dfcff246da63636fc7d0cf6d5ac84ce8ac4d7667f99d61a7d55c42b7f2dc25db6bdc1aedb027bfbda0ebbcc221706dfb5a437c17523c91700c935b5edf0a9e96d567468d14e83e37fbbbbe6603aa8bdce3b238febf89d09b4d07bd89db2183c73aeb03be8badbe1e2763c45f8fc859a7f88cbe0e8ce21fded97824ab7c958d413d71f6bb99b6e5191c3fc7cbc3b67df7587d9b3a8f30b5ef37816bbe4777fcc2f9f33fb7ac3fdf4efc3c10d4f15449e7991fe09aebdaeaaea16d3ed62b0bbe8e875c92191b63eb3b05b2c9137b1bc4daf3771f9ea734ca5aaed5a53dce18e30f5c63e739fae5075d0352d7a738f6bd4fcf5e71fec86104ffbdd3439c9aea05dad0e8445b7b1d537e8b2b2707eafaa39623d555723b63a9a9e01636b3c0d18169d88cfa32cef21edc25d176b308445b7afbdd9fc589b28b1dabc6a1cd38b78fb99e2501d3be895d7d091f77e09ad288fa134cf88e4b5e6ef82002c74fb00f813b0c4472d58b1c1db9f5f017e4df99caf87fc1eb9ae0c80027dd25a6f5e015e868e0833bf8ddf14e53cf1803fe0e0e2dfcbcf8ae497f865dbd837b0bfa2aea8f4cc1dfe8eae3508cf11f9e5905ae59be170fe14b10f06f9a7f8e1a91b10a5e0ad42630c95cb52f0f65974a2eafdc8ff91ee0717fb0b51ef456792d26f98a976cb317d763ce5ebcb1dd739ef189efea14efe4814b7a8a1a4c661a3ae4df326f33efeb6226ea94b7551b308ce9039911b8c64f2fa138f602b779891fe136eb77136be32e6ee8a26ebf85bcf1ee78c5b9bfb8731e53ce7e338d44e62fe1e251ec0dfc85ade77b367e9d6789c61f4cc496880b7ba07bf5c3f3ee9d86d84ed9462e4784bb1a583c1d351f1fcb7fc96b18b07c857abedaa85fc79d5eeb83ddda3cd43e4aa3ae21f853b91301e78b330ebb56eced462b4e8ee9954f9fe51ddce73c56d6e3c1f9a3637fee97a438765a677a0a9e999b783b4fefe3e94474ee5fd113eaba71de677e9ce07f141f28fcf1ccbf110dd117b246bda5103c9f3407c75a40378bc1c30b3c7f6ed3dec4fd94137cbed02fd1f623bc633e967b7c45d1b7261930eabee4f3ff9ffafadbf8f87dc4cb12f3d852ff162adf06eef5251c8c0f7c8b286dc282d34bed1d587ed12ae7dc43ecd590a2ae49c2698ee38f55caad46ef37f8cd0317fce91b5a5f234e08c5263dbce3d8f81aeeb91b1f52516bf2fa4e9133d6d26f94737926f616fbca57df1fe868e41027641b7611dbb0fe224fbd4e0247120307b5f5e11f6f890ddadebef71087fcb80e4262a7e1d64f7db1073f01e39a6bbcafceb14332df4a5bf335bee8e7beb6cf64c6345144cf66fbb20ffc869e79ae2eb17b00b54389a616b981723750c93ab8de191756df65e3bbf095d3fa1390d10b2fb1c1db4fc23b59831aa2c8b5a4eda7e1aaa86fa264b7bc408ff630e39bf96e65edd793bd83dce8bfed1bcb7c5711028a157c0d535bbcf730ceec1a83c051b2794b3dc628dfa5ffdf7d718f5c4c950003b22bf648284af09d12caf343f3c258bee38d5c42bdfd48a558682a8f689e15f5b1e82d312124898089b6f7972819fff7731a833372938f3d8e6fce047b47e9147c5e8dfa19936b5152e19649ec8b4fc7484a2ee93246d1800dc1fdc039f7f53dd931539c9da77295c9d448cc9f479f5ce5dcfd9ebdafdadd6379fef5756ef87eb9083994e27b4b797ac31b7d794d1761df2af0d9acde8e0dac70471b9a796e9aaa531f0efcee0567f617f606e273c7bfc4390e1420f643d9ee755dfec2dec8e7a6ad4fac1b0dd24b284a279c47c40b43a17ef66c36778f7d2f4cae177921ccdf12956d96fce5adf79d975de7879f0780f848db3c218da72fad23a64fc05efab1ea2d7fc04e768cdfd49fef1a69f8491fbb8bb32a92821f2e7295f17f9fe786cbdbfa9b7cc29ea8089eb87c38bfa143feffd14f5d631f385f3ed337f325a461036f76e3bd5d3cdfd1857868bec681b065798a2c6e45dbb9eef923e32858bfc61bccdae1f3ab90a8bbf9ff47de76af9caf31ea99a9d231547bdb39e3afa09c17ce00f6df3e5295439907033dab4beb96311fa4728a98bfcc83bd9f09244ceeb8002fa1e8776251814f92def79023b1aa0851a2a08e0bf606b8de3818e39a5fe9329fb65c7fac5b97b8acc6c043bfca8d4ec6ea12989742e6cded57e51dbefd205ee9fac4734e87fb7ebf5c6fa7763e6270b3c0e67dce27a0054efc1b9cbdc170725733ac713eafed3ba6adcfa656efc7dfd181690d9c39b05ea82fe23bca0ef215735fb3e39f9e35aa17ef3c473b04aaf227703a8748b48e459b2327bdf0d6a275ded96cf1093f9faed81b7bec76fcf1c4363476efee1a9f110cc5120c7dd679ec07acf955284fecd3f51e862d65553e5f572e0ce46671e6ed12aaf019686df7b0f4c2fd31636d2facc416af9c5eccd7a1c0b636695dcc28934fe1107e82b73b9ccddce90937356f206b8037bfe4181d6347f120149f45631ab04fa90d1b3842911741fcc4871f1bb1810e6a01d2588973e5fba1be13e71d754925e811450c0172703e958fe0008a5672823e802bf2ce4fd622bffefb4afe0d3e8f9ff63e72fb71a41121745771fcbdab9378cbc6417647e89d23bbf307e7c7eb1a9061ff4037f939ad721a966d3461ac397bfc2a87b14722f00ea88d9c8cf5bb74055bb5dc2b4727eba106cfde9dee8eb772e7913c8d93de2e0656427c3b603e4307fc44d23a6075ff9af7688c5cbc24764e5fcd3de7e83865fb859ed3f5dc0de3c934969e3d2eef50aacf612f827b7f689f345501467b17e4b598e81d8af5f55d700e9cae356fdd726fce60af46aaf5e87e44dee1c6dfdabb065bb231165b7de7159b80f2d96ee38d9fb0da8b2de50bd585929820de467502b5accdc79133e9e693fe0bf16bfa2bbe7bc5f08409b82eac05c563e6718d3bbd86fa57b7631647a1fe05e0869fd4a4d3c93c319fdbfedbfcb9ab6dd963724ffa1ebd32f7ac9d0736c2bf9fb6fb575ff21ffd5bf101801724d3d4dea9e4921c225730cfab2d309057bff5f8ea6baad8141c4cd5633fc1ed5ea3ffe9cb7858c6ca1becc4e2ce9d14f2f15f5f442d24c44fec43acf48ab61ffa036afbb58f1a3cd29fc66fb5f35dd1fb02ef6bdbf8c4b0e1e0fc3946ea59f04572005791efa6dbf0fa1378cf9cbbdbadc45759ffaaffff6bd2463749b74df6da73ff54ba7d6027a59eb8bf04aad26d69c7f2eb10d675a4c1b895bc24eba08bdcdfe8159b02b598ff44c8316eccad1fbf825bb904431b75d2b3a86ba2e677c15d54ce4b336e1ababef9ddcabe1a4facde37459b0dfa67b30d416b25231ff8b5b98b7fb7e6eea679b9f9771ef9c603a7d71a6fc89363d5bd8ef61a74a1c63d1425e458d40f2f9f1d761ec9fe342cf3daf6346712f19568b5f9e773b652a1eba31f5251f3b3f1fe0d5ce34fe0f8a9ef7c99d798cf0bb6458e844f0a8ed5ca37af7c729cbdc2e5a6caf32ffff3908754704b25e412d07c667bd39897507d0ffe9e2dea80ef533f21c4574a0e1ccef8aee7a2fa9db67bc2da90d954605c7d377daab63761e399a9bda5ff780f3dd303ae637ba07f61df1475d4da9c158e8e55d577900fd0a20e4a5d1edd6374f13bc39ca9a4e3dbf735f57f7cfb5871faf20ab6b7e82b70058beffdb7576b8134ac01f5536498d787355b92ded257c9bfdae0052ee0ea7e84dcfa446e19875b1171dc74be925bd6baa93dff37d6590a1dff186e377f634d59df3ea8ec0c1de582bbf4975a703c7ea43f33592c6a00fc4d1c373b77ecfb3cde8edeaa7d0cc47b2a8b587b0d6bd0183ffa15d1e7a55f88afe5ef52fba2916b2c14f534243d602e591de0c7cf21cf097e61e0dde221cb457e6873f2eef75c4fa4ed26cbf38333b40e04fdf3e7677afa426e606f1d64f274668f6b7cf0a3fe5d3ec8d477ceccf76dfc0edc8fc5acee3f9fcd5da3e33b9dc5770563d9208f280d8793dccfa01abf9157125cb111356eea0776e7d113f7e85f436cf1d1f98c8187a5feb5f26eaebedbff584d2d32abb5d7ff58cd1c4279b760336b036566f5a51fa63df93be759dc2de255d5bfd5de17cdd3b5cbb32aeeb3905f4b781a8ae79d97286df96ecfe3d3735db268f3994fa0260be879ccdf6336458d7bf3a1fc52dac9af28e9ed42555f462bbebe1076c1efd9eebc19b397e256343fa3c096601f97e359c99413ca4b7a9dc23750d48c8932f95a83650bccb8b18c8639ae9c72736fcd25f3edb23856ef187429ef3b8981a7eb3e8a47f53a81d370bfddf8ca2ab836d4b84fbd4c4aa3556f19a99b63bc1e1c819ff55d1d75b12e3e383995f19f51177981316a8bd2ffd7564fe4e25dfb8df2500813f35bece8e47fc3595b699fae131f579cd70d2d3985f599a54ccada8f2afcd9907d1c3f795f7b8f55d20955ebffd4bec95fa7f299a7f1f63bfda3595f8f1223af53d914cfd9eac7d891365ed75e7d12ff07ae8183af1a829758396eb2e1acc50e93e74ac119dfd6461bb7e1cc14bc7c0e175689f9bef7e5dcd8ea9c9ad5455b529993d2687ff1edf9bbf7f33bbf11872ba0fe50bb79d0ba9c79b8c7906c69bbfb1077ec25cdee38a69b6516dee17274852c9f62e4f432badf557bf3bfaa7138038e16bcf0c3b36b5af0a9187f7e29744c29ef6eacde49186fc3d9e1ee5ffabc83be475c1f0c9ed154ec5ff0089f2f2d65d2f9c7c7ebf540af3916d2afc24f8b3b11e7c7a7f13b69edcf7e778d428facf59593c7855c02e0dec13b3d44bd08fa73f5544edee520b0b826ab7917ad840be279acb6729d6f7d053d52d9fb2e62a0bb45dcd5d37868022f0b4c6c5e2760681fc09dcf30b6c6d49e2c662a59a33e923f7d5bb5b5054bfd8eaead70f49577f8a0c027bd8bbae7cc738c3fdfa7adf28d81d5fd857d98cf91f14ef343b20d4f2ed6e5d076b308c5b705e5441f3eb3278bf9aded539e3eb98eba7a82fa6f9853c6974d6b82e34e0ec05d3024bf62b5f7c777de360dfb4518f739fbe5460728b813a2ac77b39e42e84d857f69ceb0bbbb8cb79d6385ff0a322fc15e405dc11f6bebf4545e323d00fb2014c1278c1c175e2d2cfd2df7c5fe350c0ada6b6b6b4ee77de91b7c99611763b0070162f35d23c78635e324de46d417a01f991ec0db3fb7fed3b7c24f9ac7ceae7e53169fedfca2febd78178aa839fb59bf2ac366832b09354add0527bf6f7c98c086535fe0829a3cb51b66a34dcebbd7cccfd49a0b73867157fac7d9dfbcbb9adae617af7c5fcae226dbbca34c5acafb4fd508a9c92e62c8565ffee1bba6eb3b0a787617371cdecffdcc95799ed81ff736335777319611cb4dbedf07b45fdfaefcdff2375a67a9251ec9587ce21e14979207df2ff3e970ce4f83fe632ca36fe995abdceead595f9fc5d26713fb6351e15b5f4c723e9dc22fc2da110e588b38b116a1281c0270083bd201bcdd5ee5dd482c7120b9ff63a06593d5693159ebdf0a1b6eb2d6fbe34d1d7b71ab3bf16a29b1da4534cf1cf16357149611ea0d6dcfbfe78e9efd527a4230c53df8fbf09d620c65d4de4de3c43a7e9fe1f729e6e04facf64ebf605f3ac231a4bc64bd1c33e41aef2391ecfdeee9e8758dcb2f95acc36cf3cff7e9e658db4f88450cd95ce63189c3f7a458bbb7ff1e71133eddab37f725cde5993e936d551f66b9a78f88c1fb43e49c8f8f5e57df8f446b5f59affd957f19beee3cff98770e18f711b8eb06349f7d60ecc2aebdd11423f5c5b7fd0b7ed1169cf7f8e6537b2672fbd2367062c9bbd612fe6e65bdcaef5a7321e5395da27262eda06ed0d2ebe6793c7e1f7cf4942be818234edc17d22813f2ef4ca5bc26ebe2f78d1cd667e6e0b4b0877aea17e7ad85dce0c6052af675e008ac8ed7fe57ac1a7be827f38bb61b773becdf7e0ff704d7e6ee98634d0166829ed517e4657e7ff82ae39ceab2ba6af0d174cbf69af6903e738bf6be523bb73a0662e4bec1f6f54ff1ce2811904f2b84d317749455ef12381dc80f7aee203ba2c44a5b716dd17933d2d089b350b44fa344da8d4402ec4906dfc1a82b1fa3ad9984e2390db36bed632fb17ffb8e9db997c9d9586bcdb5aa3b82daf69ee69cb9c63b61667935bff8bd7fe88af719f56570096e580e32bd836247a2b962b803624728f302c1ab152504f80870f91f3405fe1c9e1f495f23ce15b8e66574e945da4aee04aeb9f367c83d3728bf09ec27f017005b1465b21a767521b44f2be425a1e65dfc0dcff656f00b04c269a5a93997d84ffbb40a13bbeb76e33424a7d54f6218e6eaa35ad3e77d240efe4f935f9627cb6796f7dcf66957d7e8123afe2fa6637f4abfcbf1c5f6fe3a373c1bc3f839d96c16e3b6b269b6e19ccb2b7705ee66ca5f019e1055c9e2be7cf457f22c70e24be05a8b5981051be802726cc09da80d086ce2b75fdfce9bc0f50b4ced3a54954b7441dd2ae3e8259bac6ecfd6ee0b368ea758ebef21ee3fb55dce0ebb4f531f36d0543af8eee41889bd5530d30e6c9fde9f49411e38d3afcea7fde68967c16778366d436bbf1dc7ed7d4cdec8f2914b9b84ddc5deeaca1b66eb47ae6ae2de048712db0fb5fedfceebc45436f7fb9ab7ff44e33778ea519395d51dbf44aa2935dd8dd6804cbe885b7834fec276adfa39f26f320e301a8b9aca1f4fce38abeb619c02f7633f61f5e61ee1a32a32e112a9e4d410277ae6d36e1d5fc35973b767d4d54c2d41d6ad7e8e5f8bb2ca18f18c72beb8ca99feeebb708edce9e69fbf8495c8f5a6ae9c467d7a3e9a648c39ebcbfdd8315b6385c7a717e21a8232d606bae0639f95fc670fe319b73ebb863b49ee84990c1b6703ce0d60da3555a1bc33e010057700cdd9527b89361024da87fc8e9b59d619e7a2c085d33a34e0d0a5cfaa83854df949f52cecc6977bbed81b393a440df233f9599dc3d9e958c4577e7d3b1775ff0fa835e889bb9edb073fa449660eeaed215643ebbbff466d55e8efd00b2375797a204b2bebf5549e4ea6cac72bb2f41caaf95a71710182322ef6590bbde6c2abcb51bd9bd99ab49763ec1e8d9cfc3d8e8f7c66db86f2555ecbe63d6dcfcc82ff67704ec3adfd86fa7554cf2afdd07f99a3927e13b6f0fb3fae72befccc3affd4f8bd67cfea1172fdcb749eb09fa1c7b9c3f13fa359ad3600ad03d01613505d57b4fb42dce38273c8df77b15a7268b55bd3f38f167e3fbbe333aead495bdf6bf59d57b0b89758f48fc5b7ca1a099fa989d235ba7e52f09bf5aaed36c9f7a5c77c792de64c304ecd73d6780e4465057fe0d516b9c656ee6b9914f54be572fddbe7729969bc9273599a900dec0397fabaedccadda884a872733e9b3cfef7a5ff0a777beead6f7bd5bccf5d0ab9e9f576a82f0f123f95c1f602bf98db5420d1a071cddef59fef9f8685eeb473ed048647124916c1eda40cf6a19dfcba1b995b70dffe0e697b29f23b633ee772477da26d6751dffd375ded86f11e3c16b7337e663360aac77e3dc87aafd6dee183b7ffa35ff737906a81fc947fd47c27c4955fbfafa3dfbb4627f077f0bf48bfc9c74e933ebfc99127fdd3c77dbcab30d39e24f724e2e9e73265e97ac43f5412d0151d9f9ae91d07ac0ab2fced1557ed36f47a28f9ac94ff3296b3eace2dd4efe6e914bc291258fce65655c54c76ec276cd583f8f2fd46615c6a7d7cf279dff7c3d2f90c19ef8d81ee2c8f57c4c5d7d7de53964fe64f8e55d59a0f8ae4cbe4047099cbacf49eb2f679ea3ccbe97dc07c6095cf6e0289a53ec183968aa21200707be6e701c216f0a3a7b242ed3e8961fb1bffc666d3ae98deeb4091b658be9d9f777c875bdd91a14f1cbebbcd035e7c9b252ef67390e7f700779a8e99a98668c3c54573f8057c84ce22c822faba6479075f3dcde9fb566fe00433507861c0d3f1a731cac8e3f98748c3da7cef6ccdc98df2c1539d70ffe9ed7633886c45f46dbc739466de44131cf5c7daebec7fe7a1cafc5be6ef8667daf5774faab3fd5512e2367b0cf7981c8da9f7efcb64465096c719dc7db3886439be2993eabf39b14d3276d589e2ca9c6019fc65c79ba14dbbf7eb56d1a8bb1c12173891dbaae1cbfd70bfbb86e87d6e5df731d7b137780e33e4bc0ad3e89b7dec60236a8ef1fb23ab238e7ed3967a81d527bbfe5fe9d9572b088bbb595f1ab532b99df623fdff7a1c0fb76c119d3db43578f121bfcf234af3a50c949533157ca16b54ac02dfd7d604c67d34db5f66b8195afc86b6ae3a6d887f0c1a0f64734449c64c28f69fd6ff70b091df0b09ac4577a9738792cbf6eedb262deb05730564fb45b73a23f967bbd653ced0177b9891da31365bdf25b2387c998665de904dfc157f578ae3d558f8dfff793f47e591d65620deca92bbcf374a09aae1d0d6512ad9eace3cd77adae4fc2a1595fcffec76a96d0dc5cd85d1cb9d3b8e6bf5cc1902d45562cd27b3d1e93dbd517cf954ff0f345ac6fa3c424f1aa714db2401cec733fee1763c515ff83e7ea7fbca9407ddf0dfaeb8d7d6a4ca6f647ab58aa0feebb22ffbf58b364bf1cd9b40dc44ae197feb49fe781ad44e7e989cf79394f4827ccbe76c757fd389e9ba6b1da9aebaf78fea19c683fb752d95ed9ef61a7cd5d7361eff17264669ea8ecbc69bb791aaf23cef969c2571b6bcf392f8b3ba21853d8174eb1abd3d8cc233c35fd1be533cbed80a256018b33afc2aef95b530b1f95708c1242eb46f97d5a5b610b5f11fcf57e96e38efcad7d607c1fcbf0ee99b71577dde9fcdcfbc19ff8b9e9d89f60ace9dc830767fee57861f51ea4b619b86edaf9bb6bf897ebbb7c3b1b3c914a16b4ed73dbbcd7da9cdbb075d267f2eac6de9e86a2b209dcdf0b9a135d1d77bf21f68edf75c9de4f7a8739571f7da54639cb09fe4671667b0f7981aefeeeabe0ec3a1fa3fee61f6de8fd433106d08749e78fd6ef2caef3f9bfc96b851e0e9e8feb9ce6f8940699b90e547b13831b7a005ed3f635165ecb156bfa4e69175575c042e7abe10a6ff22b694d4df0a537f01157f589866fdeeb16377a1b642acd5d1b6dafef836315980ac6f7dd6a0d0a9970df062f4f06cff610bb5bb6d4d1b2f1e415bddef80dbc91270e0adfba13b87a2776949d363813cfd50f541e2b955a51fda533533ed2176c4dc4cdcb718cecf2de7f7827d6e5c0f24871c00dfa3c5f3f28c7f6e0cea37705f815d6457caded3cfff878699e5360b9c097e165456db98fdf56e79496f7226a834ceff28e1b637b9acafc05795e0ef1fbb206ae25b396a3587ce76df5493cf63acf7702a7c57831bbafadc7d6b6ac0db29f6c370fc67b950dbff87756c37bedefdca8fabec8e2a5147fa27dbf9195e8e331daca6c8dbe82456c966d61573fd1ff67eb1b3a4a1688453d67c456e48e0fbee3a4b70bc558baea3976c6c3416beb22ce9fc7a6a68a31f3c45e1651fc025fe6157d69885734f39c0c91536d5fa24cb2e17f3291b7fb0a4e323136e14a5acf55c46fca793ef82a416dced7ea4b54def7c4f7469fb7ef28fb50b52f51e7051e8e16fa47bdddfb7df88ade5eb605ce9a75ef1147c7638c8d682f43f82cbac6fa41cefb0cf38cfa162de559979743ecc1ff372097a86b17dc3fd7b6574fcf0138395370c65579e02cc1186b2a7c3bb9ecf530561a6fa4b8d113ab8d7ec9eba3920eab0bcb7c1b7c1dbde857c33ee7dec99168230fa8e032e3ec2fb4db3b509ed576f37979ed1ea67d3886946f84e94083920b6e61aaf60e5ca8d176b398d1da1bbd2cc83e7e4f3ae68fef2fe40ab2b1229f0d18e3b4e026aabc8ff15ecaf13ae498e7bdc6a83b071c67276618d6dadadfb70d3b6c1b386ffb58257b7f2aa04dd4a561bed3e77741d95e425e5a4fa663d17d0799d39cdb6590065d85b796a2717a2a2b2ec06843d635d82a35d97863ab5cdfa3fedf1e7b7672f444bdc8676bbe779e6096eaed6adf6fe4ccca12ed6d88fbbdad8f69f6d1dc0fceb9f7c5dccfcbf4caa26636f04aac0e624da7fc662a1f69c10b11a8f62e1a326e60c40155c47d27e085d8f98e72287920c02f31449ea12ce55c9415dfb10a5ffce0c64f6c1e9ace85ad2cadfbb5018ebf1cc73ed73704e09e0a9955c97160f33279aecbfa4e4c638d0dfba4f12ef61c69190e4927708cc767e72697d513cd9c4fad58e7921bad073ffc6eee9aa9d7355ae64c96b292ed196eae1c09557b1d7f6bcb376dbdc437edd3f8ff242b74e6802882efea52a90f0f7512a9e7a557d6bba33ea20b7b0fbc216fbe3b3e6803d2b9da9526ea55c2d6590734d67c5a4489bd0fbbb893e81e2aeb53d218049e49c881dad843cc39f856f01ec5b783bb5a0887e6231bb498a3a77902e5f89efb19ffb35c9b44dbcdc14fdeb0e63bc8f0f16bf5148a7e310e430975f3deb187c03b8c7cdb02bbf04c17cf6315d7710237d9ec43b231776fff835ac833df39ef027751f23adcd43e5ece591c2a2a745de03a56b20e6e724f3408e5ef51e4e5dc969761ffb4b0543bf381831b08c730b18b1a5aa7b02b0ba1aa206e95cd5dd451a4b5e78b5a590fe254f9d81beeb6faba9475da309fe7744ecf31398eba05ceadf75f94f5f2b894f212d6efc2e608f9cf1cbde7bc9b4fbfe63be7e83bbb70b8f96b6b334948c7137b69b83a2d4cf002b83a899d0e7c18c8595f8722398caedcdcec1cc357fc80a73bef63434e5c5dcefa8eb0f21d9a4b41e7730e9f1e72499378fb4b25c7efd3d6b2957e8fbf16c212be0a2f93ed69db35597b2fddd9e0c247fc5a536cbde29738e2fef3e99c15fc7b72215f296e2cd7474f8b7a0d6b796cdac68c9d93523e69ab26bc4bbddfdafa8cdca03f05deec3af62a2f2fadefb58eba4654bd13dd29773d81430017d43e769a7ce2f5751d75aff34131cbd36abe08877ba029aed5fa2c1663148460fac81691d601f207d49ee0898bb6be8bac722617da37ed45595afb66a1d321df0f7731cd61f4138562546c6b936a03d44352664c975d842a41de6c369f9e16a82d833be36eef7465e251de57c41ece12c3f4167738cd9f9839e03a3c134d411ec7233bb0d6dfa76b8dda669eb85c8e5cf3183bc6ef17ebfc157679de46ae635563f1e0495f074d39628885a97116b4e6ed8d8417d76d6376f5948b372ab9bc4c12522e0c5daae9e56cee91f31c890ab3c7941d3bd37bc4947de69bc419a5b8d82f62e7d0671f7da67759a59626bb5bca76861dbe7e9598c328219b57ecc6e8ba160f625ef601775194b1dcf7767acb79fcf13abe12fda1df12a90e9a8dbf0d84606b5c7c750f9df3526095461fb7f6a47409bbfa85d6689f69a7f1ccbe20b7b294f33cfba12e2b6be36ceb378cc401eadbaedcab0ca4ed55f3479a62590fc760532e9e5fc1d6f8055e05f0edb9229511bf8227f8c19b384ebddde96bfe84700b3fa4416dd751621c38b2b913b5e6351b5c5e3ccb689bcb537687a31a223f620fbc7097fa96f3fcfc34a6feb4d3c273e26d94d8db22b6809a269873c8e550ec2c3cc7c8c2ae51d48085ef075ca9e0afca50ff4903579663b5bdcf0b1d30f75faf519773fc9f9528a2ef480c0325258113476e7f014e83ad3bfdbd1df5e5c81deef1ec1fe0c97c47b9f8b3df0bfd263eaa3fbb771bb04851575fc387ee0852a4814b1c7c80e01f2b74edad99515dce61fa3795ebefd5dcf0a3b785cc38934217a0b5441053cc5ed00f16cdb2e1e63ec3dab7c59c4c3dd49b5a1573ca6a20dccc8725daf09d484c2654f61fb3e331fea9343307fb5ff6c69e5a1df27346f5018dd6126b718e819b5266c49c3a76ef57e377b6fa324eecc3ac2bb3dae452370057b8734ea3ae6947c9f9059fc7cd9e4bb09f915ba933dd5cea445be88cc2da578b3dd7a9f1af8e441d1c943b7fcaf4cc3ca68cfb2dfdd95813c2685f9be9a1ee87be3dd0fd81af4adad67bf1781c0f8f62b46f23f71ac7f3581ccf2506f28ec430e3cc7fe5ce802ee8b97e93aff6065b56ad0bd64e06976df37d9919f486c095c137d156fe56be0d4c4d0bae91fa779efa6fee791e383812d21443ad7d6b11432ead2a3688fd5ce6ddc7a1b09e9059e414b8a604b9a50dfdc8edbffd7d7cde8548fcd8abdd099d5ed61a57525d8f576b1695dfe2e9c0ca29527b69b835739b7658e23370eeab989347be02f68d9679165b83c4d4ff604fe03f2af229ca67861dae3fa8b687fbfa9ede51d7f6aa35af2b6d7c00af0e0ef87dd8a5b905b53147d935eea071e696efe763f186a26dfe19c55d3f05ef4694fdffb2ee95ef9535c292183e9aa980b113bf8bb3616f6e6b9f8ffaf20575002bb1fa7a6ddc1b2c7b81cb78a986d5b5d6f585d6b7dca2269670f94bf5aeb10f6aed5e79158b78d8a76b46cd68bb24ef6f5bdb80ad4746d75f2878b35ff34f43578892de31748deb3ebf72707fce075a9923fede5d2e23d55e238fb3dddde2f1fc2b552c47c5c7f57b110c75e2afc153b49fbb7d3dfebe45fd2a99807fae41975cdfe69456d7275015e4c101bfd14e1e91ebb73ead6f55faeb649d837bf597fd873bebbbbd9f8f84ce7f0ff4a6ca3c415ed5b8018ed1750d2af189377a572117b78d5d799d97b7ff3e9f8759f6838bbd085425f36df377e0b6dd2f1647f768f4d3fe46eea3ad2e21d3e06712c1f31165054717309b57d994fbba63e8c2948724147bbb28fbf8ed4fa5ed68755ad0794d2cd4abe8c42a74f013f27076d46e54595d6ecac9cfe4a0c3fc402b7915bb6607f93ddf8b9ad1540f802e23211ffbc17d896fda56a0924bdb38a8d559cad6c6b6a603326bbf473f5631f607dd9b3a8931674269cbad9edd6fe57a277687f6952f1f109bd1c38dd20fbb6d7db293977cb08889877d590f57b2ac0df4e31cf56dc17d46ef209362cc7047b39c2912ad584eac6a2c511f77ce30b49eabaf02d7cc90434a71b3f0ada31e38e29ad3627f50de9b25e3b62ef872802d444c10b87912ba34bfeb18ddfa61b9b9402587cd6fc449c0f5a50d068b49d27bfbd9efb19cf35a5b988b3d70039aaad37ded39fa0ef5c658ed56daced49164d6c621ecb279fbf6b6e2cc6dd54f587bbff53ebc5b6bbeffe9c19e421b699828abb0bb782047c8613efd6addebdab94bc36d93ddd2e84760df9796be6a16bc9f067c89c8cb98c207ac9e19268c7b873f92a3795fb6462776cc0735f78ddf81234916722bbf8c5529fc53e0fa207b5fa039aab89f2edfbb46e65d8450174e2b77d663faed3bf3efc32f255de87c503dd02cfd079e18a723474fc355efeeefbe4822f75b2f7457a8e37c8ea28b147f27bbad0bfeb5e1f8f893741edce1e430b7f1adf67c3156d7d804afe4b012a93ace75289ac8b5c4fd7aad4fd43d5f22f1fc8e58ec2f955c228603c8d70f7aaf52d6891889c636980aa88d75446d2ad4c2ccff16a74ff5318a5dcad79ae6d1f2f9987f87dd8f85a52a524bff7be7352cdef218f5e589e75e7dad9e63ac812101df17e48f272e8927ee8fb13b29ea99ef7cdceb15fc5194c917f8e1a8ffd421d9b56e8ab2f18153e9e24e45cd73338dfbf21af59d0b5c13e6361acad4feccef6f3dcde545b4081c89c6660a4eec487c471fc87c2aaf695fa15fab671233beb2c0d516f04b865b83d5397e5bf8495e4f8df9760fa897cefcb9455bcb28b116055e17638ed5fc3b90bb61574efdeda63a0f1c3ff0350f1c3927de54d8790e3818cec4577509fed748ddec2371798c5492cceddec973370fce44ef1438bef4dcc754f671c58d07dcdc4f57bc54d197424fc0b9cebfebe59cd8c79bfef26d6657dfd5cf19f6b8bc9c4fa5b24d6673b49399aa228d92f8188af1ce9f3e3a1ff292e2e2beca7fc2bbcb55f04963fdcfcba84bb960e8783cd7cc708e2a6d2c6e788af7d8ef5ee153df56fa5af8d81bea441ab38f56f7f975dcf7586d8e1fe67734849ff9f4f01ee7e1bc03d19610bff687ccdf47f785d28992de81d7566d0ff6f5e7f3f1746fd01834a9f079b33d9cf7e381ce7a8c5463e9ab8396fa6a8b3a86d7365fd0317a274f54364ff20b78e3be5cdf7f781e86a1786ecb95f0a25ede3b99626f57c4d8c395bc0cd5136cd37d284a1c3c53251fb51d97c7ed3ea7e3699083eb50ecfd29e4e0cd5c93bc9f98677d9efb8226376755a778b648c965def579edf9730aeaac09454d108e6dc6f5c3300c05f64f7c0c55e83c8d1cf4183b89be2ad76eee149697df66bf5ed8f32febfd555f69f9cd4763dc6ebe782e9ff0a2d1b36a67f3c7e7ee76df91e6386435eef15aadd8cabc618c4aa81a475fddac3867f0a11c0056f6b1bcf3975f94014ff21cefe3386e3e6fea64fa51f567bdc2d577bb0689d7c46bc2b9dff2e71faef12bbc835f1d0b5fb761ebf378fd48f2553ceaa3fc98795f607a19b7f6186a4955c6031d6271d55fa7c2252ef9aff4e6da501c9951ea5a7ded3d54c921100afe2f6a2fc6a3ac57c4ad4bddb1b0bb1afa991953edd11e4afdc673ccbfa3a1abe26f1ab9d7cfc66bebf61c97ef4c6c597fc55f1789e69f88f9ec721d9e54e3f5abc7faf5fb7194bd81e7f810bae46e9edadaa0c5fcf0fd323d7008b5cf8be7edc5eb3d74b9b6d93ecf3014a37f034758fa62f53e856f1df845c4097aa83751ee19da67c4fe1aeae4ff9a3e991b162fa9b5c37c20fc396a8d59e0dc61cdfeefb02befc28ca36f51bc77c9b77602df1ffc85e16d9edc8d0e10bec0e9321df426b3414f9e6e14ef7339399065e7633526f7aafe103ee072c1dfa3e1e67f35f7a7f99433f7ff1b5df7346f7bdf95cfbfe2032b65cd29b7f9e3ed533951390bd17073f0c546ae56f4a5035ea2afad43d567091fd06e91dbd2e96fd475800f85f5099cddc7628f15f22f56edab7f2e7b38cfe8eb13ddae09d3d27846ca6f3f3c1f3c3c0ce69cf4688dc71bdda373e5797abcaeb5b9edebf317e6a8791d9ee45b16ed8c12e35fde99ccf915bf7667707d96c398faec0a9fa55fe38210763466df97ab7e822af7deadff8e6f3b6c8dee08e7786b92f970b2677537d91a01ab19c7f3cb38fb3535eb31deb5573dbfd87f9738030ec35f862ad9307fe69efa7e8b7a27a48e43326a72a2e84ba5f6aec3b8a289847a9dc9642aa8944727afd979a3a7fe166b63866fa61bedcbbfaf8afc68fd6e1c9cfd578dedb37896545977a633e3eecd6b8e84eefa1c7f17ea63bcc371ddfa57f2757e724e5bd8601b56afa2da469f9373f8258e24e0abc0c3c2b035881f3ad6ed783e71a635f077ff8eae6da4bffabdaeef68472fafcf72a4585d5bf8bfe399dc73b3b73fdf592db8067be51fe3db6fe176cc2331455dfa8c61ac20234bae4f60311af221d1af63c9eb235cdf7949aedcfa44d89a73ef7bc7ef7c3517a1a60f397ea7211efa602df4ab6de2f81de0551817cbda9fe6f122564fa0c8ab79752e68bb0f7892525f949661dbf8e0a57e168c6fda2b3a10fb568927a438f9915bcd9b01c79c2e45431371f33d8b99323c61c1e7cbd5472f81131f3ce7d4d687ca9e3f973929edf4d1fa9c68b0c16177257aa7e0b1bdae9bb9f419cee6ee8c64bd0b9e436c88e66788cb4eac2adbf25db1c0bb9da39f9f3e03648bbc23ac7f798eae7262658964172783967ad6e073feb232b7f15acf2470cea267811b8633df35d94dfb78bfa68f6a050efda5270a173e8e166dda99859a8bae4cbe5a47abbcbfae78e4056ae3ff5407357c1afc1277df5e3d1af7f5b9e7b1bbcfd7c172099d331211d46622277fca5997e7fb8df6392afacc9fffbd97d8bbffa14ff114766360b6370c039902e318f639e3aacc7bc4fad630df7c1f2acd6566e7365132ce59839e41b4c10d6ff6977019b5fd42db9f54782f1ac650bb8baefe9fd2ef51b98fc0136cdf725ea421e4598ee53936dce30f6c705673d2a98e9f1b7bca9fb568ddbe96f249fb4cfe46bd86139bc3a9625ab416dceafdafe3fb7d77b9a463e3c7a1e9d84fff92555bfeddf1e4759fadd317f2b13b6621978b355fb5c95b29e6ca4bdedbc9e6ba9c28df051b306f0ec24bf4451f40b31ec2daaee054cebb91430e23a7072c5fddd62b6a40f7b97ac72c9fb7f7ee0b9c14ac0f3127efe0695e6531062673f4b2cec075bdf4197d7f005f819dfd553db7d2ee337d57a3fbc046ac24cf11a4ba28e5de58875df024dad915dbdca3f2f567bbd87db1973ac0eca21d7e0c84747c0bb570275fd4756afbbf68f37effb7e7bda56b64522cd30b776ed57fe29a04d890b82f653177ecc031db87d6353bd61a67fccde72956ed6194f484302979ab604f2e02b577b9ad4373e3b37c249b7f875da33372987e49e74b3bff98e98977d189215ac2f8dba663383af1d68b6c7c5196c6b74de67ff3973fbe7d64c6f0bc322e93ce58b5c4b1aa9d0cd12686a8bd8dbf4d3ac6c53b1b33efcd4f94d578ed931fc393385e9b1bb465883a195f169df16cb91c8b56e6cd16e71faab1fea16addf1374df2b627d15b479d31fab15e649e38107cc74cfc6f1fd2781693f1652318a277329cc9db8f29674d2bfb88faab9d627deefdb5fcfbb4b2fe5dfd183bd2e6e1fe7768cded2fee7fe3188afbc25752dfdbc4f8e676941f13ebecdb03f2cd127ac3d9a0d79f58e75f934eefe7b44394c73ecd468ce99f6bbe317cb7bf8fbf56bd1cfb33ede539d1d04712e5120c17ab47f20a7c9f4d3122de3d1d5de7ec803ae33cf9ca38ada42fda10559f32e46512e435c09ffa95670ea1bc06a1e877b48132b3fad20fd39eb48a83dde7394af531f51787afc7a96f65b59f8634979eafffc4aabd856fd3ff1c47ba30ce4e6dec87665c12e4b1784e7d95f984a7f2003c8eb10a6e67fb0dbcc2e0f7851fd8aa70077c1f988ad5df305eed5a2c0db55d32df056e55a0b956d1d6f81db81f79de07d541a44d1503abd19c0373893a7edaaa596ee4673ade2187a5856d58fa5866425d5fbfc1d5e5f7091dbf5df89c9eac6f5d2fb9aedd83f5ddda62e0908de7ecff7c59c7ad9d735f881392faaaf22710dfbf782fd7da7a75bf5f6efbe2279bc6b998270209dacec1e579ac0b3e44f81d6e635d8ef07684fc1c09793e19f3a71dfc4cb8ccf3bc40acd7f1d7b7731a3a51f1feb566e1aa177f5fbd1ff37652769f4bdb916b4895dca0f4c17efd3774ec3f2df4455abb284ca23df2434b7f58a6b59cf774ef3b3b6e8c177ce86de7db986d9ece773db6b8fbfdf3f2fb58e56d19659b7f22ca15bfdbc3d7173a76c9bff53897efe60c27a9497dead30773b76ab059b3de251f736b1e8627f39c9ff7003c18e292507cff4a2aebdf828b818db769bf83cbfe0f7252f0f776bac8a45bbe8f36be6d5ed14dabdf2be4799eef37382b33fb6311b1bf79ae8d3c9da2a6399bd7c922eeea693c3449b8a21c2e7dcf357e6b2abe05df2a72a0cd23fcd681aa1c2af5b6d8daf992a654791b58de21724444097eed9d863a4d897f8c12e49319e49acb60af6317dfdebc10635cecaf5c72c2aaf0b7d2f5178c8ee7187f8a33515b971b395a7dbee1bcd6f7e5a77829b03ff68c1b474a4b7fc355b6ae2cf0d03bc8d7fb5f9dd55bd998e29cecc1750a193a72f54ec1dd49f37bb972ade8e7bd6e795333cd08c59e95e7913e38c35b631738c22aec2e5bdebf57fdca5709ce21e2c2cb51a29351f75af373d42dda5d70ce25fddb62bcfaff7fbec7546e0959ac9e585eb27189f8988b4a5f9fce79c19ff850e729fbd1b6e6f7d5fede14ba61e00c0e5e621d23b197c479dcf118157bf85ba38f0b3c70d0d9379a52e49eb69bfbf1c57b254fef4f240acb686b2efd22c7b5f0c3203e4b39c14e2bae2c70942ceecbb2df970b5f69dbf85afeee40d9d4ea63ac5ef573e4f3e439e6a5f83ef76ec7f794a28d3677cbe0f93c2ab91d5af483eaec2557a9d98dbae74b8e41a5398ba9ffadf3782ef3febda09b2a42ac2e0bbd74ea3b26f2d148ec6a0b2b5f573ddc42564ba58d5adb670f6c5ca6b3c327b5f6a6d221ecf74eb1a31d59bb54ce8c84cab8a742bea6433a867d602f4fe0f5bef279dfef6fd8342ff81d766db03c8ff8ffac8e205bc49cd983ded49c4aced43674fcbbb5efa13fd857f0e8348e57cc07b5e3a61f7b4dbdde659843dc11ec8ce5b65e9903d7d9ff5c71e558757e1af6c3ffd6ce67ebd801afcddfb1f36b76606d6c0fcf6bd107554fe76def1ecebeb83b6baced5182b6efef8abb7bc0a177d2aa6d5c0be3adad3bdfe6adcdc76c635b6dfd45a38f36718e877e396362eb9625983fad8d75b0376436b5cd9fd6e3fcf30a26ebb41c4d8549194f55754953f2b36dd1f1507b063ea7c5682ad7fd93b8cb6b7ba1acdbba1fcdde56add6ee99feb91152afabe7f76e5f962de1239fdfcfd9fd7376d6676c3de9b8bef7dffe7c9f9a0f39eb9ede65b5f857ab3d73f0dc8fb672f36df4f1b763a1d232eabfff763229725533fe9e91f6e3afe9c768e7a99ea679aef107bc12daeac93ce63ac1c173c1dba2ec7d17f6c7037b3f514ecc3ff645bdf66e4cf0d5b7d785683fcad8319d1b77f5b2dcbdb515300f3853e80b7c0ffb1a6f66a63d91d9d476b8848972829f99d6e9e1cca1e9485b60455bca2de135ce3169190d18ff0ec3c86bea398dc1af23529e804b289e05669b97fc2c88f931ce952bef82685f3455615cbfb288fcf9b08bdf91833fb42ff1506fa82f7073ff4fe595efc4b0f7eb635f3dd80f65ff5b63eeb1764a98c4651e4aadfdfe4dbb16b878268dcf4578aee02fb27bfb5004ef016bf3ba0ebd67f719c369a05f84f5abf0bd35d8e7528a3ce868fb559cfe83bb6b6b1c0297723c74464ef1ad8fd5d35cb0fa5ee4daa1a3557a0913823ce88cd579b944c2e96e7e7faa661aafe43cde9490cdddbbfdb7ffdcea5daa74783adf753cf7f75afd6c5774aa02771f95737ee551613ec5cba8eb51bf5f9ce5bc5fbe6a279e6befe27eef668c4f39bf1eee8db2ff7c4ce921147bab60fa37b14dd2117bba2dd750c49e6f8fe9bcbe53fa9bbb741c27fc7fa42e4fedeea53c261b26bda397f0f3e0c2a4d7451e539c7d758e1a7d9e87b01b9f721e32025978d090e3ebbced23b127d05a21798da78527a2064f517fac5dced7b5ef2de5dc26de85a2be0c956bbf66f4de364e9e631479ef9535fda88fe1e11af271cf653fc53bbc59f31d27eaa935d4dbd6a93bff68118f2ada7caec3a6e051dc79d3e77bdbc2d9b2e52c14ec8ce5bdf27405ee7d1f38f12570f3b8367c845e424e51f27e60f2b341d6f7d6a8c1f9421dbff378d1667e8c3fc89d9a5aefcfe3dc496fe34fef749ac6390a9cf743d1e7c6fd9590e3ff40173b7ae29ec4459da1d6b5300a9f78658e1bf7a7dfb187da17efdaab4ca5f6d2d0cc1af6260f375ccb2bb3f0be6266dac0dadb2ac19d46e2be4ce3e791b859388ef2ef79a4ecce23657f1e0d5edda3cc4e627de4e25a50e3d99c04ee9284c371cbb9a9f3571bd53dd7ffe870eef046ac00ea24fb43399da3ee5e9e575b91a14c2f7508384eca713eaac349db538af69eda4c53ca29d90ef38defff428c2bb8fa802ef5feb7b927ebf752257ffcdf6a9e7dad5da1a847f2bee2cced335f126a586f2295bcf9a89352ad6d72b31742d124f1ea8b78820798d9a8f806e9b5ac0d52b12b721d721daaca25ba08a1dbd7b6ae634a61a2ec03c71804ae9fff6e2aa7feb7f2ef1b96eb5cfe2c386de9bf67bdfcb947be14f17cf4555b7c7a07dde70f3c3b9bf95cacb8fe8b63d85a5e8d5f3d77195fb7c931a5d1504f23d1da47a2bd8c12e377b53ecfa82f77511f3756ed03ecc2bceed26dcdcbaafed9db45aba7e7711c3b060913eba92e0d791f0eed35f2304689023d08bcb2c72889e0c7da45ab46bd1a79df97c8c578acc54c140e8155e1401ae4fcec2def671e26ea110fd22ff42d768d73ecb07c5cbeee51b579aafd7eeae79e38e6d14bacc5041c4a035fa0f7f0aa958c6bca6ba97e7b53e87db5797d68ef307d5e3c0b51571170c7f13196bd1dfc1533c7de04cea0e5be1f88957ee01c882d6cc8237275b481407cc479efcf75a34e34b18c49eca6ff967e81ea1af43f566e47f9660ded7fe7c999a393f796be6a1ca3e1661fb1e7460ed9b3fce17de11bba7b2f51369ed83bcda7c2897d7fcfb8378a9f0f6dfa1b1f7d3d1fdd9553c842ba2f133bc785daaff21457dee5fb628f61a264966bafeefd14bcb59d74396bc99169d232228ae025d60bb58a280f25b8723a812309859f2beaca451de745287ab0f336fe54c6b9dd515ce7d03c5e71279d2af665ad0d75122784c4ddf1221609b8af81d340fdbf05aba97b41ff2aed42cfd900df4f6bc56eed2db8b0d9df2ea1837a90250f76b536b9183b671a97f212827e41062cd0e7f1fae34f5e7b29469d4470276f35f59aaf7ea7433df1fb943978ab1ef8dfb1e607cfd52b38ac9ef8b35aa7d07e925b5ee765beee8dd9e918aba413aad6ffd1161cfde27a375f42f8ed9edf2bdf3dd75fb6f231a3bd8d403c17f5dfed4be3792cfa9bd8c8d1be3ca925c093b5a82d066c15c34b0907afe0b02352652fdb17bdcfd18d6efc2fee8ae684ac80630f55fb02bb14f3ca6271ffc4eb4e15d7d668a73d3bef75992e2da32d3d6f0feaecf63abe8d5cf48f2fda5d8d7e9a63acda94e7e0aacb405fa13cbaa8b9b9f48b7accc30a8e67a571ef85a2bd4fe48a3ebaf72fa8e99cf7abce81c8d9334ff5f958b529cf4921a79be46dacdabbbf88a9c75cef1aeecad6ba4424f63abe73faca9877be630a2cff89841c1d0ffb7fe6c0671593e8cb58ac9a0e8b1a5d06db57596bbd215170bf4c4255918ad8e0ad2cbc6dbb51ee6c6fc72695b144fa3cf1687d344df97a8e259b73d425df85b4e60ca9d617fdcfcfeb8ba206e0c12f6ad2b85a630e5da42a5920c8c7a8fbbfa9355a69bfbcf7f39aa027ca591d81737aa80b5ed714a0f7834f3c4ec8063c98f53be1e51afd27d4218d688e16be772e620a2fd7e6af8ce1de8ff72c16de959751b23fd15c2fbe3c38515ef7b658d08fe6bbb731f6d8957f078ef456d46d8ed5de31ec173520846540e389a4a3a9f632ea6e289fb7d7d58907f9a69a47af9bcb68c6c3c38d2b4ed83b7e5f864d73f0b38f776f2a97b1a8aa5e0b1bbfc0157d9ff52e910acef8c5de577b6bfc8ede57c269f5b398976fa7a3377b5b546bf2e1b96777647d0de937dac6b307b38e61cf2cfb873d50aca9d5fbe1767a137b407ecec8e3daa2cfeee5ea5873fe06ce7e70a46f33bbb5cf8f231f1fc61e4f8123ad43d7f815e57c6c6caeb8ba52f5be29faf77aadbb27b1a54825875162749b65d5398d07a4f33fe1f7efca54478f73be665a573a14a5dd7c8a7a369b3de22d23171ced02728d504705bed0036c14cf459d14bb036e7fdcfbc8a1c2bf7dd401401e94aa48a1631fe23e8d411da24cee229fa05203e02d700d5657accefdaf0d81bf5d824b1ff212b5560864296a4a47096c0519b59813dfb16f6b2c5fd76b6b40dfee34e6f2dee8a957ee7e1a832deb5543afc3998b8a79ba7245bea22794fde0c6ddf3f657bed31a5379e2dc4777e3f712bb2997ac512f886afd78d97eaff98be9be557502ae1bc43f465da335068fc5a5affde7c44f22f52c8449db9c9381c499b32a57d90935113dd7b8000fe0273de89899dbd7fe8f9e503fc9ffd594dd1e7e5a77ba5b585d79173bd21fd4eff104861f5ec945bf1679ce10dd53f177d44c5d95b183b0f4ff4ed3c85527e9cf6c93fe04b65be9fcf7b3df3bfd244fecd5aa2f93f5e3d3719f9b713cac3930ec3cd9fb34d6736173c08d97e77fdfb7f56d66e3c5ebbefd1a469d18a770681f02916be35ccf4ef16c43bca669bfe27e8f8a35e0f912bb72d6fe7cf36cceabee017d22c7c0ec730ccbaab78cba068987e323c3a41e3de7bc1bd9fbe5c8c51e33732e42bbc0c4304ee5ede9bd38cfd8735196cea2213bb7d8cfa8050dd9df3db3daf7f0579163b835dea9af4edc93f974c3cf11ccc7fdfccedcdaabf954469ed6d473c86d3e0d57c646b4fd6a6e2ef5b937ea175102de8276fa26bf86d4835c2db2d84f5cdc77889d52dd713f117b885dbc3df1c9d4f65edecf4ff890f87af905b1933089b8759523f079396f5f96a50fb0a8e084cfe077f0ba66953b6c6e7515ace181eb57aaeda7fcd90659f7608fb07778f7481e4b6839f6c9f9255b90c576589e6281e3abea47d8bb27cf91a452af12fd84cea1aae776856b5c6afea2be7cf25c426d144d854e94ffbe52dbfe52f8664347d985fd0a96b2c2dbf1286ecdfadd80e168f6534676c1776caa88c771fc942cce24c9570eaaf12bbac67f8c0f3d6fc7b54f05b6abf539a0e3b2858861edf8fbc1be44aab26ecd57f092de9dc79da2aebc2cf88872fb7fbcb0aeb9667bcf1defbd69cee9049e15adbf9c798e322b6a4c5eef22a657a8577ee92b4fcb89711128b48e6594c1562d31b642947f9f8c1c5d089c7387d639cc65c822566dc868d405bed5b39f9cf33c27cd1fb09afae57a317cd833ac675f7bd7a69dbda6d0baa1d487529f33e41cbc2d46d3ceffd1565c39519de3b636f0fddadfd77b99b1d8c9c112147b62eb8a25c8f2a4638313e5873db03dfbb1ac7f1c83bfe937577faaf553fe76bdffbe966fd366bf4e2a5c5a57ff56256e5d89cbdfe75583c791c68696455d36f086e635a67b88fd27d73d681772ec1826d0752ce47623e7e432ea337b5205bf86025c77067c37780ad9ddcbf2b669dd4eca3b94db9ae4e08967c157ed0dd7762ce414c3333ec759dcecef16fbccb26dd4709d5903e59b69f7a6b3ce796cdbf26cb4bdf976755e1fc954f6fc756dec6fc0f44eb6a8715fc67fcb7efd9a3eb0875d03f5d18129bed98f959aea5dfb10b8d4a7bb0cfb45cd74f342f9b05d19fdb9f8cee458e15d83ddd4fd9ed1d81fda810dd405e7f3c8eefc79cd36647e93da19c8e579751f8edc5296b1b358913774de481625f66ee628697bbedc9770bb37df286b05a0ded061e41ac7989e59caadb5a7fb1877bb63fc41aca0d007e8194b14cc278da552ff5225763a5ac97adcafd53f66f56a0556af161c35b4ed35f4c76b2de40164ff713edc302e5e3bd3c07db392f599a54ca83ed135b186bb5bf95fb199731de39b04d999465b9d8403f89e4f8bd24fb9faa8e61ce55cdfebf32a5ac94df9470fce64752e9feac793a9f2f11ccbbcbdb64be748011e2c4ec3ad8c58dd2ff8c34251d93c3e3f256ef7086eab5137265e52fadf4fc1f0e3f7cfcbefcd2fe51cfdcc364ff738d35985c0d533df39ef610f717957bb8a10b8bf17330735eec85b4b7bfafc49fdfdaea6d3ddf75bdeedb4ceaca00b6142393316b067672e99e5b808fb9db59b6a03013500d379ffe1bef8f7fadcebfba27d8eededb778fba141ffa4fedefc7d2ede91f296ec77deb49d2c32383a67558fa77c1ce2b935a6a8d607be0e53ca7b3a2f89728944bb1389251726e525095585c59f0470d15eb13c22cd5739c622d9a0362efe8df577c4c17ebe19ec9df5c7fec794e6bcfcd3f65ec8cf4cd177be6e1fab4a06bff5ff7f7e271a675d0797dfff9675a2d7bfffc53c47c999c4bc3d94907528bead7eacf27d913ffb7c6ffbaede2edf52046f96b28e54e590cb96de291427c7bb7eddeccf58a531df4e1e37fe4bbe6a82dace66c91ddad65fed76ebef31195d790f67d158bb5dd8c529f1baafd432c37b06f1ba765670016ae425df68fd8c6ceb7d2d7392c4723e71cf0ae196f262be602fd4d684eb6fcd6d16f0829cdbf21a5ec6932fef7d8633cbbfcbf7ef5cfd9af5bcbedfff9438af752ff4a6c2bff8dd8ff5fb71549535e0b216955d382490376998bd73fd1ad5fe34ecb3073e24cc33597bce79379f72f88dba661abaf62e76c76dcf46f6822f49d65401b8ef4bdc97297ecc62faa6a6fac0d411d4df0d32e61b5097441b2cad99755acc9d9ea0a9ca2e4aec256a1507aa22ded55ca8dccfac3e31ead02c597d9b2c14cf3b9c79febc927dec9c85a61c8707311ed4491580e70db6633e668bda7cd6c2eaa2c6babd8fb296f7234f76976365f5c158bbdad03f86ab6a0c9f71ede4dfa7dc75f4fbb0591293b87dfd1de73874e38b2beac7b9621e23be9e42792b34c590a2ae4942b7218eccd71be8bb23f7faaedf6cd3d0e7209bbe8819ff841e8839b237be53f0c6d4d6b03e17db6b3f5f39832c9e7a7d971b6b318fd196ac42b5f7e78bfaf06d9f252f391f9be242ad75c85a3b1c79f8145f731ddf8339c83c57de84ddf830baf74170e781abef5ccf3ab04bcb48358fb1f806ddec496ca77e26b58d7e0cbb66dec6ed9dbb29eab9c39f65124d811fc1ecd07a9b9c7eddf8872b7d935ee24ba8bcf71fcb296d3c5f51a29c5ae7417fe2fe74fa575e9691c5fca7449ae5fdabd4021e72f669d9c7d7e4307b8777af513e95afca948acf60a9a9cbcc77bc9e5bd1df4bde25f63dfcdb57ada3dbc77da664b015109777457de739d21fc4e579fe5fe6076ccad3e5ee01dc05902f1423fa700f903ff02586c3cddfe3240347d554bee5e4a97c8b94583eee1d3cd4d300f122f12cb51b77a9db6481b34fc324de3de0702eeac8b4dafbc667f059a4f7aba833e38a45cdb93bfffb2fe8fdb42f76ef52d4272e74de5a9fb9fe6c56a747ed253e7c5459c5bf5cc12d45496f0d3f26d69af9c552aa9f53fbbd1253a37842e5029f5ba89e169e3b5ef85b7d193b13f8d7489cc03e811fec043c93188a649db7497ddb97d829ed69f47111323c6d8e9faaeb69be2a35ddc996a34cca7d8fb18de09b4d4852e2f9d71d9eeca89e4bf8bd8498f1e3f9aad2f150c779a8a7916a3ddc7791da3e56583c7ff50fd276e6af7332d5fdd694fb16bc39b4fd9297eaa90c2ccf00e68d779faacaa6f0a3b79081afe1f08ab6195e15b93361259657d9935be0f0625191d87eec8f37e00c54965656c6911bb870c8faf65b6d6decf2f98df1db874fa7cbc9bf27f2d257cd9d6fb335199c8f459d43f67cab352c7951d8774b3bb9cbda9ff64e9e636e42d56ebadf2f9ea3d03d170f75c1af7d47ce0207e735ba9355da56a7763ec387d4c7b5958fde546b96657c3d8df5f101d74ed75e81a3d4b7a92fa13507c36b38e89b6fb0fd05d9143a7637ecea7fd83ed2ec813036a6b283f84198c9456e007f1fddb4dd70df34eea7a878cfbd8efbe5bd71d3067e47792fc4f43212ae78a7ef4d75fa9ad6ec1bd581040f7780031e7bcc8174b8d6d7addd83026afbe573fca5bbff218ec8ba723e334c74be9e51027929d3df95eff075c1a2afaf63965fe1d72bfbc5f5857175aed8d133c808be1d9373667fd567c5391f79dbec5cd8622ff3a9cd41f13657dfdf10d83eca1d06ecc486e55a322cd36451de29f9bd7f0ca7f2de73ce3926073edfbefc1bfef03bbccd033b60de172e31cdabdcec590dd77fd959d9ddf183b37194357f79e7a9a61fb3775ed213d93b35ee87fad90887d6fff45c98f73119211c5ac79f19e57af9477f21d7a8a6c33867e1798e9d21858e7f0cb79b963a85bd8bb936954de3e2137078f5ff17988d6afb65dc9957f3fa96131fb1d347b2bfd2760367d493789baff6f637e719fb6e1700837cf77bec09a5039dd1dbda9d46bb81e9b1f0d9b19a6c390ff6d6101816a0e0578bdc6f7be4be2554c62706f9b11e9cc615bf345ba7caffcbbdf25bc3ced3fbcdea8c793186f35d8c8118df4cd422df9a176e7ce1dbcb3295f9886cb6ce587fae4d27844ecf686dd355cf29e203afeec78cf1405ee5e9d14fa89dc2ea894d686e3bb862c19b446da4a1790cc5f385ca5c551162b5b7870e0cecc2f5dcc5174d45bdadb813f4df16b6ba24c0b5d1bcafe17811800ba72f6fa28488a805381f9a993795e12749fc84ac47fd921b71112704b2ef186d91df4e50bb18b9d7e020a171786d68085eaeb3ae60a330f95ee0faa183ef6356e7e9e1f9e9084d3cb8377c81e7a3e7d0fc814bec08c7d079d5a6b1f7a128f06d9aae7d9809e396f25a7be3f8751a71afb123fdb48af55680155aec3de89d09b0bf14ff021d02faf8a2f0ab032b160387d195f10cf0e0b3d8795b143cd973d7405dc00bd3593b816aa7d14aa67a2c8bcf40372a6a6d303c0bc52309343e38bcfacfb555db3ba7c2e35f8e6bb1a8d5af9ff59a6cd65d433b3bdf9dec8b9ae8e5fdb5e9cdbed77242762fd525addc633f23e4f63671d1f3f45eb197798e51d42e058fc2ce73f534ca1af70bb8e1518f87b4ad3b385e5b9c7bbe761e2aeddefb2def7cbcae4ce7659ed82577d2b53e4f9acfe37493feccdefefb7c4d1c2a4b4b5c2df60fb5316e728e6137602f4722f9d7771aed05d1db9abbb632763c1b3c9dafa8ebaf62f5dc243f5ae7bebb45bf4a6e95a2efb535c7bb4294e4b15997e9823f2f65cd298adb2bfa53d8eadaea9558061b0b5746f9241c9a6dfd2ee7f1d3985f19df2c78bb225741fd1289fe74a7fbc81d1a17c821d443c0398f85ce7fae728eeaba77cdce127de7dc69e2097ee0732fded9f8aed7b46fbabe637726a8c5d49a8fd76a93e346f52c56dbea1a5f27fbb9dbd7e3efddfcbb2347d9f9aede29ce5785831a7c2b2b8efc99c5a24de241feeeb3fbadd677c495f06e5f5886aa9dc5fda6da56b5ef5edf51d9f7b20adfcbb457fb5b6d1c36f30b70f60a7fcd269df89b75f0c1a133b4572caf91ce9797af5333e6a832275fafc563bf31fc726193d4f6096fcfc39f18b0da313fc532f612bae4b472a7fbda5c8d57f773ffb05e523726be6bca74ddbeb5af2553ff667bbc59e3beb9eb3bf25e36ff34ecdf1b9fe1c7425bf50e91da3946f938f2fdf74dffa8d67c7b92777fdf8fcd4795dbe6e8abe402dee6077dfdfdf342f945f781f39672f63bea241d118318b9b57d973ddafb6dbec3911b0f7c01d5b9d2ea67a25177a0b1912fe63f3eccedefa2d6c0c83548d4bd72c056ce78aff16cd4ef8fa236d07fd80ba14af1bbe9dd7e599ff39c4bf64d16b30446e466bd8abb26bd20c73c7414864fd238e3ab9fa9503db58da960bd7761ff967fe58df2afbce213e1cbbe1c73cfbe9346cd1c0eddc0f13b2fe02bcf3f3e9a65f09318f9f0569fb1ba71ea439f716b32f0015ee5d1f3d7718c2c6389b57bec57bbc671af7e5b79359f56b14445bde3fd2c1649029d27ee73f589ebf79fe69edcf13eb5d601ab73c091bb3cbdf8721d0337df968e61eeca6d75b7ecc7e4157f02edbb14a9f5fcb92b37e8355f92d98bc728899761422ed4ee14259a43548c2f2af3e4aaf662c957f78867a21c67db1848a5ef0f65d49ddd83391f92d35567efd1dac33eab01a0f7b5f66b8776f8b1fe2e62c8116a65a066b3ab7d392fb8a27fe6b2f322a01f27dfd5f6d8f7b1aaa0d647a6ad9ae561d48d11d3cd1a7cb4dc398eae98e0e6737b135f2abef15c6778782fd27e0217c5c3e2ce1d21094549faaa8c7c54332d12fd5fc01005ae5ee29e79fbacf97c91bd9ff40ef3a2768b023943f394165e3dc7fd269e48fd614bc44fe2be7cf49197e7da17cfd177fe54862f18dc95a8697a40fe729481ef5bcfe073b88f5b5fe52ad6e4866f39d2b6463272a97f70e5b93adb57efffc711a4f8fbe58cbcb04395cf2312bd3df509aafa325a09a2e79c05df3ead7eae4add29fdde3dbdfb2a113535af0912897ed71f9a859e1c69d5ef173653ad1fda335db1662f157be179ece14ece37ef7bd1cf7fcfd7a5b972a1ec0bd716077f7f5b1edcf1e9157fe1dc114e1edb6b0df971d4f7ca3843537001d531321fbf67d6d9f83e952fa1e87762115cc66fab467dafafd5ee64e68ff8a78e3fc338a9ae863b23d2d6c8791cff57bfdbe5bccf3638337ebf8fc4c1032c1ada1b3c8fc1c2c7f4c9bbdc258614baf2928bb17fea87c9fbc8c76013c4ddc0bffd37f33e24c8fcc0359af028b7f913872861987f1a7fec5d7c7577fcfe0d989fb7d3f769ef32a773422efe54f80ff8f8e2fe1cb9c62610983dcbeac13e3ba37c3db8d2673eb79d1426348f50d206661a897b9633de6b8bcbeefcf868af0b992ab9d05ad04c1e57f5216f2a2731ec22c707571d3864379a2a9178486bac2d3cd744aed66fe496e6368cb27d7476c2a4c8f59dfc9fba3cee6ca32e39849980ff8a67b6bc3381fd148ae7a3dfdd2c6efadf60f3f0b008ca295251773ac7198dc065ec58cfdaaf8e8d278fe637effc8539e59dcbba0e827d075e9d116ab10f75097a8827c6b853fec1ef7fda863fdb40277983bfe01bb0f0bf067e0a7edd628f51ffd606f9c8fb4ed895f16c6d3d9bf636e45f749db307ba0b7811975ae0c4bf43d4a61e9aed6b1c737d5ad77bfe27fc7ee59ce4becaef36b3c9c54565cc7a16bbc6ef5f8af9db77046296b5b1360feedaa6bedfc71b1af38471a6b7e6723e58a6beb8dca18d4ace19676db932b7acadcfea52e56dab376ddfcfbf35b261d74cbee847a9ccb73a58943ee0ab2e006642dc7f9d087c2405ef1df6d5d6cc1ee0b1178c2bbbe75efbba30a702cd018b12bba329464af35fe0af4c48a4addf78eb65d16f37e43c3ef15174d87bb06d0ef1506bf2c95ab395accc870ceba38023a2e51e9ebcae8bdb0349b11feb644d32c842df022a5f06151e59e4f9e9a7fc9e936f38bb296f3be399b6161e380c5d3f8d29164346bc1debf0c7871c4ca29da6fae00fce3c875ca05b8dfaf23a760df0c683eb11b9e705d7d7efc0fdb8d1b90a7b5900d72b89295e95bb969dca581ae47b5d0fb25560aa492776f5b6fc565487892bef215697e7c3f16c5cf38795c993824729cadaad3f1fd7dec4ff70fb8d135d47dce181131f70e7827bd657ed1de5d25855789d805170a48bc778288145a035880a9e148a87c038f7f063ec7d575f07aa728887e35deeb7a07e50e018d83af5102fcfb461c1d3340116fd82f31a17fe10ae8fc33c44e2e65e7fbdb9bb2291e2c308bdb3f21aa69d706b94638a8417f93355e9121471e9a17ef487f69af2db1578e8e1b8f16cdb22f9d33647e733b6b5adc4fab4436653eb75bbda56e2341037e579bead0711d3bee7b8ef5b3bbb82d7e2dac93f55b2f73356d7127eff6d5ed3e097aae06ea4723900de31aff74efb11d5ed1aca035bf4d3434d205559855d7d795f5390b35f88e9229fa485ef9220b65c9db3e20c311b16f77dbe9e16eaf928c57c5e7e0dcd2ebe81dadecdfe9a7618bd7848c749734f9bf69263cb3fc799fc1778891be5836f0dde2f05fed4dfea04321d6b7fbb2f6cd15e692acdc5a567b72e8b65868d6983e32bf64afd1cdcc485af3ac1eafd9f78484ee1658cb5a0d8b95fc009ba1fe9a8b6b6b48d3474e27cef65f2254ac63db7af1d7f76e1eb7cbf609db047fced19389ed3f77a3d9745c90da24a51ccb8bfaeebc66ab1d5f2a3d8fc2d9acf705597c8d7f175ac627b6e90bc2f94d7045caf1bc3b004f80676e94ffc3fb9c612f3ffc7dff6d857e9cf4a3ce8d7942f5fddd66b71c56c8cd8dc8fb29632376f9feb07b0210b54bb8fda12edfdc256e5db1f0be3a2bd7246965e72265e02796c576b841d10d3f6a7548703b6aa904fe559808c42ed1b8a0d73f565b8356fefb80d7483fa18d00f92d721bf5f77bcb344fdc2c0d181296b88c515fb2abf1be27cbe56f97ce953df3554dab65df80d1a70a6d80793e63ba5baa7a94fd5d2111345ed85bbbd7d133fa8cf539e2bd5a16d38ac0df846c8398ab3ddf135bdab3a468d673bb2fee679442dfd2042653c0bed5bf40a5f42fd7b4cc6823b2e5615015cb6715fee30bf345d1fe8c0e01ef7d598dce7dd35629629c79d232aacdeeeef9e0efe1cec57ca29bedbba5fd843a5eec7b0c1a36db5edfd958bad223fd879fddeb8af861dde7d4ddb9de47dbed7cf6fe450ac1abf2adc3db7388507beb3dc3ea3734efd2306377fdd56956dce6507ce1c3bbb9923aedfec154cf2dd374ad952ab37085b77ab15354598ee5c6256fb4b0b32bce46e75943ff0bb05ee64e13bca653ea536d9d25719e7da5027a1d3030e59f41d691376696ef336cae4244a7a7b6d488eb1ab2dcca12e44aab21b39fa32cf3929cee023ce7b73e98b94ff8ee26c5bfb36b6e6daedfacb68fb12172b931f31c53c636cbeabb7c62757f643f57d6aef94b1cd55ef128a67819eadedf88879f22aebc5b99fb63eb838dae6544c9eeb0e9e136fbda4f7f6dc471d138f9d535f8c576177d93c1fe0687ae579eefc15ef2db87986b6aaa4bed8366633b870e46b4d56044ebc7ca6dbd7daedeb8320510ebe78abefe932eabf85aa924d091783d418e742bcd817956fd04703277ab86f9be68fea3ab48d0138b1d9b72bef1173193aca36ccbeb88faabe7360f00adb2c69e03f5015d45c3c42a642b6a09e59a82aab465c6dc5976eb3f71ad6a441cfa3b8e68ee7084b5f7caf7de7812cee0699d4693d17339e7ef728f7df5cc6e21eeb79f0d9bd67c3969dbeee37f3dcf8cf686b56f35d6157a13efdc543de4912d118769e53221cc384208ec6fc240695e3ac4e22b5852b392179ada0e9153732ea5f39bdd1265736e7e3b3c206ee98ba5cd58e23d1de7b894d73016255e9d2d815c6f34c27039e1fbaaeb83bc46ae39e26c00b7a8ef7176378659b6d719f96ef2863df5192977de7dfae3a840f7cb2a324fc3d6b2f439508e1b4e59efdc6dbb38d3609c69cc64ee7362e74c15c44998c9c2e126d651a27d486e6d2cfeeeaffe5b92885bd5fcb79aa70bdf3f753d187d6f36e5aca786adb866949f2647356ccc73cd5b5bbed469ecf61cb333be09f1fabcd3fb94c892ff99e25e722579bdd4b957629de96ae5fe0dafb511718d698f96c262bce7ee3cab1b29d47fbc032908fd572cf4f5ea95b8dbdbc9f88a453e26454caa3beb9d5174b6cd9d64cc3ad7d8ca123a2b6911367a57fafcc59f2b350ec2ca689dd996cf525fcb43772a58ae7bfc3b994716d622e7dd4146b7a8620fe6b02dbe01498d19f7dd425530e3f571fbf6bf94dcf6aa7d6eea272be9ff8f9ebe74fdb1435b3652b1485b5778fcbbcaf7781fb952882efead2edef674e8f9eb3bbfc7fe0587066d51ec5ecddfd2db1975ef7eedb36d5a7b7c61af1d647b9033c7fd18d2f771f16fe85da1e7a411656ecef20b13b5eb14fe839405d4b65efd13639f74062bf7d91cfa92e8b12fbad41ffa8ebc7153f53b1d799aeb3f2dd25c346a3ef3a990f9435e3c3bc8c90679fd86f9e83f9b70ff1d060f2427b2223984f8bf2c6b13e72f0e9b66aef108383dff5af71c61193202e8f5ad9feb4c116abfa1eeb6daf668eddf19d5ec6db9fafe8cab7637bfdcead8cc3514e1117f39be75443de4d80c51812c41b2f5a7ffd7b19fedfdf7ffefb87acb430d9a61f6df91ccee38fe67356b1cf3a9e935e9eeebdadb964fc40e7e88a9fa59889d6fb481d1c2277f0c0d642ce36f5c7bb1e6a266d1187eb259a122fdbe73e6c2a7df9588c67bc187e4de6e6df55e225f35b1c43d1773d60549ecc4beddb7ded680f8c653434114b6c3863f86eedef95b97c3f7c5f69cd7de5caaff7d3f85bf4744e7d709ab5d4e3c6ebd7f4b83089d3f05a9f87e25bcb7b7818ff0e1ce3776e47303f8cda3bb01aed3fd0af795fd66cc59c00ef9ae709bfaddae631d4b972cbbe5ccff6f0815cc9fb7daffb3d3ac3ec9d58558e5ea21c799cf23670884e6b9e60d138359fd1669f1b6b1b31ea2ee539403e376a8455f2f9e553289e53afbba1feda3c0656d602c11aed8b7a08910afcb021d56a88b4d19fa19f2702f8ee9ef9cd81b1c05900ee627fe518ae62efafb8c2a76b41f77d8fb5b5e1d5e3aa623f5aca0debf23cf7f3b41c4d85dcff20a0a61bf4341b5c08a50ef863f5f1db55ce9bef2c16e0f7538c1db1abff9ee7b9dfcc6fd9fe93b85b7d3fad8a7c7f6079b58132b3fad20fd3bed3c9f4a97d971fe706d4a71a5f34a5388f1c79f034a6f1129684cc87c885db7d11977595e9b4669caaecbec4357bed178d1fb37aefedeffefa7c14fdb9f8d34699f1865a3ee1b0755dfd57f8242cdf5d76a6398f07b3b3505fd406778134eaa32e11c5bba4e0cea37ced392fc766ee1a3bdf5da65e0d1b2e6f7cf0aea83de49fb038a14cc2ae8c5810ab53ab839f2bf715c1761bc6e082a2ffefab84f286c4ceb9133caa419bcfff6fdf3937d5efafddc35aa283bfe4027b36ece6be9f5ccf78fb6f24deffedd5980be62fe74129eae4bced7d51abe16a3cd47aec1af02b1c63a7319799ae43a42a1dcc7dc143f6355c444d8f798b87326263244ade16938e307bd1cf7c1bbfc73c1b73db30677d8df3fdd63c6737637fe11cb1fb773e344fc05bfbaecee53b03778d36580a715f1e46494f88dac63116cd7770358e11a8b616bb266bf75eaed4eebd1b996c0f96ca54b1add1f4edbfcfe60c83df77b4357ffb19b73e8715a83662aaffbfc487cb6f35c786cb98f0a85ff8ee80712cf10537b81af378e76ba4f39f73f33b22f869067bd407fabeee6c43554972bd6649b0df75a101878071bb2678775731ec897b1db2f876d9762e4b049c69c6ed715efa58efc4f80d2e6d7f8a5c96e84f31f7f77883da7eace321ec3d303dfffd9cc63bcff169edaa40b53bb16a7d29cefd17fbdd7c266b32664920ef83062cd033195aec9747673754c9b2e5de3d8f3edae804156c1fb0f1e0a84cf6ef51d79442d52e6bf7328cfc9f91c86af1643dc4930ff1001cc764edd7b156b5f826ee85c079969f549737f7f9681f357e3cce396c900d793caae80374ad628daf6b08d905fe0afacd56f2f0b3f57baf395e657ddaeab7cb761eecaf344cf6b8875ee7dce4de1dc5fe2387a02bffa677f8aa71ff65c011ffaf3805ebed333d6c885a2ab0cd8c65045c84d8dbc5cc9f1dabcb65dc9777a82548710d53791d2536adfb78adb94ff98db7c04ce05c16f807d45d0b1ca9b1a66d510ff541fd5a2b18ea699ce435101b742f8e9fec66fe5ac646b4adf99b72cb21c7616b36f84d1ef994f26f3ee6e9437d1bb2f7dc454b5debb97d61ab641d38c272fea21e50c475e2e2fd223764fafec299afe903c5d8b8b8b1c0313ac8fffca2bf9ac38194768af60b9d5b5b35cf19c61cabcdb507f97787997a5d13f5101f8ccfdc84434231d39fbb3baa7bed6361f42bfdef7fbc6467d5fa72c55a9e602f4422bd8f89a64a47f8eca11f316e85b5e7cac8d7607925bdcc179543e01a15fb0971755a839ce57f9fc9bc2fa761023cbb90631257fcbcc362ad10b3c9ebf2081de8e3a81d1727128b951370864b31f0e27c6c7a0a1ef1b99de3be5bc64e3b745e9ee57b7d224fd1adf76753eb0bd51d7aa73972e3c56587d502a9ef17e6f7422e89b7dd206698f98e20698a2e209f33acd9a6cdfccd36381ed4b34471f6d33b3f4b877d8fe2ed695d81ed955fc75d51dedd4d533e2d4776f27580ea77b83a16fa28e7631f188695313b6918bf50c37d50e9cfc7625c9ff3b7daf8bf0d6ecedae0f2da79daa75a35e7630a9c9f5df042dfd581c5f325e6af05de9fe5f6145868f0a9a694dbb76b438fa8e60320676c4fcfe070b3089cbc8eec03df05ed73be2764e0d41766573fc6ae9cfb55efedf21772d569dbbcfd51bf5b91c3b2eabdbb196249fbb49dbe643ecce9407cde9bb6d38fc66d715d39b7da7f455d1bc6f5d3c9bf05bdddd8845bca27d58dbaa73dea4879e2fef2fd819c0a5573f9d46f043c816a2e81c7c0dd8c1ab3a36d7cf9d51750ff6a1d74c7695b9f74de5769ed35c604c8de77e55dd86d9b7baa099c73521fa3639f42b5d7c473c4d1d1aafd688d55459d3912a9e763dcbffa2f9edddf571dc54843f0804e856ee0985be8b3ac6666a5ddc6383bde4be62df7da8b79118841fd2cead65822f0d4a650ad1b6295b1136b31abcb8f199727a3aa433b42ea6f374ff1e0953d54cc0ff639e626f5b765dda263b01d576bbebeecc32cfac38f1592c3dc12be996dcff67af2ca7ca7e1961893ceb5e6fdd54681dc5ceca9cfbe2f131f39d05d0dfe2460d4689c0a35816e73ba689e727e6f1d34a5defe6d8e68052b4490a310ab8bc5345144afa8b7d297ab3c3a3b4df1057f2afd57f25a0d9483dfb5c1edfbddcae4659401c378de69aa72799e5726205f50f0c405ad6d344d7ac7d0392fb5296b5ba158a20962750f6d3247926696a1b43deb71b99ed2de73d26344eabac7337ec6b2fd61872f3f57bd4ee048927be9ed3dd1aa7123fe7ca97e085d3fb4c1d7f3c12dd936cffcd2ca86eb78ce5b7b1db6f8fe7d4dadfc6f1bfb90e7663c96ad5c5999f7e762ac3fb873108ad0f57bd42e6f69eb882dee9163e8289db003fb7bd2763ee42051de2c71798c86b043e2b24fafebf7f7b182da385f9e47ca717f7ce213384689b4f4d5de0cfe90f6b9785af6899a9f956f60dff5b2305136a150c458a1a3bd57f94e3fcb5f6e855dfde039e6b1c04634c80aaefd50bc8bfdc9c73600a77aceeb4b7e6dffbd5c23d5252672587613b1b7f1a7139e7caaedebebf3ed7c0e15bf0afd9647bfc5dd4399e7b48edf3dc517d49ecf79b652ff9b10695b701918d7dcddead9ec3fe3cc94228d74fe73fbdafff93944ddbfdec1bf485694286be8bc4ef67174a755eedbcd3f0fef02853bd7988be73e5b60da80016a7727d0367daa13510c18d726c178824e7a69b927b9b8b846bfed505f7ae2fe7b518f215cc924daeac76885dc0529055e80d52384add6613e1d70dc6fe2220771088e1499716536f1133ce08fa0736bfc996ec70d98e046d95c9b0fae3cbecd4bccc749a2b6589c728d8c3f0ff81ad1978def98c71877636b391b9d5ff113dc7ca3f0156c43b5b7f21ce8597e1238e794ea7f35ce15f9e0bb26e55e6c97dbac8434b77ff5bba78b06f01c9d9b6f1775e981abfac38b4be6baee8d8ffc767da86c17b64ed7c8bc0cdf433cc9be8ca83c3089aff650730db9cb904f4760825efedee4f99d723bbe7b7be609f665a8a34ed726a475e7ecaceeefbe95a7d225707cd236ff703cd39eea79a8fd1b6e8dd46fc65837dbc9d57792731a27167c442bf77a27d3b6bff7df9af1fd8be6797d66a321af3a1249e23ff0e7e7cf9c050fb6605b5b6dd22cef2aebdca9b7fb1ae6ec2e1657cd2d58347ffbc6c7b08b1d23f5f3ba942972ca9a79495ae932e5be0bb7066bd7e2c646a344698dd5e4c8a4abacc6d9749f73fdc46ac1c5c878c1123d7bf54cb15ac3076fab359ea5c09597fed04f81c71d91222fff6bf9989cfb318d5465833c3fe401fa8eb082dd1d25a8056977612b07f40e9fdc603ec8baa90dc8189bf9395af8ef2ae3ec65d82face6c89f91c0c63cc8f3015f9cdfea98e8592cdb1b8e1be71bdc5f13575fb7dd4b2fe17e91cfde35e52819d772e62a3e90224e049b22a5785f9a9f4931850b4fec6591da63b952d2451bda27d427f6295f11ad0305ffb6083ce05daefe503fa2bd88b6691c297edbdd147128a9f0a7f8620ffcf907e6fbd8796e5cc49c57be6b74c0ab85fcf467b1e7686b5fe28192790d18931b99010e970b742eac7b9ccfd1324a8cd679e054df2fdfe3eb30b123acc2615bdd65f092eec2da2e74967de0185928eabb508cb7d53ad7a168a4d0654a3c2d70fd59259776255bc8b7c5798f2966455e86f0ad76c78b987297e4f9b791dabbc47d799fe32f05f8db96ec1b476050118f0c45f8cb288fda1139c014fbba9d80d33a0bc1f7a1f6b637fad2137bce144247df452bc146ff1efb092a981db5e09229f890184f7597b557c8507e1c807db7c1fe2b780488c4eee177e454c087c5f81bdfd29f953a4df11072e49c5db1a5afdc45f9bbdcb8dcd0cefc4d3a6b293b8457b8fe69db2b863751738c9807dcae681fa3ae8cd8f132eccb457e4335e605ce439a3768b173375af1e7394afcef56c399e570660851e21d7ce059d03f96a75e790feb88987832daca47f86fd99ed935b503dda5e098696bef7c1fd23e7f54fc2eff8c2e3dfacd42ef78cdcf4edbfb2fca687e57233e331649ce7ddaff1bd8dfd6582cf62dec8538cdb95b27141f5cf4b17697ddc8e39b3e37e8360d77e9b7ab4e5bbeaf1afb889f03d00d5ca343f5c82ff2fa3fa97d3229fc28234b97d8debd7ebbdd7c5c62cae77f2f539ef1a1f1efa018bcaea760c8f7fdd2efaaf606b9082de544365ebc3e47377955746e7cc7747d47fb8dda75d5b80127a7a56cefd794a7efd1f1129f72c736c8e6c7f3447c555842479bb3d8186c3c2febe5d807a1d667ce5e333bed7d11d62b71aea26d769f7f6cc33c1f87de993906ba52b393f11e79ce79871c0586f3eb84a8e70a7d2ebf9733df3108706ec001855d1dbad431a2983ee007cff0fd75686dace1f8818e1d53b91ead5acbe82eb8da5e91a9a3ac77e5b7b4114b2fe6bae4362a6398d16bf1a1625e1f9d0f9a5ba00dc0fd92a65a7f69c562feefef6dede459f4a9b5361de118242546e55a8bf72666e93bc2113838e8ad6c6dc167f55f50e03795b2cd9da698b44699a9daeba0acafa3ec22f13dcfb9414c72a81f03a7e04720a8195b9ec528a3f5432807cb4dfefca1850fb153ccdd2beb1f0fc1a9d9fb2f98ded7c07be11e2de6e0a65620e683cef3f1f1fafbecbe6abbe61a67cd9bce8eacb2fbf3a9afbb915ba179ae13d6e6cb71433a5f221baf9a5e46096cb1a5e0ad9063d9e8632be676015e52df51a8de3ebaefd7cbbae7cd1d99afc510fc99f692de3714d7fbf4cefccf726d126d376de453a5ed1bdf663d267413b3a6bcbed7778767c8e6a3af2e8fb1236d28ff3f7053497a8e1d3b9bbbe7157e1faa56c93dfa97f775651c9823edfbcd185636d6ca96977effab75701be518be7ff29c4921bf301f5b4d5de28ea198d3ab4dc9b7db812db55465d3e0636d8d6da37b12fe1c5eaec0d6ce724cab4ef947e0a369c41d73ef91dccf5abccbb7c770368cdf61f63f9bef75a0be17f38d7c87c524391f7d113658b4b0b85cd5579914977d6cb8cf9fe8a18de7a9ec57ef1b3805fdabcdd5bc162fc4f331df9eb83c1675ba1fd4fd7eb2ee1a6aba035f72c41e0d1c097a05f4e67f502710f64eb416a86cd46786accf7415ffb5db1bc5f8dff9fcbd74cef769dcff9fe813f8fe1f705fde63a40abf1e38ce97c04b2d42d14f3077d43fd3b54bcc1e6d0f78e9adbe8cb61baa87f8c865bad30dab7e16e0e0760b8613bf8d1fff81ff08e72c2ec6af14325222f1f00c1d3ce5eb9dc59c35c87efe3ec43c40d73bf809d916f7d3676a7216f3c2ce82108934c640e5472092ce6bf2a3d6b7263dd49e5aedf787b1f65ed81fb63e154987f269c0074bb1d0cade77811fdb511f9fdf9713ea83576dd177cec7288f71a63361fc2cde7c5f6350bcf25d153802c464626757ab39f86b68ff41dd580f7785f8c6df071619056e83de74ab433a84aefdf7d73821809b1460fff1313fb6319d4aff73bf8b59f208547d0cb631855eb101e69e23e36a7325e8f9b37fc7c75089310aa1b3d97ba270196d75a169ffce36c46ba90b663f3e5ef0433a523a2ff907fc2530c17360ee57cc17ed9acb48dcb31c18410887668a3d9dfba38513cde1cd6bdbe16ec173a51d7d174f68eddfb00f81bbe0e98d15ffa6fd636a2f1af62e4f4ed076797815dbc3fd58e4c9b591138be679aee85f95369fee9941b4b54f9ef3a876842e78426f0dfcdda86baf5d11d840f317c3ddbf1e8b757412256863c29f932db8c9dae6a068afe465156db7c92169dc5fc811d10667700fbe317c0fb5ab6fea095d18ce6fa7a945fdb08f1d6a688277023c1bc0fd4c9d9ef542eca48e1d747421dc9a426d0f72fd9a36ec964e3c6d216f58bb0dbafbb375cddf637c60455f1b64ef0e3ebac9dfd8f395dc11168f629cc08c477e5da92d8a3b4434b6c15458e5ba62cf9e74ec0de5a325e788d596c89f5d79e94fd46e4a8cdf9e2375a6cea4c7eebd15efcc85a83364bd849b15bc8eb104b630141ff32edf9cc7b52f6ef65e42631247db326a7119d4b7d3561c7d598cd391a3a7e1ea76ecadd6197218182f3a469ece133a3a9e39b6bc3778f1d12731447b0f9e464f7cdfdb053e91e5e0511cced46c6f4f54628c11173f497392fe203e1b25ca3acc34dedd50f4eba94fa80537134727b6bfb91d624cac58710543b188af4f3af6fbd4369599ddd3671bffe7f5f790a11fab5a3f60bb58c6af579fb73ab6650fc80f5318373f739d9b47fe97f6f6ffb5bde675abecebebb3b45628e310532ed110750e7f6f90cbf5b36d1da3b22daeee78f0929e007ec6967b3c1bd7f38885f1e4255d691576c18758de599d50eced58dd32c6917b469d82fc9e52956d43fde7877a6ae51b7b0bef5b64f3d8deafde17e5bb2df479bdc2f5d6a67e19933b890e7fdc9a8fbfb6696d8b48348e51621d826d5bbdde925ed11deeb86b617fa9035efe10f03039ef6195a36f682397113cca0b7f6b1f725b093100aa4bd01a0e459d54bfab2f4347a0eb1aabca25e88e0fda80d97dc83572e56594a0668c45f11c650cddad6083583e7395ab37ef3b8d3950ddc5dadaa85786da93dbd851b6fef4b4f0b67647537b58bb3cc6a00eaafd054e00b8e62d3019888ffb19b8c08cd417df583d3cf879a94e4fe6c010b0fd198afa7fe0a5070628ec9a47ea47b8dbaff69b279e059f71dd689bf3d1c7f8fb5cb97bbbfe6defe05f23e22b1631f459e73e0f9bf91c363ef6deecf7a9090f5f39bf797b5b9de4f8ac5860b5f55eb9db85da1e5ba54cbf866e728e7e025bbaee3ccf0f79662733bbe43a67768155683c5bd4b6d8523eda96e7caeb72ce556dcd2aed3e951d9e2860fe0fb123d13622cad30a39226c4bec0feda321e039d81623d14c22e525df01d3652bdf60b1ca265d27129555e09c77ad73a067638e1fa1d1c7835aff69a89e9745fd05660327be636f3ce0fa33f95ad76595fb7bf23a83f69a61dacf31e575076716118b9a78916b2f435acf50415d53a20ded0bb0f27e62135f855eb543cdd32cec1a174d3553c4288037f3c4e5324c24120e29e7fa3a1cda1b56eb89cda78d1a9ae0f9acc43495347c148b768d25b8064375708f27e7e8cde5bcdbbd1dedffa3bb8abfffcbf9e5fafe69dfce6406bc70bbbb5e7cc90f526dbf5863ea7797a84f7b3ecdd714ba8aa682c71bd84bb22aee8f3cd67b5d1f867306efdb9ec57777d7bb87ca681226937dacbeeffd1b8ce8031f60ea6f710fd961f6e681e7053289ae41a8c2bf6596f1fc6838393e5ee77c2e1b6ccb626e986f177801ba3e3bdf9148593779da3bc5fdf7a77a5ceee3aabcfff00cdbd94c257fdae2e78d99f6d219f65d7d17387b52acaf279e8fb133297977c3fc5c82271ab52c8e21d50f7a87120f222a074dd5f13eb84371df767ca7acab42e62ab9b0b35eea4f773eb08a9d7a1def7daca8e87fc3be5e51d9939cd320f799ef59bce5c6f743edcd72cc344fe2063fc7b917b8ba1ffa1bd2f5e1ea7ef8de34a6f542be2a876bfb95444417c0c9d53657c4edda9768fbf1f0bee7da1d94d3117258cff2b13c1caf138a88c1fe6fee9d6bfb2556b4860387ae59de33cc97055ec770487d5fd5fb09dc797b4d3580ab3e868e9d3ddb9b76d24b1b6d89e6bba0facee7e61dfb7aeb1f81457f700f9088c4cbd6fc13ebc5abf3ed7a6e8919022f37eedf35e5aea67714ea4f94b9130bcf313723c898dcff5839f7b4461ee5c9d554b2870d1127b00d962470b502a79f7ad0670b7ede867b05753ec3c45c46c95d9dcfaafe56f4bb85eec6b8cee85cc7cb39e21f34afb857e683bc18e341ec2e8b4545caf3939bf02df6255095df8163b6ae9150d92f0beddb98c7bbb2065f57c19b7bb31f85485c2e03956c0286632edf1b3eb72fafefb27337348fa1782af8ffd6a178dec1768a32f0fff5bafe90f9aa9dc902391c1ed3ef6255c9505312f744d4055ec396f2faad32d5b7cb1a89aa009f5e1aa967a2a9d011078b829becf1392dfaf924bed022376ce6929965d5e20f989f9fe0739aa98a447de18b669d8ae31fabad3b5f2facefd191985e46ddebbbecbeda3cdd8f572c4965ed79b2dbd76de2ff6cbb1f3fb1ff9cc0f545ca27d0453c5cd29fe0a12ae739ef5bdbbb2e4e881827ef9f93b9f9bbbc3c3cc7ea0bb225b4e63fe7c8daab5ff7a7aae471818b54c646b4812e698a3f1959f0bdcab340ed9d588c9cf257fcecf7963fb9b2af78ef697ccda0393c5dd451982c26b631196d24c5ee3fd07db7fe657cb14e85bed46a3ea9eee09f3cd73871ef30622ace17e32d0f6bf96f4d321ff8e0ff201191336e9e06f1c137e822cf1a3927e5bd7d231762b4a7f42ef91dd83b3c3ccb8df7aa6f99f6a2c4cff8d081c13105bf6da5ae45a167177c3d5156c4a02987fdb1e08582fe1225f77652db789dcbfa33b5deef781bae7107baae2272b47967aa22871d7b607b0d79917c9e50cc29df16723c47d904ce5bcb7337e0c55dabe76449b9ff9b70e6f573725f93ac59df13af7de4cb9d622fb0b6d93b126aad7722f014b8f28d5fcdebb5c8656837c789b2f455c2e7a54f94c34b3e23ce596cf427946d177a3b7cbe0bc4b9d7a1da13fcbeb0f22836e8e3b7252a4b2bdbdce1d2a123862e300ba8fde01fa3adbdaf9e9717f67f23774b65fc9cb35c95dbfad1a71c8b939edb47ce493a085c631d3399100932ea40bec7659b9b15efac788e94fb7a9ed441b8e7e3e6c625c4a24df8c76347605c768d6b9e517bbfed9a73e2435e51772c314efeecf7d19ba5a2e7ea9bb03bf937be7ea3f92c6efd6594800fd37a1a9b2c6a66d79f698df1743c57a7fbec15bd80fa6c44e44ecbcb30af8b7684fd1224b0317be26d9b35b9e5eae0c8fddd52afcac6a7e6f9e59ca92c44ddda92a75b27790cc05a78a2b20e729d01b190b7f9b43df6c84ec8f2ba2e521a893456b97095d3efeb37ab5c50e0ff6be1efcf3120b539b9bf8317fbeb370499e1f51aefe16a5b234bd972f233bd915d8e633fa1f362b5688fe2ca0f9e233cf6e156fd3265bfefef9427fa51d9bf7cdd2c1efec8f11d69dfba46f3b7c10bb6be4fc24428eb29874e0f3637b8d997f322c7fe9ab37d8c45896111e489e79289e79c0e9a52b451d4759c2c02b5b7a3b19c4c5e032b5cf881a94f7f6b1ffc619eef1d7691afaf5fb4a18f3a3e4b4d158ef0bdfb53f914a83df0de2ea3e1c78dafbe228f951c07016e446f2aec3c472734e74cd52560432375b38f13fb103aef5c399ccf6dbbb5a332a19b8f977f9fdac7b93a68a9b38cb91c64557f77e84405fef99a6b4e4e2bf0d002671020770f396b5b9f84dde5b211375cdbb3b48f6d7d5a4e6ce7ed4e28b61631a88736dc8dfdc078981c698b7ec5f4db4ae1a37f3ae7f4bcd077063c5c336a9cad7de7f4bfe4c92bf07374fedc443ac6d91d9ff6dbc8c1ef05f0d5ac3972e98df26c270609fbc23aaf8bfbf4b9b4b5cdbc2dde796d3fb377783e82d457ed23eaf8b79bdfc12b75969dc031499821a6cceeb341ba8c136596ef21963f3830665656c5319436d21f1ff596ddc9027cac8183da8ee6511b32dc3972f40a7ff0d64e4375b2009661aa98c6ec9bb5c8f108ef7cf9a29a694c988c11cfcba84b791ec4bccfc27f51b68fb435d72f958fad93ce1a6ca2461f864dfbd3fb6e31cc52a55dcc5ffe5d31bd34dea15b5a73115cbc077b634fad0ef939fb2c06ade68baadf2fda36452d58c6118458566f3da2f13cfbd8e02340bfd7be2afd97e7d4e678533f392fa3c466324088dc7e6f09ce4ee0074395ace8b3b536c19bc2748f4aedea5193eeb168d6a5f8b285cef97ffcf8869ffa1dd8dcbbafcaf4eb39bdb6d956068bbe783e049c7d112abdf564c5fddb2660b1ce57fc8fc8a77009e518f96f94f54277d58bbf638f89988b7d21bf8f9e2ba7a3ee39ef1fcb9bfe3e6d67af044e4ceb75f0ef52b2f592f796f3ced37b8c6328ee992d516d077a676f1ba9cbae2b0a479ff9502bfba7c7c3f6f279e17705cfb01838f12a14589cd8a5fcf0dbb02bffa61823d15e1577f4cd5c556579ea8bfab1c13eacdfaf2b96f7c99e2fda05c67094c4c708b9a3f0e738659e7bfad4879c634ff2313c3a172272ac4cf245fff17d7ecf4588b4adbf0d579d7f500b84afc7902d7cb6b1da60433ef68d636c07567f8c3bbed0b15be3868d8f66b9d36cc391bdef943996ec3ec1de000795b41df565c40c0aeece9bda48f295c760fab628da82df244a589d5c5adb45d98c9cbcfe2e6c7dfaadeda6c4e1d1b6c1fd5d9c7b7a27d3b60f77b196ebde874df80ed910a90aeadfbd334eb594d54510c1db54eefb696f1f8abd2dea4e865df9bde44ccaebbce05b12e35fb8798fef37a1cf35e8ebcdb1977c0d4744de792e2151b38cccdb14eacf7074ad07b24c208fce4a94c4889db59565e74fe8a81c6ee2f2db1bae6cabceafab6f81e76dd05984283931fd17325cbe807365049fe536eec658bbd5e69f3821992ff68e21953fe4f8f44c16b2a6f86e421a7dc181ab7762c736fe02bf7855de6c02d763fe3c5d0c2d611938d2047ef70732278b87369939adf299be87626f1faa83a7ba38d34568db61def6c17727cd7b696b0a61b7754ec3c5f8f82ad7cef59ba3cbfb6fe7f2b1ff31850fe8092773fdecf0b0f6dfc2ae2c45c99567c2dd18c7684832ca1dd1ff483cb1779acf760be467cf2f664f1bf44ebe238da13b684a2576525db3fec76a2ada12f8e87ca6d3b89bea7b9579548c93e7eae7f0696ebf4679c9a2adb27b615f7762a777098167556d70b51e82aed1e573cd8217b3adff60d2fe4e15952c7006fb505556a80fe13be66a3ec33d2ba72337ff265f26184df56af831873cfe8736b9363bdad406a86d62fc09bbadf18c9d9772151272f1a672e239461aa286418e4921142734d48f6142d69aea03e37cd1682e9174f1e14bcde44e807bd2897fd3bee539cde062def9fdd32274284f21c3c64bbbf9f4fa3cdd57e280e6dd003bedf7818db037c89d07efbf3f1cd31c3c2f016edea0981676c79fa204b98f6453fc7eb4e2eb8698db7b9da7aec347a2bd8e5d9de43a61519791e22345cc7fa12bb6dccbeb005850b127c4ecfd077afb05385f4d29db5accbac01809e99cc994af70a197ba78fdf9d5cffe603f9a7e40079f0173120fcf4a984c52ba265d1b39026eb967073de83cfbc04d49b492e59f82218589f11b3cf461d7be68030336c16234edfc1f6dc1e9c7f58c88b1f8de162fecd81d8653a83edbff58b9420fedb48f25de8cdf576d116b1ab3f92fef19a732d6029b3ed59aeda507b20c6364fc92dc35b754a513b48ceb8f671fcd7da8dfb79576efefdbda7cc047e21a82e79c518ffbb723bc1d193e98fae482aec1b0bba43362fc99054ffc48240796e3d180237dc6a149f9e9c1ad45e5dda80bee38ca55bb9bbb66ea75c7fb0a1feeb1581fd45b0ffafcb9a4effc0f311231f23ac0a7e2eaa5bfb26c73d8c27797cf35adbf7fad296957daa4f1d083d7dd1498b18d0f4c5f2e6329aeb0a88734ea5f6bf7d33c15e6b7832e1488c0204bdb2a3e9cfaf9aef5f132e816885d44db07d8fedabcdedb10a16377eee2e0f93cfd0206311eda1797d6e332fef8aefeabd47bf2f9885c75f2a7f6edfc5dd173cd5384188a3b7e78b69ff023147b9bce3764ef686bff693e8ba8d55b70c1b7d123785c1f852fb4bed78a3aab5a1e7ff98fd61da8e636af7a62d49551d78044d90d76615dd33316a8b3e725bdcdbccc95a6b8bf7d9cbced69fde0a940e371753df377a671f67b556f412e0a7d7ed5d25edcfac0bb3fc7f7dcdcb3a3eef53de603c51d14b9df2af1d2558b58295ff6e67940d775e5ea55b0b7b137c269f5aecbf332dbf34a0e766d644bd52756c8e1624fa0f67b959f254e7a47ffea1760712b3b6bf019dfac616ffbd407867513e3d227f972bd90fa1c1ffd8c8f498a9d57f23907d92bb110d6f69563e9060b133abd8def5c560bdda0fcf56fbffbb3382124a6fe4b7b771b3b617932d05b8b7abee0b0d9b1dc1b8a5966791c998f9815fd2eade99b86498cba88276a0babf53ab70ffc43efcc0f14b94363e3b9e672e47a380b823fddfc33e2fb3a057fdaf28c125affae94277f857be9468f8ab73671b2b77da5fed07ff8895afcf087973189fb784a657c1facc6694506649bf427f428a7b91edd435b5ae93cd14798edb5b50962403c2c41ecd8ac9e5f4bbde2259e305ff06d390346b2c014d07b53256beca918bc242507116ac2831392e2c19cd8b1f657dc21e5fedfb33b5f021f6f58d42444deaf6a279e6bef62f69c5fe4a5811f3cdfdb1dca3f979035f2d07cb5b78ee1e3db9acdf5a82bf2e63a3f4f3182a52c68a343d4c64de74aaaac05eaac7d54fd2ae9cfec95781d57a689b1639fd878f0ef471ce0e84f2770fcd63547b83a67d7a8e4cd79fb1b8e57608a0e7e51c736fbe0ca125f2522cb03a06d30d9b20e55e512d118c15ddb8fd6958eadc18f7183b72bea6b6aadcf5bac9264eea417ae3d7c192ff2fa74a7c5158bd4e6fed05ea949ed8e33d90a912bd397bfe5d8828fdff6c5b02619308bc2294a500703d89b25d1144399b17ab2c89d8d127b99d7d128f7d2027c4334cee028078ac5c4f983ae37b4d7f46caae723b839615bfa2cbe10aa3664e232e7939613df51c05b0fbf0bcb3bbcd624f5559be6cb522ec0a27644f6f17bd2317f7c9fca299e03e7008b4db05c9225d12807a50d1fccf3fa1d2f73ae47174dc9ebf969036309bf09e21da3ec855a74439e2feeb41c4d855ccf15eebfc3b55beef7cf53cca40dbfa7c264f1ea89acb8c6c5bae30c753762967bd683eeb089863a8960c3aa6701eb1a653d3cd7c9f75a896369d8f7c6b7b06b1f62565fff4b3e9feb5976c71d03fc1be00a7eea038b2fe0c7c8fb50f8bb0a9b9fc59e5eb3f52bed71cf7bc7ee9b03db6939e66cfcf1824fb3689bddafb1da3b867d9a6757dacf854e87d828f32de22cc1bf49e2fee35a7f05b626eada27d4e589fb32d5e3ae3eca1ecd03443cbded39435d0053b545ababaf1fda5555ec0df5e5c9a13715fe9d4c8560dcef48aed0f9a3e5b8f8a33b0527e839f2bfa5f1f78cda3a03dff5688c00ff69fdea79fdd88f66d2137dadaa8fc6c44b4c12afeefd4d2fdcefae69e903d3f6369fb4f7bb81236d7cd7bbfa94689f7a29fccaf3be94cb95c5ef9b79d4f15dcf1a28fe5f8b939132ae48b95b7eaa834569f357efc26edcc519831f630e0ca9cdec3dfbb4fa49626542969389bd79e7cab98edd771459769af2f81a6d81b2cdcffa5588b75d9e3c77c3b3a7dde940f866b5d59517af9ce3a53111aeb69ee7da9dbcb670198767317ee8b834179fe9297e5ada69aa22b09ac2f979564f0be45cb19c4c70ddd43803ebbcbdf0515e3107ec7c6fd087c079438df32d6a5544899281d729c27dee1afc980489b59965ccdae6ebb1f1bfbc6ed7f852fc3b740cbece257c2c4c47429daab6f81471fccafa55db2f6471d9dfcabc5eb996b29bb97b16cb07de706f8b4b89c9ccdd833a3a7d9a0bded75dab2f2bac5e52f5fcb036e3893915c6a1d83be57c2d821d0db1c6f6329c0ab2af9a59e3b7b6f1c99f0a2b7a6762df3d94e1d7f31c77cbda4d0db1912fe82bcc5f839c5ce8237c0c4f3cd114d488dbb7ae2f385ebfc24d506d9fe15755aacbb1f3067d19b81cff1825880718847258d1b893713deb437b8b33074c7ca9a723a6a85a8b50ed2d99bfe612a936611c56c857dc58b95e76dd772ff2ac71f6cf3414a11b4ddad6d4b9df97490fbebdcec8a2e7ef0d5cc3116c36865f2c9f1f72ee9b9a9e5c5b439eeca93cf31493519dbbda737c79f3b18f8a31254253ce8f6bf505c41c3b909981635c5aca9c4fc63865b9125ba277b22d7cecaddcd702fe957594c96275edb4bed0b4769cbd5eb993efdafdbbf3cbeee1d3c835109f470d25c4cbb8f7b1c56ca599f8f63fb1a12bed17f83c6adf54eec893efea39a751ce5b71a4f782432e857fa3b093467db91ba8641df465dcddb807704fff6fcff57651f1a3092b76aee795f8fb4653916b1e4f725f84fd336c21cb6d81f914acdea1e18eaff9506af37fb31f386bc1f7af74914384985befc0c186b99e13773c87ecda9eb957f643d936bbe34d355d86497cacfa318a5c6d16b7bccc698dafcee2fb003ce91bf015ae19073e74bb2454edb70a8f0ab5c318971a99d3fb8200634f5ad5fd26fb4b3c44ce545e871bfd88b2f7ad7b6fa3a08d25ea66058e2ec4aa7d2ba38aff5651d7944276df16b58e1d71b09f93ce9f5ffddede77ccd49b6ee8fd001b2cce04f82cffa158c00435e7a17f283bb7ec4f0fb658776477fefc526f7e077fa7c06a36e0bfc4475eec7be8286fbf545acb660f3bee7b77897be80ffa33728cfcdfb3de3bfe1f75bb6275f91ea876ea8bcbceafa1b1f69c33f93e15e873bfd46b9fbe4f91b7f876f5d530bdb3faffcf6a9dff9d39d6d735fc36d92f476e1a7fef9ec04bb4099c418ab18d847de40ea33d64f8cfbc06e89162e5c06fa4f4d2d0a1fcaad775e89e6f7ed739d0784cff0deb85f71197ddfd5285e57c78a6f187918df8c4fe8dce33d67025acf37f6ffef9d5a7f8ced41f9abf7fa94b423179c3f3c513959d3f2dd6e67ced537f9362bf688bfa987f95b8addb7b1fba15d9fbc2fe1225063014fffd9cc6e51874a1b3d30a5e199bfbccfdb9c0f977cd4e949015e5e7bfb74198bc34320fb89012df4ce332d4efe04ef7ef2311fe30b2fc3ec5bc5cf7f1f7d9fb11eb137675f0dd1d3ce7fc2b7004e01d2ed735dbbfdffe6e443a7f5ce574f8feffb1f76edda922db1fe807da0f2d10d6098fc10862948e20d7372e46d0c2b09606c54f7fc62c0a04a5b44cd6dee38cf13f4fdd9d16a8cbac59f3f29bbff9fafc0b9ef7816b707cdc7b6ef20cbca5b5cc2e15d813a827f8dc7b985f7397bf0b07181f70140d82f1f1d3770033693c47bc9e400f28b28fcfe7311df2aa67d459bf93f56ffd77c7e76a709a0edfe8b7bfb7d659f3ceff5fb710dd42ea8f42dcfb58b5cbb7f560dbc84c19c7bdfa235306d0631238717beb5af0bb2707f041eb9ac5ffe36b8dd79adc6ba04f0837729f9c4e800f66f07f4637b77401d5f6e3952deea933bc9b131d05d0ef987f66b5fb135f00dcd6e12b709efb6d3b777260b4ebfe424f59117f6f5a9e6514ce0c59ef3f6f8be7cd03799fb67f9a44db97bb391b1338a5797b17b2f17de07c04e4d73d7e9ff87cc51f3015e4fabf8b9857caa80f5fc8dbe96c2b8bfff37ec6907b5a4f0e4b536390b96a8c0ffa1af3a56b7c5ee48157edde8d14db9fb90f808beecce132ff44d6ba0fe7d4772e70bc813c438d6df2763ab799b15ce2bdf87e770db5e70a6774d857d8229b056b07322a5b3d383bead92798a5fa1b539cdbe8ab878891b7896dd3648cdfcdbf8183e5e3051baf57573e0dce9edc934f0d813f77f8760ec9772663dbdaacdab19c0774d04ddd017be30b764ee509e2edd41adb69a8a253f0400e97226f6d7d883c24735e76cc233e41d18ff26c90033a461f4afb7d94fda3e6bec1ce10bf7c77fee5abbb7e19443806bd725e99b11b07ca3af4c6197c67b2c0f81265c57572bb842bc5132608f23c750c2a76263bcca7d3e63a4fb51bebdd8cbfe71e62e6529a84a0932a1b680567c082f894297bbaf9f20976e05bd9eaa369b3e3f16ee942fc7777457a39f5cba99f1d8bb864d311fa433d512117ecef4221c2f140eb8ce3df7bee6cef99dca2aecdd586c9c27394d35b8b279de483dab84b1cef09b0bd0f1899a7b437ee3bd49ef1bf138e9b8a4f9513fc94abce73daaf97e2662d7a6cb5de3c4033bf9bb9b807ce63f3bee63b77751289f59dc74ecbe7202f8ba146f3c4a88b8487eac5e0fbbc92872d0caee7f86093e7d5d9e39228db23823184fa71c0c7fcb979eec8787b7067bd7951d3365e17a3bd71f31ebab03108d735e09f4e75febd9a07f06640bf6d8d6dfd71dd5e6bccd41a45f80d7a62bd0f66f7ed07c06fe4cbbe7adade18f7f9fbac6be42a476c8b056ef210e6878ceb863c7290df64bc132cfec13b61dfce2f7bc2448cc646e109761965d0ab67b3829851b736c6bacc159c31359dfafbc11f8841bedb101b8c230d716dbf8ccccb86b55a2f53098f03f0f4effc1e2d178394b28f423de61ebb882e77bc9dc23c208fbb4c29f2b6d5e7c04d0035d13fe303e9da7011d4fd635cd48f6c90cef8d8f55e2363a7483d2680d7ba75c745d8af67bce356dfb183c9376c29f16ee76abb3a6e8be38fd77605d5de3a3f03bc2b94fd86e756f3caeea9fa75ffc0febfd035580ecfefbe93d3fc3e6f788311a79c7b66bfd33edb12af80d7c0b20779bcaa173ec6bb5f8e2916f4b52b4ca0d7158ab2fb1c377d3618d107425c8a18d312430df0505c0743a99167629b16514a935b34f0ad4912f07fd987c0b2650f3d678ffee61efeb5bdaae7fcc07ad7fa103fef54cfcfd28a7fd4779e8a4095f6be291e82f10beddc101c04e379596c287ab2be37fa6bff0816bff49d83e4708774c27150cf0975ae18fb51fdad734fe05a3f88a9551c08c7e48ddc43044b18ba436debbaf1293a01defa99b60f6719d8b6e77bbdff7deb8be599b7bfa6db380f78e8ff0e31f06a7d631efd094c09eedd05c1c050d6d8287d67ffe7c7188fae4e021cd69fa064b4815ae3b0d7fd3d3061fef17882027e77e7ffef5f435ecc0227baf73b0df0fca1a31c16a46f5e8de9698db7beebaaf9609e33c253399052cc9f3bbe37debd1d423f67c778f7b21c7942cd75a5edaeecc07b6b20181ff1787ee3ff19fcec7b9c3f4d0c85ac3115b342d602784f596d97c3bd985dc50b1a57b5043cda40ada531d217a6c58d313f28fc5da8d747dc5cd8bb177ab4fe0db3ec59673c89d5bbb6a003ed31be7beabda3d8e9541ba188b2e3067242be29d6f2046b786b9d7f0526a3de63b3137e05cc7589308ffc768f0f76ceea25acdf32dbd064b2f65d4f847fbbe2635cfb83a0e23728efda61044349e69853ee6ee46547e63c01456629be8d9d86e3768f2172c6489fbe39f02639d08bde6a71103fa5f4bdc2efbb8333bdb8cbaeb8d55f56da86132d4e99692b8a7cf4eebde462bb3d035e47a3081d250f6feb94defdf387e08b89803718842ec2ebf120474bad93f0beddf025b6a12ab172ee1cff9d3f9effa9bf31b5a1d6463c613cbb43f4f0796d25fa7ec6bb50e8e95ddef1eb2ff6b33e07db18f9fcb138f7e9bbd8e36d7c983a0d16a168d73591efa61459a6fbb0247682bf7b235e10aab85f39e33de03dce69b9b54f1e8f792a2adecbdbbc1eeb88c81070f9ff9b4ac9bfdb668cbf62dede79555ffcde3ba833d62e2fe69f77bee2c56c71557311afa3889336bee327d0d3a4ceaf82ef8f7b5c0cc5f5056614db8c9a39d86b4a33ae5593fb485f7e6963af555f8dbe85713dcff3e73e4407c74ed121758c99e4ccab75191c8e33f589cd7e25f541be735c031f1efd9c274528e89f01af248c679d63b13b2a5e01e84153733c605f400854a988f8e48fe7f4f37bb7cff7f9b73d7d56efeab7f3bce873477f202ee60b132e1a038f029b5d7097eba21f8fed9ad67eb2d8c4630bd9736b64ff4bbd3fce6b2004ce1e7816b6d1166aedec3e5c2d5ddf5ccd4f3cf9fdb1da3ce0f7095e0f46dbe8a19eb1f05e5e71612e670e20e0d97bdad7bddba0ce3fe03717b5864f55bd3ef4e24ee53c4ce5b9a9d0b9fecfb50a72ba345f9e3d53fe8c323b6bc91f8ce18f67e36fd1e5eefc9bebbb85dd3eeb7cabf9ed787067efaa781f7ed6c5e3a4f134b850fb170a31f090298c67b77c70dfbe7c5512a2527e35487fbff0a25e2c12a097abbd07beba88c75cbb1bc223720a1d65170ee50cf7301a4f7252cf5d73d402d748d55b5fc51c780413cd9d1ea881eaf6ab528e78ccf3811db92af53e3bfb351b2ef7844941b89e986203107b091d04bd0c1b8e258a6ea9d7ae354696fb5c7b7ac4466e6440c13d4181a7aeeea30436d5465345ac879b7ef8c2a488dd9755c87bc466b64b7c1671be1ce7ac7ebc0f30f7d969f20cffd4d7f3fbfb8062a8572d6241afdfd363d3f5e87b8813f39d3d049b0af45eb5f642bd26fdfb13946cba6eb6f65ae3867818cd5ee8cd0d03873bd4e617a1bacfe3617f0f83f8fcff817367537197559c4cad9e27103fc33af28ac3a0a3bfc43f3df7446f2e11d62a28ff32574e8591fea87a3b49bfa352c27d903ec6b0cfbb7c9ae60fe5d70247fc735e1f5acfff18729983901781b702f67d077d67ab7e240ae4a9caff1d46b07f2c60cbb6c7f331aa6b1337ab78dde100eae58eb8aed5bce67206b986be7f6156f3075d700b71ec7b4ad37ff1f91b5ff5fd0dfa05e30bfbfb63ba81bbfa1eaf585b070c5f8eb3f9e3392b03ea9f1d44641b7ae0c4f9f2ecf735bffd6038cbcb8adf00f3b147e5658fba86af90f79d6311a917fd915ce0755576a18a657aab8d711f65c253a8971037f54d59b347dc4c6fc53b22820739f75eeac5f2dcd2cf50d79b2f879c09313cb091a294ebe8ec8eaeadb099b0677b2bb38fb1033c2a5cd39775fad2fbeefa7dfbb93b011e9dc413e67bf3017f3e70577762332fa995d9f0def46ebc651be7cbd124b7aa3ed1e41b549be0811c1b7a6ae22d2deeda39ee1d63dcc095b5631218670ef7ed1ade8579f25d7d100a13a80de9f58beef979b076b49867c81f330dd79931de77f33bfe0de1f8efb1af05f896c773279817d8ada4e7c57f6c5edaf9a9fcbab067f8d9293ffacfdb026a99c5139ca50fc5f8f480ab7b8c8ab8c646018f6116ad5abd705b73a1f2ffb77e73c7571f4ec09fb8bbceadd84d11aafe3a74a9dc69f0ed01f067033770e8705c98e1fa3d15632a5cdc7388550f526c8c363641dac5ea31f152f915f8546347c4bdd08d16a71f03f654b36ef5f645f1ab01f1b2f4e9f743f8e47abdc6c6ce73b41bb229bd76f8657fe07ff7fb3052d18e13d37813bbbd76e55d0cf53a50872968ab76bc669ab2c5b9483caa0807adf75ee8d87715f25e3be0d1d8bd9b71e8a671fc5646b8d7c044807e0ce767a726d4cb1c93a52371f1f858f956b846c546e1f658eba9dd9bc9417c6cab0dc9d93463a22b9e7f353d7a801761c8ad435e1cf88e38980a64bedb5911f352e19dd7abf0308f8f719af2fa09629f212fb5b87ba18e87839e1845ec1acf5357df7bce7107f7a6efd8ed78dd3f108f7b3f7325fe7933e3efd59a77f7f3ee7dd189b5dde7ddeececf9680f33f09c9bb6877d80318a0ae3c0c936fe1602f6cedcb3debe58d2471d4661f802be9164fd9bd334eee9eae8c527d61a988c69384d1fe66ca85033f499431f0dbb7ee595827180773bea4c579567f8faac75af5eb8cfabda547c0a7a4e97bfd40d61de2f0bfa6fce857d5afa292e97fb770a71884bf51bb1b2f68d5d9d779b52ccaec3aa706b19dafa94b6a694db9d1119a0adc24cad6af7b7ed6719f715c06d023c9795a05ced30ac7bb09fedc7310f499697aec69239237035cd20870b2283146602f2b03cfa9fafc6a231fb05c1ccc0738ca2abe750bc6d970a078ee047ad8029e09f30692b1271ac4095de8d163a03327e853caeac339436e0e7679c57b03eba5154b95db455b99d869c961d2b92b617feabe6f2f29d6e3f6a11d8b4e22156a1045a1b687a6dc816e0bd3e2c7ed7bc2de475a1ac7559da20d35172856aabf115ee53f982b03df1775feca780e1c711d8eed8defcef269474eaef03a27c0e6e33acd6d7c68ceca8ae14c9e65eb8e5eeedee77f2dc70ae315b04fc5d09ff7913c7beb0e797dd84fc77dba7c47f90cf963ee9d722152a46ebc85c967b858b314eef001e8fae68c7e34e7c53ebd999dfa4a1807dcf955bc15fbbe5c1e12eeebf6bdc0781fc5acf7c3593751e336a0bf08c7adbc885d196298849b94cd1e7c908fa9fa1ed18196601fa0e730e1ccac75e209f3f438b34ebfec5aff40ac4353dae366d73115070ffe66b7e7f43d9d406c4a72462b2e21bc565cbd56fdb1557c26740e38de5cbee2adb9ef13b4e7c614a3fbf5af0935042093dc898c8b707ef6e69b5aef971dd37e61bc333d1a57faadd8c7d3d43dc7c93c122773db636838e7abb15062049d358d3374e8a9d7f8c13ad61801990fdc971b7646fccb3365bdc2243e9a5b983d945b20df22f984631e674d3fdc5524001e4c21fcdbf8cec57c4734ff485b1f07816becfc85489b03e6e57c2b5f3e2b5b15d789298073c16777b8f9a7b99710d439e21e457155ef4e38eb15c281715f2f5fdc5bbde361cf41d436229e170dd7b182f35ec6c39ff9b8b7e29c4b156df05a575c04b046f1f2342b3fc01658ef9f27436a1e92e2c74e9afaec8a0faa9e03acf9cb27a979cfdfcb9767af8bd38dba1848dc77e169ea0036c3a8e35b4583415cdfe35dc5364a42745f2be654f58da87d9b8fa1972f3176c6f8001d1dda52eebf72154f8b72dcb8eeee34dbc29df9997d0ce7b94659e3966c78530bded78331baf033af701efdfee513c6902880219a7cf82aa27029263bd0b1902bf033b4fd4b78c17a2e30ffd51b392b14be3e067be4259d0ad0b3fd89f89ed297cb039f877dc275db637b07bc3bd67802ffbe8e15e9bd8acb88a7290ffdd4f44772404476e634bde8cd2d6e6e319eadd95aa3d80b672cc1fbd8c863f588de51222f6c790e32a6298d3d446aad6b1db4c9df4f8722064c9e6afde701dd43c6fd18a668eecad75ce0f4fa92cb1827fe9bef4e7634f9a7c533807f36a863199b7d1298f27b24c409f44cb7783bf7d523aefffa97273847d5064ef85fb305f54ea5ed37c6f078649efe769213ec7153e3b054957d347ef922f6d155ccc1197143c67803d5a638df5dc035395875f125b2b7b034eabe2eac0772d8a0214bf129743067201752b94a93f705272ffe5e7d4cf5be6fc4887b73c94bc06b11ddd0922d8945b6ae636564ae94f7f4f91c603f9131d0e2f39e95d95bc34d58f962382aaeb5b5d7f53b5973ee8e3241fec07e8a867f39efde1d7bba84bb98b79f2265f735b50767bf15e38851d5affdec03ded25980b1ecf0bc4e05c06ec668397ee98d37de7fdfe409f8a37d3739b4f000fd7a997a6e9a3150ef85ff16a6cd513036ab1b432318b6561fbf5584fb5000b7efe012cbf6e6b97e72612fa3a5aaef6287f0a30d27958ddc8757a3c68ae00e3be2da0ec207f054d52c555cb9ef29658d5b31f6e598867debda3c66a6f09e7dadefff5d6c5296331fd77b339c90319ec7f6c1b4ff95deac9ea5c5179224c894a7bf79de3d274ffaead03b67116c3df89d22ad4361821ec4bc54cf0e31cf33aea1a2f1af38aaf2e5b913a8dfff915fd19d9f08b1d9a2479f31e7c997cdb8e453a04a87d699207bc46c0b3c35e3c98c3e5e65cf7744351426c50feffb75c84b7fea3bef627e4f3e92d69130c928f67ae602d64ca8fb29d2efbf5e5da2da5f06f82b25c1862970df36981262135cf0c5f371e9390382eb3be79af1f8497f0ed04d75ef4c1c87579f575e66416fac43a84a22fc0d6c59cf944bdfe10aa8a5867b95f004a0287d62d239601b0748e17c772242bc18b8f1c05e7395c3673c9e70be79583573148e2a990fc64f02ff18709f803d55efe3d3a7997ebc82cdc579c63a4f23e08b83189c70e8f09d909eeccd73ee50cb3bfaaad18787ba7fbb67a043aa0defebc066bce563b6f9951fd8b2cb2972d76f238cbc2f43b051241836e08742c00fdcaed3a09e1fdf1141860a5a7ece51ed22ca468c31a4d1d3376270754ca55edb22ca941b3d08ae716de499d7d89920dad96ae41378711cf110bbf3553c46077f81e311b9af1a2884fdaf30b1200f1f805f76f909e04f3f703c03f2336a552be90aba187180b912372eb69d20cff252bca75abf3dbd4d106078eedad31ddcb12c5bdccbd762635b5a7ac3d78671d8d2291aeb1f71faf4e7712c06191bf51e4103cfe172561dfa738c6492c7aab20f2036957e1fa3d8beb702d74842a7c76ea1db8f640c547fabeafffe977ae4dc8cadbd1e134d6deaa13a7b077bbd54a5afc0c13da27731e9c3047ff755ab00f9f42bce9a433096232d95079e3bd9fa2ed68f1f5e764cc26c1f696bf129e4c5dc2bb953c81f399c17dece56169f14d1582ffcad2178ee04bd0fa584c94eacc6d46327d262527bb47471dc9df464add617f2cde1d8267d43693660f73c78900337a5df11e4d452e977e4da28dae25c6b35c7b31f82f3b60ff9e1d76b44e12049aabcfba0c6f1b2d9600cfc235ed54f4daf75e4036b7c1df7bb78179907f3fd8163314b8237f0493f1b3aef530235d87bdf95b98867c5fcceca07f2121ed4d2878e7d8881dbaee13db24fd0e708727930c633d7fe01f7288c7809f8b7f388f4a7259881aa6f59277f214fe2617cd2c632176de522e2adbdb7b5f3703c27b616c7856323f7f82409331185c30b6c6e87ffeb29a5ed6f7b0e3d79a45e9b6039869c0b8722e83f4db0b8ec7b59e597baefa0e93dc4cca5a92f3677f58463f77260f6db3d96a22d06cf5ff6c636ad017a5f901cfcdbf0e9cf9b69dccf13e0b1ff37ea193e8ba56be753e128c60ed633a2e72445c82b87c0d5eb9c46cea66710d475e4de7692445b6a5f31dfb6362b439558ef9fc10367c8362da3f06a7f632402ef0f603bafebefaa9e14d097bfcab1413f5dc05660cc7aa36b903652e6a6296f425ee7f0b9c3f973e98b9a0f04deecf511df8d555ee77305eb1baa08ecfdb2e90b674aa5af7ac5d93e90eaf52f7dd57f7e5721bf8008c785bef35cfd04b61bbc6b5672a7804780674a4273433b8bd53ef6d8f9347bea8cefb7cbe9162550a74ac62ac6ce06cb09f413eedb53cf9afcbdb8f4ad9e7ba8cac72d1d25f532bf88b67617efd63e9f8e329ca7ecf3afec499478d9bd7875b536da48d980dd1703472ce48bd9e67fa2c62a287ee825779de7188005e2490fcf6a9f9b5cff85dee870a5e8bbc0d1ff44f8b7c05b25c3f329f458a8f7fb015c1455be7a314c99b259aae80b7cbe583de7a3d8f4d404f70b82be9e9e9b30d711804c7b8e01eb04bda90073ffb5c478a567aa6eaae6345a196ddcf40fede41bfea337b513d35066fb852ad5ba89e42001872895679cc9d55d783e2f173837fc9cdddd53e0d7a39d937a4dae6db1de5caf6f09461165d6cdfda3ed47c47349a81ed318ef07e07088fc56bca74507af7e637f6c55d96a2382dd64c689cc99736210fb31a0ce74b8f9c7a9d627d11423f14b5987b89d0fdc66c3c19eee3313fd796d1f5cf09fe03ebc62b035121fce61961fa63c39efa6c664fbc4aab2adfb3045696b3d4bf177347ca6eb2f137ac76aab798b439551d659b0cc8fe90784dcf9ed38d0c5ba19d5ba39dcb6d659539ecba7021220760fb91a369b057a818a49aba71c838e00bf74f565a88003d6073fd5fbbdb153076283f6933632f20878c3aafa5adc3fad9d7b69c741b11d3fd60f804580d83c6087fda1bc27f2bac7351134bb657d14c167f04bb9d5631e25535706dc09f08e7fb8bcb289b8434a95798412d29f7714b813e40973569d22424db7ef261f9e43302e8e42fa90b4bf05fb48bea1e01a8601d8692e4ff0412ddffe968f4d70cc109f293c47247768136b2863f399e96e81fab65815c99983e757776566e140dd1ef8d5cc75bb5c6b2cc0a35b3e600ff77fb7e1aac0f5ae09d47286f8ff2b5bd2ebef00f9308fefb575fbf65baf7d688c2f13fc47f6fc157afcdac075c6dbf59e33ed23f8aa98e7683b3b810e58923353df775321ce7d3546b1c2a80f2a6cfb26801a243e3e4d6b591b8a65dc175f26df9b6e38043d89027ec4780f8d0edfdc43e833b53e6317fdd277486e03f252ce5124fd2fb17d087c14754ff3783c81de2827121f287d572ea2ad713a73e85bf8377ee6e7de25eed7d16963f9e3b1f6ab3e9f5bc8b59581b34fd8f7baeb9b6b19b7bedcef686b15613326dc2b6ee039f173cc2b038f5fe53d716590c1b5afe2f3ff3be20d14aa88079ccf34034e64e92b520745eb1b7bf2fe35be5fdc388955fd13faf8c03febf753f6b5e7eec631a8662d5ab246f53962e61a1cadfc862ddacd65388a10610ceacbdf8861fb9e1b9f7a6c21daba88f8f759227a995d5263fb8ebd8b86b2e943ffc8ad9f909826e31a59ad71bcacf4934689f7f461ed110ac72f2b2bc33519bc47e2d64dcc8e3f16b133df639e08670ef52d80bbe720df175fdcc7b47a1e5f95f63d7b44f8a7ec10f8a7a05fda12afc18f7aa59da26c46fc007817ec79658b4717f3fb80782bf86f59752f9cf1e52dfc2d918361dad7ff902e1fbe73ecc39d53e503ff9e96f76be402fac547ab79a66c7c9e99a7fe3b72c0078e2d6823f053a12f1cc606081e0f3c2172cbd78158338c293e81ee0ef9c18a8c157aa33673bdaabbb8bf4e5ddb780bf229d77e72e165e897cb5763737956bb18de41c5ebfbbe0b3574dc9ed186a1f9c6675b628b50047d659dc1033901858b8103bc5e9b7a4c8a813c5e2c4255da06ce153e6b1d0ab2887b76126e5cc7b10f30cfd0942ddfd1bf42fe58f83c4a1ef16d495d10e076c47a1cd5d910d33e9e1ec7412854710d39a35cceeeaf2142b9ef1c3750a7dfa36bbb328244326ea89fc6f765859b83339d6efe5936e3c3fe2970fb178c72731e433faec50f9ccd4f73f2b7302dbe69a18f39a79b0692acc560af2c6e7364f4da5c81b3a1d5d4ef349570390f39e82f88a016d11f1e562043912a7d613f0bd77dda1b6dc439f35206ac726da7756c6bd00350d307b5afc1e5b9a7c4036ff1c19df7211662d8cb2dca03a7f58d46674b9196023ee600b954b41c1b28caa0afee217d47280fbadc0e98d7a2b3ff555cf22b1adb036d6c88916ac17b3e03c7d8f88e08efe63de7c8618c4255b7214fedcfc37481e2e5697ef8b03b7885eb3d033c03d8eda7ce9a005ef359b38f9133d04773db905de5183a169a4cadc96c31d0a7984f60932873cb789e6fa491637243c38a278bed71b81818fed4b2ff3586287295414a91adce790acc3b38991eae8dfa9ee8d183e99457c00f1b40ef50a8df989b5c0035ebaebb7bfa00bb52407940e2175735b9a92406384700fd8c7358dfdc27f9880f5559c72ae27d0bc7051a7b9732477a2ca98a817765a6a4c6c1814761a029bab22859f36af347eed7d6fb89cf33d611ae931eca88e47d5761c3c74dced458dec54eb467aa69a4c5dc2b6e4b2b009b6e8cf51cd607e76fd91b6d1ce7b1ba5ac56a8234858c15fa9276eae35ef2770ed6e75c6719a7ad7a54f365df8729a6ec5b5b365b6b735746db35cce9bdbb0ce2224b474a22815a97e2878231f09c11a31e9f97ad6faeb4d7e8919c5efdad461f7762c4aa04bfdd07ae5ed9ffa97cc07ccb80c933e501c4163435017be854ef17ac61b3dedbd94ec37de7e1dc403c59af39034ebea37311e6b9a1db63646c3d310f8acd82567bc79106b1aa2453ab8a1b7802a92db8d0afce90abdfbf3f73ce13dbfb768f9a5e8eede91615f1d848b0ff8a8e515cee8a47b86797f5b8a976b7c4d938ceb063948bd1e93139b02ddc4ba1ceefaa93123822a1e6b8f6b5bcadbdd5c646e939d0c342db814f160936aaff46fa45119fabc23844e34951dde757be59831da2c54d3bf3194ebec29223f5a070efa122c61ce0fa1f77a85ddd9f04c70ef5a6a0bb37adda3ffcfbe5793d576fcaee305d6c708dc27b5fbeffe2dd677ce0452c8f970ebe239e7c47293d3ee9ae333a466f42b2071b40abebc4604ccd380027f8fc1f8d12c7ee9e8b7aafae75134de7e0bb27cb518c393e81931cdf3b05ac435cad435f9f64fc2d8c9f64f3458efa4bfff8fbe3f030773bf5eb98db18d77f15da58ffc4f8f42c3af3e099b200bd427d133042801594d7603f36fd2bc7c6a7ef20e02515c214f3ed417f3d341dca5ca442cf8f39a943964ed3a12c78aeb10e868755a8a23f3e9155c287f3150f65d07505dc3d512a63debe5098513984dfe15edaca09e4953e547bef65f6e6a3ce2b9b046720a022760dcec31c8b9ffb3093889d6d91fa1738f7cfd8f6a8dff1666ad4fb29b6f1bbaefd39aaef80716a6228d803d84faa9ed9ec7eea3fb4f21e80c71eac9ccd6ee5946255fb38d6e3b7f2466e0fe5d3fb5858e0411da53772dabf025ee1a66cf18e5f012f9d489d608f1d96dbd6465b1999c4859941ee41467b6ced3d608fe55c945d7e079f8b33b7eb582e43c128b0fe3131b735ce5979db09f0e002f67e35778e3bd0dbda78023c329b9a23127f4795b2734c1ab872a4f2d2678a4a3867983776e7bb3ed46790bc45c3278bcf0ac4afc01f8b78c4fba60cbc3a9f6126416c5f9ca6b205e3f2b79302f21f80ebeffa62e77c9bc74bbb50b5d764be859f565c96d3a1fc6a7198fb1262eab8eea95e330d62195be8ef2e02eea0c9d3696339892b5f8b9973addd5bc06faf3dc6c2e0fe02bdbd037c15f1843be7b7c71f934898b57a127cc239267c6bc73dd463c50ef71c02a70b6f55bdf7c7bbe3f47522e1b98ced7d48f727da67a347161973041bbf881d71437010671b0ddb811a73af830f9341d7f0dd6f2db776e9a5343f27074c50befc6b7dc55ed20ebe4bc8d3e5087a021ee7500b6311198a4aaafe21e3b96bf75ff9a6fdb919eaf761ddcf7b3e1eb0d44cc931f4a127f552ce4619ce37c8d3147d32efa9a9d34fd41a6a0e7a87c39eb9caa1586680f7c21ca58067f97f602d7c7c578a6b7ff1799a0dd9f4a8e74cd21b9ca281e78805f33e9f3c867d467b1ffa4203fe6aab971ee4d5d17ee90e27f11baf6cc04f5f66ca5728f4e39a5a3615191be359dae64998490ff589bd184751ebb208af9b582c95beb8c4adb586b5a49e29a8177d851c3ea3ddc610ff3cbfb3e77e66aef17332fb4b1b617e0c9a6cd2e65cf94999fd7587d721c0f7cd0f79bb6f71a6381bfb5f43d1150bf98a8574c5e2f489711b5b7d2b37d85fbb043dc9c0ae44755ddde61fc04d819e8e4a297e2feff33152c6d2ddd36cfe800d59fdfed6bafbaecedcbf90117b712b1efd11a8f62e52a435f89480a3892eb89b3bdf184e3e96b0ae602f708dfddd1b1f70f15cc14edb7f447c9240cdcd8fea35ebef36feb5be0b057bd3c4b2711ce565457e73e52bd7b52e9a2a657e6697954da6739853dd41bf3c670236db20e4a51dd85661d960afd731f044f31c70a4b5b00b18a39084c3c32ae0c522e68f0872589a0ae3b156808bd054e90beca38ad3917005e3d86c3766e3ab577dd3616f2c47995fe975f83dee6306fa6edbac71a39fdbbf7569b9cd1bf562cb21f7ab96c3a90b39566d1fb0f3893732dca3e3e8f511cddeded0c73806bb5bcd0157c37807ea0ff58cb8fc462d67150717c6f390ba1338bfa16b7f054e13d71302c780daaf2f1a8eb525bf1853d4b33e177952afacee5fe910385cb3bf2cb18aea99c12dfd9e85fc91bd7efdd0afe3a9e734937681f35cf3341c02551ae0da6957fe5c767a0a56bd3600977573ddaaf7b1e2f5baf3a3df29b7e5917c93ba86ae3c605e3f967a9dcc3ec4ea0373acbf4f9fdf853ce5087ad43fc889f00b7f87cf4fd4784786f6de969967f8347bf98ebdc07dd8237b61c3b91f4e06d1d6462cba2870b8b497aff882470c736ab0e299210eb8cdf3d099948477ae2fee17048ef81530e72046c70762cdf5bceaf355df874508bcf49984fb4481ceea70a20836c4b4201f0475457998d59c277212e29e504a1e0e6552bba191fb6b44f2c2509b01757dd45c433ddfbb6b6da860fbc7051b86e2aaaeb259578accd3e47813009f01ffbcf7b263e197dcaf7abcb4f31db8acfc98f3c7f6ce7d69f4a2e7a2da3e011b35f7f8667f763ee02caabe0c80e34681eb23fcdfaeb68ac690eb4fb09d11669250e784a0e614c76921e737368a585576101f8984f92a84987c0af75955abe2bbfa60ea5418cd48554412b73d01c719e6913329b9485ce7cf6debd84dabd760e19a9fc594db2fa7dce0b7efc6c9b99e4cebb79fb7791e61acf4e63a47750b63b9d50720e3556d509e472a5784992dd6587bfc4ff78ae3e057e012dc51a9f5ebe37b3926e01fcdf462da87f7cbd001eade7e5ca370c3367386dc7056726aa82adb3afe7131476f6a69a7a90db174fb2b56f483e788f41e32f8b77a02b161bfb6f1d243df371791aa7c99b77f83732abe7507efdeb9f3aa357bcc5624cf6488b20f125ab2d6d1aefaf7a0dfae81f7d27c0fbbd59fa193e3c5bd2ce6ebc9909c512150a59d0fb59138ee6c436d1e70d85cfb046e527387e3b55a380ac4020661779ddabe68e1f79da38efebd38477f8b039c7c3b74a44d0cf5d8bdb511d56fda759cf4dfa191ef7a377d8e73fc07ce01c64a277ae51315fe0f7a342ff13ed3eff5904f58398fb8d9a17f0d29f2f5d97011418e216db8888a303b021fc12ae4fdacc2cdeb1c9c8d8b18fde61a5f0c7b6c78f6358604640774e12f984fb34ecd5ae31802c3f9953ec3f2ba0ffb1d0c22fe269dcb202f02f579b5001f97d5cf3b59fdfabcdfcf6bbd5fce2fec9f16dfd3f94e86b30c7d0123e871887bb35ce6328daf3e7d6d2b8975652fc0b8b6a4f776edc7634cff595fc29dd0cc6f3ca09ef7407dbe73deeb3d62d3adc4b685f5a96bd692c03950ce8188a6d7be09fdbe5bf59f858b9c801c663117aa4ac9e20385bc88a6902313c8bd44d1155479e40123957024b702f556dbd83926512a25f538a698731bea3714e8bd514e05e047dd436ce9341572dc8fef8c65ebca71d5c39bc586fc794d845b7dafa0ad41db5607cc28db9d5bf980f8f7943a07cfe452c0326baabe8f4ab6f3cac0c5924c9dc92ee4a315f435ebc19477f3d957f7187b3cfd62fccdd83fcc3b77c6191340c61ae31e65c1f6e55a16328c7f2cc22c4151f6fc757d87d2e462f4347df9b19cf4c6c73d0778eda42fc08484a52c5ba34d7a57e75ccfe3aebfb7c8a48d6f5ec51b7a79483a631ad43ee703318ecef8a457d02be1d67824de816d80ce386ec43e3c8783585e198f59f753e31ef01593d0c2389e4154f6f6af6d70654115bb4f3cc1686295b87eaeb239f79e2b9fcefc855cd53f406db8502a5f7f6c14217fb8b8d75ab9c2b1cefb5ce5f3bdaf2166a7afdf37dd7ebad827dd02ce6db36aad0de1ed9e7fb9e667de9767a1e980f3fc19f38a1b653fafe7fe406ed173b83519eb57cdcdaba50ce79f57f6f55ad36544cc43dc63545cb3f7a9f2f807e4440e210607f660a69f1a59c1b100439cba4681e3f826e07f26a5e74e44122b42202fc045097da9e79cbe98e19ea7f85d807158f98e9f410d7954526201949e49a4ff24e0b4bafcc5e5e69f663d2036007c34754f9c74f38f27e8bd1cc694b5e839c3951de383dc03e62c15b7816b7cc68ef61538cf45f3ed6a2ffaec1ab0b9ff401c9f5d475b22657c2dbd097ee21ec543f137b32c9f9fc923ce4f42156d4c176af11ed187f53bea3e923a6053a0775116c0fa643afa773d3adc9575d257cb570d3114a0dfa0984fb3c676821e34ed75eb5d57c078fbeea4e26c66b319efe7d4b30960d9011f0d36daf55d742b8694c5bb909f24d8fedecaa50fffecbcafb7272c7c5f08ed879f49fd6d52df43fdbe0a7dddab3b09ec2f88db6d27c8a7fba76b0bcbfe815176697909bd08f93db9c32fe55207fe3db077724a8e781d8dbf9fe7f538d041f6aeae5705db5d833a3ad05519d883d2573c9e1421ee75075c704f2b1bc73bcf5c8af8ae133016f644faef27919a405dd3ae897f42acaac6adba36e477eb5c5e9d03065d9bfbbc085c5c83b0944fc457c99626e1ba184f0a0ff2e525c6dd9d7d4732eeaa76e129658def75b83a33fd100913de1f3e7f0f5bd6c6f954f5228d6c50eedbfafff79ca39bf6ef3a22cf51fa90af7d1eb1e2688e542e70da9dd3e2259ef0fa3670e7ddb177fa1c5cf37e4ef8c6f683bb02cee8afe5eb64ad37bdca253cfe29afa480fd9d96d17f3cf01b87325fdf6d502b35b5077fde1687c25bf4f6d5a19cb3732ca57ae75dcc1ccc8f89e7fdbab78388e7a18dbd5a9e92a9fbb977cdc738fafa7c697ce7c21a6df3a759361bb4d7ad4f1e429e5b545ca28cfee2e285b27e2d19eff290fdd600fb93559cc210cb8878bd88323bd2b6c793276812e1a382b8eda91a0b7a863b92fc6eedb9930d6074492d42add70b2fb313d041e43e7d9e0f24dd1ae8efd606df1729d44bc09ed2ced879ee8c76c0467f5fd8faeb829b28bdf15224bd2e06dc623e904ce311db00ecdcad8e79f2a13f920ff557bc9d4459c517016b36e5c186d48bba67e1c7e83c16fc1bb2164cba82d78bd0adbe47b3957dd54e6377ce7877fd3c66527d0ffcc3e77f2e7a0b7d0f0f961908f80c1e88ad54773be0cf71ad0405ff02efe5d1de730e7fd92eedbcf75aeff7c7dd46bea3834fb39ba2c9bb6d89237790c88b91ff616c8eef0b4e638fdb5fe8725fb5d781c325616fef945d4e7a8e7d850eaafb5cff8efa383fda7318bea4d3b2a969fc4dfaa715cdb7404f20d29b33ddfcd3b2932f75786b0d3b7bd2db8ba5ee8f06f739fc3bc615a69b7fdecdabb1dce32d89efe9df2a96c9e5016fef3dfe00bcc883606ca73847c677f6b8cf66dcf88e7d5838e88b9dabce62b0cd0de4bb32c41a40aed9745df58c5e3df3889fd37a8ed57faf6cebc63facf41d7e8f10a652674daecfa3759cbd468c6b35fa868d0def8f3fe2f1e7cdb374cb9684beca35d7f9d49a88d53ac17b23da9ab6f60effee6e6cefaaefc2455cc5e1c4a627a2bf181d747236eeee0dc456f96a0c949e52c81210f4a778f5dcf90ff7218138098afae411adf606c451805372bbd9d7b507c49eea8ce1fe9ada8ba5236e024b4fe2bf551b91d927fc4ec021de8e9332c7c3ddce38a9f50fb4fb0c45d59aac3d978a7346f640f135454e96b8afb97162f40d06b4bc419f1fe9dbb1ec34f97ae2eb435da223b679c45b189cc6672cebfa2b183ff8364b13d743ae49ee0f6a2ad7550f74a80d33c017c0f6a2366ecd49f58b28953126205095af783cfbd254dbf5dccd0ad7700933c0fa94a1606f83a18ce680297500d763d163b0947a8d2937f83d2da58a2325b305cf457d7188b61d8a625e117bec94ae5eddeac813ecd2877847f5de66bda2a6e652bb2317addc227ec706cbc534c3f585d736a0fdb9b2ce1c92ac677bc782eb68cfdf49aff3d6176770b174b82ce445f19b5835e4a4f2fc8c05a1f8e9f4bb092db72d8e7c5abe3cb3034d91392f3be6ecdcff6ceb750b93e9dbf9c2b0f58569cdffee1d8544bc6eb133d905d06b80f6dec7f6f24a9f32fbcd17ebcbae0f492cbab1c560ff24b4dc124e357756f86e7ea8e739758ec8e795f5b2cf2f72bc07ecfe11cd2f6adf4519e4387af2aabd7939dff12eea1d1fe80d90d9d9bcf6f559edb954427e0a5c6f1cf617df16cf2d1b5bc47ebaafda7ce30394da9dbdc0be16f253f137b54e1930df63c8858abf7f9cc7fe86cc8799c14559339f7e5dd1c2de409d45ec4e0aca3e5ed47b37bd06d27bbe049659f26ef009697778ec10fbd51451cc18c7d1d711c5066bcdcbb5ebbaa23bf1fcf66f71ec05c5a6549d276756f8bc718852ae7ed71e641fb86d1fe45bc5fef9f93bd697ef5a7d3e145a629e168480a3c51fe9baf54319f20499c8af7ef0179f85b7c8bbdf00bcc5a9e76fa5463bf34f80b9839cbac7db9bf9805bb803e0927b7edcce67bf0397d06bd477712fe1b5ef18af0b7bb6a1e974ba6fdb1d3bc684641314657be05b4aa252dcf87d7a939bcce7acfbb0585164f3867fb0c175f428dccef736e614c0e35e76fb790087abb48db6f29ae12edb5a487e658b1f91fb45981cbc52845a97ebf9b3c7dd07fa81590e2106bd8f787dff2fe40087347983ff777d2fb3c7ab8f9187dfdfca7fe078d0dfa8af87f772278fa7d648415c00ec8fffd2fa55efc6eba71ef34898f7e7d787da73f42a5ef52cab62d7bbff183c1a2c54748a872ff77244d4bba1633b5cdb49642de629c5a6a0ddb5cd1c69fcb810a382f3fed3f56daf95660ef69a0a7b0b35665a8d8bc93c8c99bdec235f61127a636f29e56ec2398edb77ede57ae29eefedf7d5e772d53f1f76bb34ce638c13bee8e587cfc8635cc89d98ec70027cc083ea0eadf688f0f56db4f4ef9cad50f0e11e60b567297ab9bd2f120ff7d97d3bc82863574ec26f62058df1848ba0a66658c570417e34e518bda9a08f5f7e11fe87c227bf837583f895ab1caadf9532997b6f7cb999db8779679ddbb6092ff1d57d1817712a267d5cf0be806b558b287bfadfe556cedfbcc20161bb9d75feedf3777e678f6d4895470efa1d86c2e48ba68b02e053181beb401d31aecf88bfcfdd743cc563b423f9c83f0ef7f4ec9abbb5c77bfb406dbe87edd258558083b924bf799e0afa60e91c1195e3294b5e3d571e401cab47e6bbbe54cb56687d17df7b755ec43577ffbc995212a91b86dc07dd16a9623cc9ba191b35669124e1d648c2ecc73d09da6b52bf93358fa5798e38f01cf101ae7fc825499ff573f5f96e7a390cd9d609c703a12782897dd13e7f2237ec4459aa3a7c6bcb9e97f94eae01a1a56a3fc17e85598c22249d20ced9d44ba4f7cf6560d99ee1266b9897cfdb658ffdfaa8cd2147aab20ed4ab1abe737dc08fef4fa438a3d6bcd387f52f6aedcf57e0d84f188f4bebcb9621ccb91aabac324fe3ef69adbb0d9cedfaa0b7cf453bfed5b5a5aefd39f81be4ff0523c17d69a9bdba697e1fd4d9a06dd86097ce736d7e371edc391b38469347641cd30c357b7cde8f496eb3db8ce5ec853d5f10d8604bd5d859fcef84a3a0c5ffd68b916eef073c77bd17cc3289443cbfb7a15ce788ff3c7457f6c92acea5618e3a82bb9072c0b0f9a694836dd7b7c6018f84c0d9b3f6a5a6f118f66195cfef26f8be0acf48fab976b9cc57318ffe04a69c44637977e6da784a7bed7f0a1619b08a1803e41c0123ff055c97ee506bf528eff2f74d85d51e7fd7eef28c1ac0413704dc1f702ecb50ff1369e999db9c70a3fe9ec2b36e0cf38498d4dae73b3d3031f75d9b137d0a7d4c327d1b985c1a384fc5db426ad608ee17c085018ec0579f256dd52fcfadf841f32c5b0ca13a73cd3319faf2b3befb1ae241107f19adc0ce66948b23838d9487eecb2fe86de39b5c1e667e81f96f79c2377b7ad9ffeb8cf67ecac56fdc21051c85a6422cd3fe081cdc67bbe270e206bfdda196bf972fbfc106a7db4df53c14f03feed6ce59501bc3e62b8c0d4b54ac8d62d5f8177783746b649ba6353af3e3babb72faaa4b6ff61d9fb38a134f4217c7f692a9853968f626f09adce6fc6878e92097057137cf94f6fef0f991facc43e04e8aea2ee3f2660c95eed8f8a604b9d04decec07ed7f8f1d3bad7494fe190fa5f3734eabaeea3cd634709e07da5f8893b6653f3ccdb17cf6c83ebb8f3790426d7c8cdf0630365981f85eb495cbc0d5d123714bb087c34c1af88e9856dc51e2573894e6d57ae903df792ae6d8f68798b4d4e1ab9af2a8d015e9d9e5296be7beeca18f063bce9bc57e4607f2ce9e73d16b3faf43554aeff0305ef8223ae4e14f35ff3fcb1a563e053a4c1de036b17734ec60b0017ca15c8419a9196391a985b67b94a7a7fb9d3b76d770f2dbc23df22ffa20f7c69239d9b4ae7845713d126b1ca76f0df1b9e65b635671ed232d36f3dbb28d2276f44f461d5feaab076c2ca458a160af03c2ad051cd1916a43afe822aeb81308afd1f3ca440ae7bb13b1ea97100357af00582de8cb817b4998ed5a251138a993d8052e37b8c767ab3053f8c08989cd70a86bdc3e711ff6b2e26568fac72b31f2367bb8dfd3289304c28fbd0b9c3dd254340fa06f8a795819a7d1ea6da47cf9c0c9eeccf329e103b35465179af23cca2cba6dd8ccbd4766fae421cb0dfba1dac7fc18ab12e7f1ab076bd8cecf516582c8309baea1e24ed711f936e414fe4da5e4df2c3fc68e5d2e17f9efa8e79c5064a8920b623f62ee269bd899c3568ddb38fe84bba86b671eae6ccc364752cd830c5cfdb1fabc8a78620f6c372b5331f4c5501e2d0603e8450836eb20ca141c27f11c225f2af4684087d8399ee5ce9d412dc82e1cca4954ca28c6fa4906bf7e83b901cb03aebd037e10c0a376e475a4fcf6dcd52d99c26bc19ac76f7e9ff95fd37aedcd9ff0179375efd1ad5afd7ebb0fcb8b2aac1291d33eac754b0ec066eed611f2f5d8bf8bddad9fafebe26615bee055e27d57837fc7737f5b3c4b8fc42d5df3ce79abe206b5acdf3b6f2b43b5f9f97682fb846a23e827069c9b70bfd803d6da09fa9dd6c38db1c1f2b69ae37a287c67ec7cec03632c5b332eafe148c7fc54501b853ce82337de9cfb09a897e395b07e8e047bebf3f609746d28248d2e8e55fb291eca79b895b97828678163effcf1ec3bb2df8df967b941e4fceef9980c81f7d24f23e06ee66de08a2e608fc077aef7b1339ece9932509c4d4acc0369f5ec15a31eefe8d2a106be0eef1119b8dc17f087a64e5bf6af78d68b605b9df91a3353bf6bea5c8ef1f913fb5b26e410379de7bcbeefde3f73fd72473f1715d6a23d466ceb494457c31e92f1f222c68d9ef500975cec5571eb5c2d1cb4050e88ffca1922f2a08db8dc1720b663d57c6fbfbb1cfce7fa7bc2f9061ceb452ccc564b47e2ea7e05a47f28f0b97d121ed903e00aa09740e01a22f0d9d6bc70b52c427db7af5aab16d7d2869ce1668f48cd62b326210f35fd1a605293307d394ed79bffe9d96bde99d5eb663bf558f1bab6fb5231f8cf3def733d77f205dc0714ee34a297911e38c0556aa09bf1ef8bbc407d5e9cf269ef3af537ab5e25dd3be4f96ebd48ab4684c64d0d7f3be2fb27bd17e3e9c521d6775073cea7e731133dd5f22d9a7d94f5909fe4213baf0dff406cb0753e895d5edb78607bbd5a0ddf450c672285bb027a55f809b6e30404fc3c7b4d6d62d160ef179aca2501fcff6ebf0d8c0fc0be129c4df00d54e5108d67cd59047bd073748cf90e2a6e68a80d4e42f5b08a780eface1f6abf205695147428e17883b9fd8955e900638933b4f6ddfbf79836aafb83dcc5b2d6ebc4e44354fa51dc937d7b04c7f91db92773004c42cbe63337fff4d66e41ec131da37738cbebc1dd5ecef0b7d902ec432d65f37bc9fdd08c8b969b69e41b780ecb00b88333fbc4ec03cf1ff281f17a69c0cde84efe601fc09d359ca04d1fbe4a0e9bf814ae3b10e424caf63b5ce754be7c821c823c068e88ffa6a9355fca66e5ab08784307e178b322ef43ad9e64abaaef6fb4aafa7cc9a7b8c2ac41ad3b023f1bee82f37bd117b97feade32600ff2100f0ec0ffaacff2f0e961b9adefc3fa37440743ecfc74de0beb419f56da831f16421fb5ed1cd7a59f6d85ce7b6fda09b6f5bff079d147ec6c5276b9b127b54fdbd3fb676c5b505f82edd74154623f126a3507d8a7cdd029768013d6060efc753c6efa030d02d5de838c55baa6ea31879f01fcfa50aed764877b4ff07ae283dc96f202631c4c7911a8366abdaf92136a1cbe396fb6f9dfd1775c608a8d3e83fdd2d0d3e7347dacd6bd239bdd33dfe8bda96be7f8cebfacebb42b1e5c669fb4d377b3f111f7b1b3e9b517eefbccf559c2ef389f9f55bfaeead3a16037d4f38c9dbbfeea3e52f384b93fcd23bdbdcefa796e6e14af96ff483d723e70ee8e8d4fcf25764129cbd56fb01c1e6257ffb40403ecf01b7555461e23d2c30aeb43ee1064cad3d469fa64a128fdcb76ee56065ffb66ed0c2d6f5cad3387e7466cd4d677c136c5efde47990d31fd75ed7fb6de0de3abe608f153c72eaabafb9794e92c9cf574384db9b81acfff57eefc2ea7a3afda59ec887fe2e1f94c1139bdce216c9579a84a839fdefbad9cd4efc89677a130bf8bb16ff47b7ad3d63ac0f81ee4f0c77e75f32c2d7fb2d547be73cce32d2bd668f608eff99b353010e479a31a63a112eef1b19d02565e1be9a3454ae2f29731daba072ac4834668a0a93a70d6575cf5c496f7c1be73671dbb07f7cf235c3de156de6b10b7e5d1291e838f8cf5c338700d2d065f00ce8a00f13aa9c47851dc8f378173017768c59906bdc1604ca42f1b5d9fb479d03eff09e0bb6b2ed2d6e7bedaf0f77fd7cfc59427fd6fb3fce4abf62e707ddcf703db2c25f5fe6c7e3b75b02f719db3bb909d692901477225373cd90b5473cf3e941f3e3f4fce58cf9df027e48f45b49d0d586b8366ed3b00f862bbff5dcecace7ff3dfc0a77a53eb5caf61383076e88dcf0d9730c6ce98efe2e99bb17c9814bf0e6c336172d2c7d6ddb36f437d9c82633da76fd6d974c6fe7efa5c3d12b7fe30596cea7d11aa52199d0c8e96830d5594e27c2dcefd1ff6beaaa090dce12cfa74b67abc9eaecb9905313590518c7dd84d1d23f11dae08db1879a8fffa49cf2fea37eee682af6b01a86b0d383b05f36a559865e910f2f3c2540ccb7afdfc65d4eb7a7dee768113e33a3e9fd90ed32876d82d5e84baa79af6abfbcdd63adfb8d3693646ebde6cbdf37a5d6f63058eb9cfc3dd276f3abcda6d397594413064aff3a4c77fcfe7fdfcce1e39e8f55520d797fc7e0c3f01cfac7afd0146b922dfa4d5c002060b7de0dfabff3b1e26b00f035bfadb3204dc33b95bcbc2352688662f251ecf9d730fbc3f88811fcb943aefeb397bfb90373e205712404f138795bb60f6489f79fde21b386e047e0ff8f06129136ecf9883f825703cd4f935fc8c0af80f5297984595bfb4bd8c4d329ffbcbf9b6ea3c1a1eadefee5d8dffeec12275efceb90b7cec0962927f414e4253acf3491960f77d53fcf2dd7901bd2b5c5ee16235f98854a5cfcfc5f3f5558567bccf4e8ff47f58e0ef1b494cece33095eb3558357d35484ea8e229b156801536468a03f670a42a103327fd05ab3d873c52d47a0fe84dd2d700f7ba36dd39961fe8037f898ba8bf391d62ee13dcc3dd1fcad0371962efedf1ed23c1104318a78aa08fe4e9aa7710ebfd8d8e91077775c615bef29cbf0f0cd9aefff67774c27f49ae62e465b07758af125982ffeebb7b609ffd24120c8c0dfe6fe9081f30c50ed95f53fe84fb54c3f5e37e11658053d191a62a3be8691066caa0ea2f85f325850ffd40f9cd8a60a4362ef06a3af35d83a91943ffa5c9a0c2caeb9c9f419f0ce05a9fafc22deedf5f42cc3812a0c607c764801f15fb61f3413c3973910f30ce06d78da890efd18b30d3b9683bc1ef8a321be2f7a78b38e5c9aff8782087bb8f4ab9f41d2e099ca755b4b5bf42c03057fd3b09c606faa8db25c4bfc1f70c41af03af173ce782cfdde5b7fe7f79fbb226c571e7c1aff20f5e7f3d63b08bde62de0abab8ba609adbb0f18b0d5b16b6403ec607d7c67cf78d9465631b5f7457ef4b15b6d23a53a9549ecff19b80a71d732b4f9b60a70df28e8f6ba5eeae9ac73cf6cfdbcdd4aea10b8af0f909fe28c6bd28c7476a0c1fe2fcb49526a70c9e06dccebe009f1df7f7d144c70de57dfd60f41ecd4b1768710b684ee6ec0b40e7c175152b556c411c2a1decf934f342214e932a82ef16e85c46202707d936d705020cac79f7c6ec1f07fddbbdbdb3aeacbbde7603fce4595f499083b9f3f77cf166efccce55ddacafa067599aeb26128116af1c8eafccde6bf44ec7ab6b37cc1fc3f3f6c7f4e5dd61bcd0a80ff1a7e6a7add8f13e48b71bf9a0703e49d7c4be03b1f4a1bd7b3ebeee68dd9fcf40aec1645bd2fc216f7f7d1e0ce892e3267118e4104fdf1f133c7134c62a3b8114adbbe3b4be1e747c385b5334b897a0cd7a3e2e15e23fafefc38cd635a4d7f1fbe524985c5b47558cf0df713f36c64993e6a70f2edfc9e19961ffb0bbe06fdb0312d0ed73f2ac66b271b03b8c7983d0be23835bc66add7f8b71fb8374c75a0f721d8de96ec8ce85f04eca636283ef159c0740bb812e03cd4cecb908174106178c06fde36e4063bf28384bd4414702fb8ac77d0af234385b2036b77655e439d8bfdff592fd707c68383e810d820a364d26d255d843d2dad8892be863336a0365f75076af0c5ef5adb9d277431a6c372d16f71bf627cfcb6f41ecb65daf4b7034fe7b6e8c5fdb3fe13a7d261f0c746e0f3e2b6a95ad791ac7727c57de12e7ca28ff3e5bcc37c3b8822d9fef223f6a3e076d6d6084fdaf71b79edc26f97dc9d3031dd9797bc6f2dc86380ed17e88f180e37b7c46032fc3725902bef49b1ccfad489fce718ff7f7ce5370fe00e8bda56dfad66ec16dcf87f3ec3e803a6fbc5ec8617dccf2e8b0c7a2fe26f059df029f12ea50b9fd636aaf8574bd7fe7d57fe58e8686b409b62a9fc69f98a975a8906fa6d77b4463fcf824dc04791fd8b7cce3fe14c40c05983d32fb07e5fadb70f3badd4cddb4cd799abfe07c41c6a73141bb429e24b4c790c64d6eb7b7d036e3f647ef8e4bc0db8edee3b85ebf74870fe7a4e61d8b9cabe39999f7b9f8349ca35d9bd9ac882ffa6ad0bf412caf9ff03567f30879f947fdb1a16c2ee08bf4bff8b9706431aa96766bf2797493f7b97dde42ecd0d097bf488f1ead85a30e27bfed5ec8eb8fee85613e96ccf919dbc325fc62ef728388164e5b68d83d216b16f2a4fd716b0bf621777aca7c63eef9bf7ee98e75dd6ea8877e0b8e3e516ff5793d5386e04302f8d53520af6b185be0c9b3db5a036f0273b860fd03de41e4fac701c4405b7d9d2e8b632614e04491bcf31cf51925f755918e879df1d39bdafb0d3434a41b3724fe3cafbb4af3bab1ec8addeb206f0ce49091c66dc885cff3c2b473ebcbf2942635f8bd72b9db4c997c7525760e8ab80639c8642b538ac89bbd6a4d27df795e99f5706ca8d634a4e3ef17036fd6d7513f8ca9cb6cb77bc66ad99f3810471883df1bf0a032c86a264ce71ddae75f6e2c6749d8b6bddb5ccea1cd377bcefacf2d57cdf369fd7e59021fa28a17579167fa12647b74ba5cf67e690fde9098e46d67bf7437bce351c5de48e0759d3b1ed48ba4a957cea36ab7df247b63345c93c09fff518ecbf477bd6e746fb8e333b75f626b0ebe27216d9657202b03bb03666bd407bfb194cd33a3bb89dc482bcee78d063c2f7588c3695ec39ae8da06fc5c4631aeffda9d87cd655dbc48b403bca0eeaf061d69278f937af8c41c7e1a5d4ed4f9242de673fadbf884d0f785d9227c98da4915b5c84ef9847a1de8b78444cdc9e11702aee77c19f59a1cb75eaf4cb62a19ed9ab2884fd0b9b7ad701cbaae9aa11e78425e85ca187b056b96b7af99ed89c8dbf9362aca0910a852f7db3d0f7bbdf3e967625f2fcdcef903e4d412f81bafafa57e2209bb20e085592eae7b3e56962b53353be77bfcf7f5eb7d4f74095edafa8f413f8cf92eb64f08e801f8309b53fa7d31cae795cdf609bddfe1aa78e4d1b1e56ca5f189f9c2f6badd55eb2d581ed7ab9fb445c1e9f1ac9e8af77a9753a7c75ac88f48d3ff2f6bae4ad36f48a4c77a6b9d6e6f74b81044de4e3f687add7f0cdef5d8368cf163dd1bd0516667c5f4fa9ddb369ec7a2dc82a357c02b65d33e6bf2fc75bba011ae18ea660531524ea809f1bce6e8c14ef400e7b9d344d6a5b91b5e9a5be6e7420f2af0813cb780dc1b6bdf415661767aaa648ce06c6032be82394cd05c68b7360ed6880936c5ebe97cd97b9ab632fb47559a1eee7852e407d2b9ad0794f17435e9e765727e1e9796ade96ad55c2f574dfa5e8e4f097e499aae944d9863f349794b2637369c53adf6aad59f8cf4fcbe17edcb30bf61d887823bec491b8e6fbf3a7765674fee5deed859ade82465f3f6848c21118fe86c7c2c5a481e4edc656bbe58dd6c7d2676ce18e2578b465a0f915a9bf9155d2bf88a5cfb2fce3f321af54664d261f3f72103dfd6f9075d9fb2cb0cbf2da4935a30132f276d533b5f4ebb46fca6388fa29cec8fd8915473eaa9d2fa18e5926331b706fd2bf8a5406c27591c837cd5957ba3ff24faa62f36ed037cf3a3d7317e30baf9fa9f4d68c7f04d1d745abbde59e7cfef5b796e7cf4ba3bb05918f535032fde4901ff77d2c436ffbe7a9d32b4a7d00e7f0b32bac1ca57e4794b35d7b78f4dff8ce05edc1b1b68f8e6438caae972769b1ed075b2dcb69f3907b3f358749f41833ee464313e259652229632f8a5293cdff787d435d0a0dfde2e5a919fd0a9682fa4fbf3fc5c17cec5bd0f2ccf7e01fd396f81be6fcebf8ae349b923e444a4b9f7d43cf9aac5e1fb4c27eb2093c70d7876ed255ecfc0b9856b9f13970ddab2e6ce56bc50f44cde8ac32aff0ccd93c9d06c1bb9b9e8c1f68720b30f3e3a4c9fc1e340b07b2982dcea03e02bd60ffab5042d392af256e771dd601d9bca660a36fd3ef84f8d0e2ff5e63eea6bd359969ead59fb782939cebcb822d5f1aa423cdd3960f3a25a736b2b8f217624b797ee24c754948befac0cbb5d75306ea97579d973fe5eced325a381719e6d2e44db3493f2b594dd178b0d3ae473d3eb4a0af04379b109785cccdda2db66f4176c8bace94935fba04fb5c19601f4b55b6bed17af393b6f61cc4638e6377b736d877ad3e114f4a6fe477e3eddfcbd6a756df4cedbacbaf33cf04585f6a089fe315bc1bc7d68a3f771af26df53642fcb738c3cf81d477841b6e53911f3e451f05d6f96f1b9c9aef9228c930f6363b27cc00f26b31299eed351b96c34851b83ac8ef485e49e25e9b193d4dd76f1f6bae5f1b1196e1eb33e6aa3d7dd808a60ef02fa931f72d746833191c5e9090de9156cdab46fa9762156d02bcfed1bc6df962727b81f21694df6201bb9d29bb2b980fc8de55c553729b922fb5ed9b42c5e87238b70b6b5f7a01356527807f7a7e8ced4cdb933ed4ec85afb708e16ca8352e7261bff4fe462712af1253dbe71382feb4e846f7b24ae9b1af4539afc943fe236c923c7fb70dc1bbd6b90dfeefc13671f5be7225e96e1caafc7214d9ef1a3554eae922a5ea5e00cb211e4e629b7eb2fa3358cbfd9c57a9cc49cb17905fbbcd03f5b95eafaeacd0af02fe7bc7f6ca3f0acd80d3a072dcce118e9b193f134754d1a3bda704e55b0bb245d88f39dd5536773d40fa3ef231909acf5fcdece099132da9feaf7f37ba94286007d813117f3e2c67929d3ee6e007c43bdf37bf2c4f9bd4ad59fa6e9a1ceb673de6d5e7c24765ac083828d16a35bf21462d5c5b65aaa7866747ef4cee500a14dc2740bf16257f35ed1799dc2a9dec890fb17750636daef7d24f77d553e5cb4efb43b9dad3b9bf97abc5cac3a7f031f2557c7974bb407bef020f7ef227978d6d3739af4cb3b421eb89336a04d75b0facfbecfdabd415eb405971f2c079d440e26e335ed97e93cc46e86b654991aac2de61b39bdb13c97805bbd723fc032ba7eef7fc57d287b5666f1b3f74696325dae56675240d31eef00106363d03264b14f90d8f134794e65697d5516cfd2a7448e07988f41abbdddcc7d65f3c2e26b14f0b2b35d33c4c19ab4ba48d776e719c117e07069427ea1ddb29d6ae347af13bd7ffd319c9e417fbc152f0692262007053d18d803529039c0baac061a5f976391dc2055ff27f394e79d18d5cbf5b71bb0cdd76891df1aeb73ab3f81fa3ef5fc83f5ec4fe7cb016dee5635eeed35f034eae748cfa76f4fc4c4873558ae5617471bac216e37e81af530ffe45178269e471ebf01380df38aa4be07fddd8aad5bd9fc6b60c35dd7f66171aec3f7a7713bd611cc5f6722d8ce1c1f7505118f29756d34d41cd564fe56c0bf97e131d8e61e7f02876bc7ce96ada83f33b6461a31c2f8eceba7edbeaae94fd84e31edd9b44faa543b1feecb4fddc9581b06f0cfb744cce6a23125f79ba39a9d13ee3d2f1f2be039797d3f47d3d18006aad87929e6b7e915f2672371f5a9f1ff33fcc42bbba7717e741f9ed54e785677182e7cb47ce343b62196fee983befaf2c23b419e11eecff78de9de37b3d3467cf7f1016259be06f2b5a57d4fe13bbbb7857146fbe1dd732b8e1d98870f11f8f65752d0efd4fa21b343b6f294d6cb3b06b2b27752458b3e39067fb20eb853e4dd273ce095f1a60571c5a836cce256d17e298add5366770ab61e17e0e1db1feba9ad4a6fa577cd24ed52c16f7548fdad3c7677f23c2fdf6d6d1a85781fd40dc40003db2d8eefa5f2c1345d8fe70df2a25b30962e927b2fa04b051f61f8edfc109f8bb5945cfb87f116e5d1b2c0876ddec46b2e6fabb72f5b7f57cbff817ebf82ec4289f2cd9bb19e4795e999c80be78ccc75531bb2f8e84d354766ba95a6b7fdb78b310af7d93f512e16e667329cb75703e3a40d5e9d1129e09dd3703f1743273ff7d719f53b863a1c03beb6179b36f8cd1aea807edd6d781ca4641dc0430fbbfbf49daccd735527f190e1c65916a9bfdd689c863fc85fa0ed904f9527a57b20ba9b67622ec1599f9efba76200b44f1f6cbf434cfd16c8ea41277e55a54877c8e8c93fe04787ae9da89d336be7da8a6328a9030af6384495747f2b5e4e05b1f4cedaa6dd9bd5bd0beb5578397ec8859ad03f86782a818ff29814e3d43af84439db6cbde2e37beadc0def00daa64db6a4cdf2de2093e508c9b74bb0c674d6eccfd6efd3d16c51732e978534bac846e5a8c83b6e3b12e6df41e63acee517e1746a4ee23c4b3e9ffb31dd8afdb33698dadb475969a27e2ecf5d9fc9eeda82784d3c2f611f25f535f2a2600dcdf5b7d9babb9a358d6ece59907b075e25c692bf6f537d2f5bcf88063c9e8b70c71c1c7d24ae0fe063067aa3d41cf2b84ed9394ccf33c4603772e27b815ca49398abf7d356ee3a916eaa806729e4f9d83d3e6b6f98e8c7479e7cab4907abba7b79899e90394ec77053e43e09df214ef68ac3707f99ee6ed021bb4577007276a5d73de24ddb5037f4c07d174cf0eb45d72ebbcbec5232c91752c057dc16abdd22e73e945adf91357576d6b866bcb5e4dcb61c455cc3dd0cec04594c314e5f893ae8581f12d43bcad9ebd3bf77b276ab7996d7b8db87f5e58cb33ecfd46c534d9a14ed87c27940227c372dd3d7f3b99e05dbcdb4a50e5e034daecb83aeda35c6cef5986d632bad3df5da6ed58ecf43233d57ffa06d2e274d9e04eb4f8e9dcc72bf59d976a6afdcf63fecb3b4be7d8ff35c38b77defd5a93ce7439975eedce6e0db01991d0f7821cd5c1b6aef5763222564fafd544ccd1b02f9066939bb5ea1bc0dfa7c520797eb4eded16dfd5c544bfedd827f677fffecf8af8f7d4bc5618a625d2ad6e499f549d477298ecb644d6d88c3fc613a75e942716cca7c5c67f5f373b116ee22d1705473f573b1a8c2f11365a3412c6688b16e2bf25b708f514f4f1fd27dccb96711e4291dacaf3bf3fd37df591ffc13f0eade76a16d7302a797ca80c50730f3f2b46a3c6ec088b6f93d10fc25598e00b0020eef9212bb6332bf8fc89eae2e8e25da2ee02dc35cd3bb5e2b955ba3269e5d26e45c2b4f7fae3e8bc50d5a1fb98f4b98bbedee9bf86dd59ac0def21714629f032cb33930c1477b276b27647a519cf416c8e046c3b9b113358861dedc31391ab34f61725d64f63dc61f90347f91d1751d618e1ef1c0bf6dafc9f999f9ca66ae65e688cfe79ce26136966ef6ccbccffb46043ee59dc7505a1f3fe48836b5f3eb37fb4d6d3876b6d6bab9937370968f83d946caf1daa6692131f007f18eb97934927d061a307b7e0cf2e2ccd76bad46b49fd91c6c6690efdc47903761d36ea256d31bf53f656ef36956f2ce10cdef26856bd5f740736d6ca519a9a4a17c2e76e539db8a79a582fe15f14d9a484dadd7a6aa0972f0d52fe6077f9a0686ed432c4d13fcb0d3362ec56ba0393b6b066b6f31dafec893e6cc0fbb2b838d6d73b79904c85a414e24732bafbd3c1d0dcba5cf7403f303922635e765fb4c9e9215f8e4867a20ae831fae9b2cf6daf0e8ef4c7a45d28ce774db414c11aa899dab92f57f8e641a7daff83e7deb6475d46e7a7c5375363c9fe435c8c51dedfbb5d3bcf70d782d7e2e5fdb6c2e12edeb191b5ff7879895eff9f7ba06a007f7dff6bd8e16e7b2051f3ff0ed6f456d1f858f56e29be185c9c7f729da3e7dd5401fcfe2c6ac6ff222dbc727f24825f02ab3e68f3c63f1f9981ee3b5309e7193f9e92c7e978ded1cd699f13a51ec08e0679134f1b7d6f8a449538a32e756327f54bebf7e1cd30664d2168f5172dd997df01386f3f298b1e364fefabb01bda1c1c5c0f7fc9a74d77bbbcd59ecac16f818415f2086ca5919d0033f53a39ce971fecc11f3296f5b616e4dad381784751f7bce1d355796b37e3716f3d52a581dd79bc57a3a5e365fb8ff841e7cbf1e8f4fe8193377fdb02ff971ebabe814bffb3379e4ba84979ff338a775f1a808ffa767ae3f80bc9a5f3fc4f7afdbcdd4df2d9d6614bfb5ae4f4dca9f13e22dc67dfc34ffdba84f8fe76df1de2c8ac31a8f2f676e4fdaa0ef3d714fbafcfd96cfaf3eed8344c1e66c6ea87d7e07aa31270cbe2866705a0ffc77e81f5cfb6e15f685f90ee4eb74b5d5f484ebcaf266cffbb8c5f517f342091ab0fea64af63374bbb5153b5f3fac712b8756b71662fba4493ba32e0e24c60439cb127d7cd3a7cbb782fd57ce33c5b9bd7bad3ec49cdc0d12b862ad3f54b32369e6fb27f8ac5d8c5047eefde7cea7816d75379e07e64b55487fe3befc844ea4b64ff07d4d184d79fb699a9296c98cc0fffaa86cde4f804b1af3998bf27cd0e7e8f6c60f5449138befc9eb05c89577030ab964eaf2952f4ff095ad9509b6ab7387e51e8c6388c1bd96c9b88dad38a5489ade733f0ee7348afda00d3a27b5245fea1a623d1ffab7bc9ce5e9391d9bbb0dcf4961ad2157d80549ccbe88e9a93e9a9713c37bd261b1f16aee57663fabddfbc0f82cd09184eb07f7f43cfd366b3f941dd7dccb93b77c5a75d721863e057ccf9cb8bf516bb786d885736b2767fda4537b25ee4f5dbd139bf723dc91c097e2e7ee866cde0a7583bb9eb6b9783be0abebd2733d7f8ecae8f9d61c83ceaeb9dd4c2156b0a30d8fb97af2084e33e94d9167453037c875017caac2f439a5f3f29843cdda9178cc0747dc5a6b0ff22f2a9b17522573d28ee3963ab850f07760bc36b787e67224b8cf15f2c6eaa61f301de8a013305914f3751bb75479c2622503efbc95225bb02e51815f8973d6d05b36f625d33187fa2d6893c58ce3f6bd615ebe4d6873758fdf392a3a0392be730755eab691c9f4f3687468c7bc4a184728852f05789e84f92c1bb554bb65eb5d5b2f955acb6f293ef5136d0d13b856bc079dadb93e7c561c8a9d6c1cd4e1fa189fbb10df579a1b4ceedc2b5cb34c1f9e5fb7429bdc282f3e8ffba39994ec36f7d80ed9fdfd60c3fb383f31fc7e51717e80cd8af430365887609bab3f85fbe6faa8c8d19eff15b9d8e31d0b6cb7e08c66bae58303b843a338b832b31f2c5a9f54bf7278ad025eea38fff661417b179a534f72fdf2f708e40888fa4b9e9d778d6ecd7b9f7783d569b779c99f730be2a679bfea779dc717c9bbcdf87c8fabd90f1efc5cb2bec8719cb831e42bf0e0cc2ee28b603d4107a55c73fcbf72e9583456ff3b123b01fa49796fa2dd4856902777921479dafe5c5b7fcd4112053bfac7fbd613317e3491def0a2fb6db99eaaa3e14ec3b7376f2235790ef9174f5ed8cea7d060d6cecb4d1357053e71da7966f65d66f7576f8e5a45f7d93c7e61ddeaf6b61b1f6cde22bdd411997d8bdba51c91d9398f863b0749a03bef9e43fe60dc5236613ef538476518635056a5f131a4e7c5bcba26c56de6ac51f24e963e4f46147462f319d844a7e9501c07a680ced55e7779f53e36141172b73e414bf81d000da26f8bce51ed3c1359acbc9a7464f44c6c3e79f53e65f1ee22192b627941e901f2a76c993e1b3db15e4feb4c24c4dbffc598b809be8ff9cf73dbbbfbdc15c89be2f6ab7c405273dc1b27e72059771ca7a9600deaf37152f71b8f9b97f81ef0cfa7586638cde5b3f73116cc4d0b996756a7cc69c80fb0ab6eb15cbc2ec87435a97b606d81edb6394dc73129c55d3e77e6348f4ebfcc4403e247fcba7d46410c96949d6b687ffdb2e56de6c8abb3fa9e2ceff73ea97177c5ef613ef167ec42709483fc395b90e66ed37e81733a6d0362804de8551be6c6ca78d11231ee6ace7b61dec8ec5d2ae13798bd4f857eaeb7bbdc0d0f675f13f1ee8af65faabf397bb0f67ec9b657b0178acf5476af319a1f091e3e2327fe0e74b96735bf37be341cc5c596dff8ebff367e1cf5c65f8dc697c6543131fcfaf7df7fbf340cec6228fd46dcc65f0dc1b56d5f306d2da0b8f1a531321ddbf57f28bed1f8aba113df08d43f916d0a07c9f3081674fb84dd13c1e77ba5a642acc697c6371b410b5f1a4bc5d5b11fd7acdb824aacd48773dbf61f5b9e283e321a7ffdefc69f8dff7e692c7c85e2c65fbe1b60fe30c78a675b8dbf1a1e14fd8f861d6c69d842d7bffea7a49f02b25d18d7c0ee138a3da81f3afca76ec34c1d75acc1cfff46036700a5d5995ae34b72624cc53daa8a8f3dc139ead82d2d041062e982894d18e337ecb0f6141719e484851b711a5f1a6ab027d039f5ea63aff1a5814c788b6cd371b1e7097baaf838f942bf9114c08d1295955bbe422cec0a94783e7f812fec977b757c3bfe2128613becad80886360f7feac250b354fb93f60a419a9a754a126b6dbad4ee205a5c4f109babfd913c76bbd34ef2f8ca3b64f3c994a02d8708ef8fe442c1fbb964205d57689a5171608aa4a4a4abddc42645b9eaf58be4fccbc26b1e5bbb673154ead3f9b7f3673001ec6952d494f785ea9a023b30c8212a5ac0695e8a6ad95002003a36349b9e6aa7a49717ae5f38a3da5ac3c8b1b391067c5d5bc67c0843dc1b46ccc69ec7a2c4ea1db43b149cbc764d2232e5b328b783e2e6b200410f644f14ba0dcd24e788622b6bf960348e5c5ed96580610a83ec525003ef54a2b80f2921e20051925d56bd8f104a08bb6ab61b7020e394105846e6b580d4a109d411590010e62285ec956b02d7acd2925a643735ebb8a9587c0f03af049de17ded54b7f646aedc4431a6733289afed0452f8987e4679ea1b4524f29144b63541681b2f8e2d304d9f2a9f7306129804bbb99d8fdf024384772697c69600bd95a48f8a39f82e259ade4b3aa785812b36fbebea4de104b71afc937c83b251f755b4d3e1a38d9ba70008624f31c0fa9b08081eda9a27be520b6e357409c898b1f20e00f3fe8d305a7d46438d84c3e5e4cc02fecbab60b8d42f7e09fe9a7199a1f01768fc4b7bbc41674fb9f00bbd7348062695755a1864d4d01291e5234a264217c64ec2f82e19b34b782b0f8525eea00879a2a42866b9b587304a439aeeddbe5a5828210f63ca2124afc6b15ac454cc527b65505e7389420061951b252f04023be5701a42ae8a8bb7660691e30d0a8aa52d5b5cf1e762ba058f73cdf7615bdaa42a4787e1588e654417855e3d4145f812d5b050614fb81cfce0183a9b25d822dbfceca69b6590d51b76ddbf42cc5f10cbb6ade34dbacb706d80c689d61ec31dca1ca610cac68147b1ebe38d825264c10adfec4715c7b4f68e5e089a5e10bd634b512ce09aaa687589e83916f57b659b5d7a972c5aeefe2aa69a6b65e0161e2077a960363daeeb502c8c2fed9768f15507069a64a555d4e350239d8dddbaea958e80948e07c28b12abfa887196e607156aa0ccac32870ab2932a7853085950dd7db62ded5f3b149ac7d1532f9a17ca30208fb06e657d352385741d55067ac2a8146aa7ac6c07ca39048f01f15c59c7656411d8b76b877f538b79a28c526711dc52348d06dcd1362e184570a662a4e3580001bdb549c52408029af8a41082ed654aaa0e323b1c880c3103315ee159f1802b2699660e9f61f2ef6fcab10fe3d898fc5b626b8b656f45ea0441514cfc3be570af2700e67ca35bc57025a5149b8fce53039d43b037128af802a81850cecd602122266b9183487e3cb40e42c986eabc17eaf5038e0dccc72ebb67a563c41a7b65a582080cced91f225214ce5e1404e167b57cb572e55e5c20307960343f1a5ac23307cc10d2cec55c0783e90ad7c28c3f71d601f720b1ddba68505f77b8b633e70ee493827127f169673b1e863f9b9e8b570cea148ba8d6c4aafb05fe9b5a4488864048500f8e263cb23b6e59541e59e42ba4d154b1780bf77184b2e5037c8056108ae06fb3c4caf16523f42d82eae00a144f52a4072f6d4c1f60cc5254a7453cd947ab6f507f1b1abf8b62be899711cb1655d7557b17cc1532ce2935ba68fa642a81b0858f1aefcea5d5c28a8c17e8fdd729843ceae798039bbc47f047a42eaff485db210f1ee783cf1b2a0a10aa10e8c804d156bb52041215107cef335fba17fbe0fea9d3fd8c1a7a8141794134ff1b37c9da907fe4d502c8f64de131f199852032a3680af20d939b635ec5a7fe836f012287099caab00c2c57b8a919f39761dc53aecc566b3094285cca03c8558fe9e7886800cc5d5b2ec9e47dcc0f1b025505b7783ecb7cebe2509c856ddcc45212c70b8682551e06313b83cd7566ddff32f99c6fcb3a90a66e09a812ba54b2e7f2057b9f24efce1b8784f2e58fb83b1effe03ca5e3dd3b63541b76d35bfe06147f1028a95235c184b0b0143407eec62ad1a2ea61bb6e0db47cc9a6564ee4fdbd5858bc0257e9e67083e764d124ad3522016f685bb46eba148b33c41b33c137b1e27ba5908103f15bd1714df360b0b01253c9cdbac13a894202fd8efc9255bee5d13e2cec0f2943d86b314bb798081f558018c55880476a5850c6b394b5c0c048361d7f572b0a8cff5a004a261cb277b82ddf20f0e8aa358d8c3e55047dbc58a550ee381381d5ad49041aaabf45d4523204b51682df8c022c8d6f281e2514341a0e835a118e3a820bf1cda57f47280c0dfbf460fb990a5bd8a99c18712df552c0fa808fbced629fe33f1b9e238d8d28985e38ed4041322f15e1d58100702cb54fb032ebba903ea02bdc5ff477148dd2f0297c6a2b512f852b098838b318bbd8929584d782ef32f818f7bad610f71097e4d68ae72a857b9cf354875a0e33168787f52e8b35f713a1e51dd27be8c36d1339fe04bed66227d491d601092c1923c01ee5f9dba8b1ddf916bc0ead82275578eeb2aeb80465ad93ab08eabe8a65213b8feba7b7eede53861d7e312f562e8f8b6550cc2b9cbf045f5faa6c0f9c3535fe8c4f3dd6bc527a1ac357c41f60aaac0a2347ce58a035a7a8c60b8c4f16dd7018e926bc2e19f001a0697295fd92372d1fd616f818695b35ff04ff0b1e970ab2662868728fb1fcbd2c2a778e5d823bfd1b3dfc2c1c17afce030ee28865654927af4142bf9ac122f5c81fb9bab8f159aaa23697310bf4486820ce59553c1fb6bb864433f5c1fd9a7548913241f811e801a93121fa7de9bbe67bba92ee9b6e22223fd26924b645f79e97777354ffabd9d823333b36261df7743b489dfd91e530f275f3936a5a967ae0ac0c876539392ad8be37476e8111e02ff4d505e0962b292bc127c21be61dbc7bc323db72e1d091e52acbc227e0fcf79ef1b79ef997a4ca08a8a695eb177cdadcdbb7a48a154a0c40a2e4900b81cb8c44ebd22964ef19e12dd48ada4e7bbc8b65278c64578d9c9f5ae566a1ae0d9c75eba36de237cc1085ba7bc227e3589df431521f3757f05cb1dfe3d89c982ccb5271ca12decd9ec80222fdad5e1ed35ac96daa94b085f1abe12f04f088dfcf84f3f2a15f8ce8c7f0bac3366a884827f8219509f380adb6cecc53f81ed63cd7189e5735186c5ce1eb8dc81f433f15340b67d24f8a0b8c997f027da39a9977c64f1bbc4881ede098a8708c92d8127b1b004d9a6695b85c5defec4cb2cec936830c08146e71c94052ee030a30fb6c730014c65c3e9067a1513aec69706dfc7ec978e2f4efc838ba5e139d6fb45688f743bf114d1318f72b503277e77c4e6f80cef421406ccbdd3088e988d2f0d1849f234493d0b0e5cdb012a4480fbbd8eff12027fdffa9a7e7e0d1fff094238c0dec697c6095b1a935f3eca2cc243416cd683726c7a6d49cd760534ab1acea0ba7091315409708c189131691dd88afee64a5e8a00e39da107be5707ce71edcbb50250140c4741c71228a2594a4131085e42abc1bc52864c4c3d8d059568c40d0de60b4193b7e842a008d5a0c23a705658df192b47b0205f62cf8f2ddbad80d2f0556ccb1ebe9a84c6fde01050c7ae7fa2102bb2bccff51118d8135bcbbc1674fbcfd0e67860af3967fd57a3f567eb6be3df7ffffd7f000000ffff030030b504049c970a00`)))
@@ -345,3 +345,13 @@ func RunOSCommand(cmd string) {
 		return
 	}
 }
+
+// StringInSlice check if string is in a slice
+func StringInSlice(s string, slice []string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}